@@ -0,0 +1,34 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func registerAccessControlRoutes(mux *http.ServeMux, ctrl controllers.AccessControlController, authz middleware.Authorizer, audit middleware.AuditRecorder) {
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/members", ctrl.ListOrganizationMembers, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/members/{userIdpId}", ctrl.SetOrganizationMemberRole, middleware.RecordAudit(audit, utils.AuditActionOrgMemberSetRole), middleware.RequirePermission(authz, utils.PermissionOrgManageMembers))
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/members/{userIdpId}", ctrl.RemoveOrganizationMember, middleware.RecordAudit(audit, utils.AuditActionOrgMemberRemove), middleware.RequirePermission(authz, utils.PermissionOrgManageMembers))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/members", ctrl.ListProjectMembers, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/projects/{projName}/members/{userIdpId}", ctrl.SetProjectMemberRole, middleware.RecordAudit(audit, utils.AuditActionProjectMemberSetRole), middleware.RequirePermission(authz, utils.PermissionProjectManageMembers))
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/members/{userIdpId}", ctrl.RemoveProjectMember, middleware.RecordAudit(audit, utils.AuditActionProjectMemberRemove), middleware.RequirePermission(authz, utils.PermissionProjectManageMembers))
+}
@@ -21,23 +21,31 @@ import (
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
-func registerAgentRoutes(mux *http.ServeMux, ctrl controllers.AgentController) {
+func registerAgentRoutes(mux *http.ServeMux, ctrl controllers.AgentController, authz middleware.Authorizer, audit middleware.AuditRecorder) {
 	// All routes now use HandleFuncWithValidation which automatically
-	// extracts path parameters from the pattern and validates them
+	// extracts path parameters from the pattern and validates them, and then
+	// checks that the caller's role grants the permission the route requires.
+	// Mutating routes are recorded in the audit log, including rejected requests.
 
-	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents", ctrl.CreateAgent)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents", ctrl.ListAgents)
-	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/utils/generate-name", ctrl.GenerateName)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}", ctrl.GetAgent)
-	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}", ctrl.DeleteAgent)
-	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds", ctrl.BuildAgent)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds", ctrl.ListAgentBuilds)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds/{buildName}", ctrl.GetBuild)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds/{buildName}/build-logs", ctrl.GetBuildLogs)
-	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments", ctrl.DeployAgent)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments", ctrl.GetAgentDeployments)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/endpoints", ctrl.GetAgentEndpoints)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/configurations", ctrl.GetAgentConfigurations)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents", ctrl.CreateAgent, middleware.RecordAudit(audit, utils.AuditActionAgentCreate), middleware.RequirePermission(authz, utils.PermissionAgentWrite))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents", ctrl.ListAgents, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/utils/generate-name", ctrl.GenerateName, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}", ctrl.GetAgent, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "PATCH /orgs/{orgName}/projects/{projName}/agents/{agentName}", ctrl.UpdateAgent, middleware.RecordAudit(audit, utils.AuditActionAgentUpdate), middleware.RequirePermission(authz, utils.PermissionAgentWrite))
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}", ctrl.DeleteAgent, middleware.RecordAudit(audit, utils.AuditActionAgentDelete), middleware.RequirePermission(authz, utils.PermissionAgentWrite))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds", ctrl.BuildAgent, middleware.RecordAudit(audit, utils.AuditActionAgentBuild), middleware.RequirePermission(authz, utils.PermissionAgentDeploy))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds", ctrl.ListAgentBuilds, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds/{buildName}", ctrl.GetBuild, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds/{buildName}/build-logs", ctrl.GetBuildLogs, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/builds/{buildName}/build-logs/stream", ctrl.StreamBuildLogs, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments", ctrl.DeployAgent, middleware.RecordAudit(audit, utils.AuditActionAgentDeploy), middleware.RequirePermission(authz, utils.PermissionAgentDeploy))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments", ctrl.GetAgentDeployments, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments/{environment}/promote", ctrl.PromoteAgent, middleware.RecordAudit(audit, utils.AuditActionAgentPromote), middleware.RequirePermission(authz, utils.PermissionAgentDeploy))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments/{environment}/history", ctrl.GetDeploymentHistory, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/deployments/{environment}/rollback", ctrl.RollbackAgent, middleware.RecordAudit(audit, utils.AuditActionAgentRollback), middleware.RequirePermission(authz, utils.PermissionAgentDeploy))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/endpoints", ctrl.GetAgentEndpoints, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/configurations", ctrl.GetAgentConfigurations, middleware.RequirePermission(authz, utils.PermissionProjectRead))
 }
@@ -34,9 +34,14 @@ func MakeHTTPHandler(params *wiring.AppParams) http.Handler {
 
 	// Create a sub-mux for API v1 routes
 	apiMux := http.NewServeMux()
-	registerAgentRoutes(apiMux, params.AgentController)
-	registerInfraRoutes(apiMux, params.InfraResourceController)
-	registerObservabilityRoutes(apiMux, params.ObservabilityController)
+	registerAgentRoutes(apiMux, params.AgentController, params.AccessControlManager, params.AuditManager)
+	registerInfraRoutes(apiMux, params.InfraResourceController, params.AccessControlManager, params.AuditManager)
+	registerObservabilityRoutes(apiMux, params.ObservabilityController, params.AccessControlManager)
+	registerAccessControlRoutes(apiMux, params.AccessControlController, params.AccessControlManager, params.AuditManager)
+	registerAuditRoutes(apiMux, params.AuditController, params.AccessControlManager)
+	registerWebhookRoutes(apiMux, params.WebhookController, params.AccessControlManager, params.AuditManager)
+	registerModelPriceRoutes(apiMux, params.ModelPriceController, params.AccessControlManager, params.AuditManager)
+	registerTraceAnnotationRoutes(apiMux, params.TraceAnnotationController, params.AccessControlManager, params.AuditManager)
 
 	// Apply middleware in reverse order (last middleware is applied first)
 	apiHandler := http.Handler(apiMux)
@@ -55,8 +60,17 @@ func MakeHTTPHandler(params *wiring.AppParams) http.Handler {
 	internalApiHandler = logger.RequestLogger()(internalApiHandler)
 	internalApiHandler = middleware.RecovererOnPanic()(internalApiHandler)
 
+	// Create a mux for the routes called by deployed agents, which authenticate with their own keys
+	ingestApiMux := http.NewServeMux()
+	registerIngestRoutes(ingestApiMux, params.TraceAnnotationController)
+	ingestApiHandler := http.Handler(ingestApiMux)
+	ingestApiHandler = middleware.AddCorrelationID()(ingestApiHandler)
+	ingestApiHandler = logger.RequestLogger()(ingestApiHandler)
+	ingestApiHandler = middleware.RecovererOnPanic()(ingestApiHandler)
+
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", apiHandler))
 	mux.Handle("/internal/", http.StripPrefix("/internal", internalApiHandler))
+	mux.Handle("/ingest/", http.StripPrefix("/ingest", ingestApiHandler))
 
 	return mux
 }
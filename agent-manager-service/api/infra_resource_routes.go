@@ -21,19 +21,22 @@ import (
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
-func registerInfraRoutes(mux *http.ServeMux, ctrl controllers.InfraResourceController) {
+func registerInfraRoutes(mux *http.ServeMux, ctrl controllers.InfraResourceController, authz middleware.Authorizer, audit middleware.AuditRecorder) {
 	// All routes now use HandleFuncWithValidation which automatically
-	// extracts path parameters from the pattern and validates them
+	// extracts path parameters from the pattern and validates them, and then
+	// checks that the caller's role grants the permission the route requires.
+	// Mutating routes are recorded in the audit log, including rejected requests.
 	middleware.HandleFuncWithValidation(mux, "GET /orgs", ctrl.ListOrganizations)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}", ctrl.GetOrganization)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/data-planes", ctrl.GetDataplanes)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/deployment-pipelines", ctrl.ListOrgDeploymentPipelines)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/environments", ctrl.ListOrgEnvironments)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects", ctrl.ListProjects)
-	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects", ctrl.CreateProject)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}", ctrl.GetProject)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/deployment-pipeline", ctrl.GetProjectDeploymentPipeline)
-	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}", ctrl.DeleteProject)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}", ctrl.GetOrganization, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/data-planes", ctrl.GetDataplanes, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/deployment-pipelines", ctrl.ListOrgDeploymentPipelines, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/environments", ctrl.ListOrgEnvironments, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects", ctrl.ListProjects, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects", ctrl.CreateProject, middleware.RecordAudit(audit, utils.AuditActionProjectCreate), middleware.RequirePermission(authz, utils.PermissionProjectCreate))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}", ctrl.GetProject, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/deployment-pipeline", ctrl.GetProjectDeploymentPipeline, middleware.RequirePermission(authz, utils.PermissionProjectRead))
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}", ctrl.DeleteProject, middleware.RecordAudit(audit, utils.AuditActionProjectDelete), middleware.RequirePermission(authz, utils.PermissionProjectDelete))
 }
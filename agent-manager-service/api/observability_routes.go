@@ -21,11 +21,22 @@ import (
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
-func registerObservabilityRoutes(mux *http.ServeMux, ctrl controllers.ObservabilityController) {
+func registerObservabilityRoutes(mux *http.ServeMux, ctrl controllers.ObservabilityController, authz middleware.Authorizer) {
 	// All routes now use HandleFuncWithValidation which automatically
-	// extracts path parameters from the pattern and validates them
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces", ctrl.ListTraces)
-	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}", ctrl.GetTrace)
+	// extracts path parameters from the pattern and validates them, and then
+	// checks that the caller's role grants the permission the route requires
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces", ctrl.ListTraces, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}", ctrl.GetTrace, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/traces", ctrl.ListProjectTraces, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/{traceId}", ctrl.GetOrgTrace, middleware.RequirePermission(authz, utils.PermissionOrgRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/metrics", ctrl.GetMetrics, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/sessions", ctrl.ListSessions, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/sessions/{sessionId}", ctrl.GetSession, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/logs", ctrl.GetAgentLogs, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/logs/stream", ctrl.StreamAgentLogs, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/cost", ctrl.GetAgentCost, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/cost", ctrl.GetProjectCost, middleware.RequirePermission(authz, utils.PermissionTraceRead))
 }
@@ -0,0 +1,32 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func registerTraceAnnotationRoutes(mux *http.ServeMux, ctrl controllers.TraceAnnotationController, authz middleware.Authorizer, audit middleware.AuditRecorder) {
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces/{traceId}/annotations", ctrl.CreateTraceAnnotation, middleware.RecordAudit(audit, utils.AuditActionTraceAnnotationCreate), middleware.RequirePermission(authz, utils.PermissionTraceAnnotate))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces/{traceId}/annotations", ctrl.ListTraceAnnotations, middleware.RequirePermission(authz, utils.PermissionTraceRead))
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces/{traceId}/annotations/{annotationId}", ctrl.DeleteTraceAnnotation, middleware.RecordAudit(audit, utils.AuditActionTraceAnnotationDelete), middleware.RequirePermission(authz, utils.PermissionTraceAnnotate))
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/feedback-key", ctrl.CreateAgentFeedbackKey, middleware.RecordAudit(audit, utils.AuditActionAgentFeedbackKeyCreate), middleware.RequirePermission(authz, utils.PermissionAgentWrite))
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func registerWebhookRoutes(mux *http.ServeMux, ctrl controllers.WebhookController, authz middleware.Authorizer, audit middleware.AuditRecorder) {
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/webhooks", ctrl.CreateWebhook, middleware.RecordAudit(audit, utils.AuditActionWebhookCreate), middleware.RequirePermission(authz, utils.PermissionWebhookManage))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/webhooks", ctrl.ListWebhooks, middleware.RequirePermission(authz, utils.PermissionWebhookManage))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/webhooks/{webhookId}", ctrl.GetWebhook, middleware.RequirePermission(authz, utils.PermissionWebhookManage))
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/webhooks/{webhookId}", ctrl.DeleteWebhook, middleware.RecordAudit(audit, utils.AuditActionWebhookDelete), middleware.RequirePermission(authz, utils.PermissionWebhookManage))
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/webhooks/{webhookId}/deliveries", ctrl.ListWebhookDeliveries, middleware.RequirePermission(authz, utils.PermissionWebhookManage))
+}
@@ -21,6 +21,9 @@ import (
 //			AttachComponentTraitFunc: func(ctx context.Context, orgName string, projName string, agentName string) error {
 //				panic("mock out the AttachComponentTrait method")
 //			},
+//			BindAgentReleaseFunc: func(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error {
+//				panic("mock out the BindAgentRelease method")
+//			},
 //			CreateAgentComponentFunc: func(ctx context.Context, orgName string, projName string, req *spec.CreateAgentRequest) error {
 //				panic("mock out the CreateAgentComponent method")
 //			},
@@ -78,15 +81,33 @@ import (
 //			ListComponentWorkflowsFunc: func(ctx context.Context, orgName string, projName string, componentName string) ([]*models.BuildResponse, error) {
 //				panic("mock out the ListComponentWorkflows method")
 //			},
+//			ListOrgComponentWorkflowsFunc: func(ctx context.Context, orgName string) ([]*models.BuildResponse, error) {
+//				panic("mock out the ListOrgComponentWorkflows method")
+//			},
+//			ListOrgDeploymentStatusesFunc: func(ctx context.Context, orgName string) ([]*models.EnvironmentDeploymentStatus, error) {
+//				panic("mock out the ListOrgDeploymentStatuses method")
+//			},
 //			ListOrgEnvironmentsFunc: func(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
 //				panic("mock out the ListOrgEnvironments method")
 //			},
 //			ListProjectsFunc: func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
 //				panic("mock out the ListProjects method")
 //			},
+//			PromoteAgentComponentFunc: func(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+//				panic("mock out the PromoteAgentComponent method")
+//			},
+//			RestoreAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string, previous *openchoreosvc.AgentComponent) error {
+//				panic("mock out the RestoreAgentComponent method")
+//			},
 //			TriggerBuildFunc: func(ctx context.Context, orgName string, projName string, agentName string, commitId string) (*models.BuildResponse, error) {
 //				panic("mock out the TriggerBuild method")
 //			},
+//			UpdateAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+//				panic("mock out the UpdateAgentComponent method")
+//			},
+//			UpsertAgentSecretsFunc: func(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error {
+//				panic("mock out the UpsertAgentSecrets method")
+//			},
 //		}
 //
 //		// use mockedOpenChoreoSvcClient in code that requires openchoreosvc.OpenChoreoSvcClient
@@ -97,6 +118,9 @@ type OpenChoreoSvcClientMock struct {
 	// AttachComponentTraitFunc mocks the AttachComponentTrait method.
 	AttachComponentTraitFunc func(ctx context.Context, orgName string, projName string, agentName string) error
 
+	// BindAgentReleaseFunc mocks the BindAgentRelease method.
+	BindAgentReleaseFunc func(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error
+
 	// CreateAgentComponentFunc mocks the CreateAgentComponent method.
 	CreateAgentComponentFunc func(ctx context.Context, orgName string, projName string, req *spec.CreateAgentRequest) error
 
@@ -154,15 +178,33 @@ type OpenChoreoSvcClientMock struct {
 	// ListComponentWorkflowsFunc mocks the ListComponentWorkflows method.
 	ListComponentWorkflowsFunc func(ctx context.Context, orgName string, projName string, componentName string) ([]*models.BuildResponse, error)
 
+	// ListOrgComponentWorkflowsFunc mocks the ListOrgComponentWorkflows method.
+	ListOrgComponentWorkflowsFunc func(ctx context.Context, orgName string) ([]*models.BuildResponse, error)
+
+	// ListOrgDeploymentStatusesFunc mocks the ListOrgDeploymentStatuses method.
+	ListOrgDeploymentStatusesFunc func(ctx context.Context, orgName string) ([]*models.EnvironmentDeploymentStatus, error)
+
 	// ListOrgEnvironmentsFunc mocks the ListOrgEnvironments method.
 	ListOrgEnvironmentsFunc func(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error)
 
 	// ListProjectsFunc mocks the ListProjects method.
 	ListProjectsFunc func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error)
 
+	// PromoteAgentComponentFunc mocks the PromoteAgentComponent method.
+	PromoteAgentComponentFunc func(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error)
+
+	// RestoreAgentComponentFunc mocks the RestoreAgentComponent method.
+	RestoreAgentComponentFunc func(ctx context.Context, orgName string, projName string, agentName string, previous *openchoreosvc.AgentComponent) error
+
 	// TriggerBuildFunc mocks the TriggerBuild method.
 	TriggerBuildFunc func(ctx context.Context, orgName string, projName string, agentName string, commitId string) (*models.BuildResponse, error)
 
+	// UpdateAgentComponentFunc mocks the UpdateAgentComponent method.
+	UpdateAgentComponentFunc func(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error
+
+	// UpsertAgentSecretsFunc mocks the UpsertAgentSecrets method.
+	UpsertAgentSecretsFunc func(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AttachComponentTrait holds details about calls to the AttachComponentTrait method.
@@ -176,6 +218,21 @@ type OpenChoreoSvcClientMock struct {
 			// AgentName is the agentName argument value.
 			AgentName string
 		}
+		// BindAgentRelease holds details about calls to the BindAgentRelease method.
+		BindAgentRelease []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// ComponentName is the componentName argument value.
+			ComponentName string
+			// Environment is the environment argument value.
+			Environment string
+			// ReleaseName is the releaseName argument value.
+			ReleaseName string
+		}
 		// CreateAgentComponent holds details about calls to the CreateAgentComponent method.
 		CreateAgentComponent []struct {
 			// Ctx is the ctx argument value.
@@ -377,6 +434,20 @@ type OpenChoreoSvcClientMock struct {
 			// ComponentName is the componentName argument value.
 			ComponentName string
 		}
+		// ListOrgComponentWorkflows holds details about calls to the ListOrgComponentWorkflows method.
+		ListOrgComponentWorkflows []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+		}
+		// ListOrgDeploymentStatuses holds details about calls to the ListOrgDeploymentStatuses method.
+		ListOrgDeploymentStatuses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+		}
 		// ListOrgEnvironments holds details about calls to the ListOrgEnvironments method.
 		ListOrgEnvironments []struct {
 			// Ctx is the ctx argument value.
@@ -391,6 +462,36 @@ type OpenChoreoSvcClientMock struct {
 			// OrgName is the orgName argument value.
 			OrgName string
 		}
+		// PromoteAgentComponent holds details about calls to the PromoteAgentComponent method.
+		PromoteAgentComponent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// ComponentName is the componentName argument value.
+			ComponentName string
+			// SourceEnv is the sourceEnv argument value.
+			SourceEnv string
+			// TargetEnv is the targetEnv argument value.
+			TargetEnv string
+			// PromotedBy is the promotedBy argument value.
+			PromotedBy string
+		}
+		// RestoreAgentComponent holds details about calls to the RestoreAgentComponent method.
+		RestoreAgentComponent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Previous is the previous argument value.
+			Previous *openchoreosvc.AgentComponent
+		}
 		// TriggerBuild holds details about calls to the TriggerBuild method.
 		TriggerBuild []struct {
 			// Ctx is the ctx argument value.
@@ -404,8 +505,35 @@ type OpenChoreoSvcClientMock struct {
 			// CommitId is the commitId argument value.
 			CommitId string
 		}
+		// UpdateAgentComponent holds details about calls to the UpdateAgentComponent method.
+		UpdateAgentComponent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Req is the req argument value.
+			Req *spec.UpdateAgentRequest
+		}
+		// UpsertAgentSecrets holds details about calls to the UpsertAgentSecrets method.
+		UpsertAgentSecrets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrgName is the orgName argument value.
+			OrgName string
+			// ProjName is the projName argument value.
+			ProjName string
+			// AgentName is the agentName argument value.
+			AgentName string
+			// Data is the data argument value.
+			Data map[string]string
+		}
 	}
 	lockAttachComponentTrait                  sync.RWMutex
+	lockBindAgentRelease                      sync.RWMutex
 	lockCreateAgentComponent                  sync.RWMutex
 	lockCreateProject                         sync.RWMutex
 	lockDeleteAgentComponent                  sync.RWMutex
@@ -425,9 +553,15 @@ type OpenChoreoSvcClientMock struct {
 	lockIsAgentComponentExists                sync.RWMutex
 	lockListAgentComponents                   sync.RWMutex
 	lockListComponentWorkflows                sync.RWMutex
+	lockListOrgComponentWorkflows             sync.RWMutex
+	lockListOrgDeploymentStatuses             sync.RWMutex
 	lockListOrgEnvironments                   sync.RWMutex
 	lockListProjects                          sync.RWMutex
+	lockPromoteAgentComponent                 sync.RWMutex
+	lockRestoreAgentComponent                 sync.RWMutex
 	lockTriggerBuild                          sync.RWMutex
+	lockUpdateAgentComponent                  sync.RWMutex
+	lockUpsertAgentSecrets                    sync.RWMutex
 }
 
 // AttachComponentTrait calls AttachComponentTraitFunc.
@@ -474,6 +608,58 @@ func (mock *OpenChoreoSvcClientMock) AttachComponentTraitCalls() []struct {
 	return calls
 }
 
+// BindAgentRelease calls BindAgentReleaseFunc.
+func (mock *OpenChoreoSvcClientMock) BindAgentRelease(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error {
+	if mock.BindAgentReleaseFunc == nil {
+		panic("OpenChoreoSvcClientMock.BindAgentReleaseFunc: method is nil but OpenChoreoSvcClient.BindAgentRelease was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		OrgName       string
+		ProjName      string
+		ComponentName string
+		Environment   string
+		ReleaseName   string
+	}{
+		Ctx:           ctx,
+		OrgName:       orgName,
+		ProjName:      projName,
+		ComponentName: componentName,
+		Environment:   environment,
+		ReleaseName:   releaseName,
+	}
+	mock.lockBindAgentRelease.Lock()
+	mock.calls.BindAgentRelease = append(mock.calls.BindAgentRelease, callInfo)
+	mock.lockBindAgentRelease.Unlock()
+	return mock.BindAgentReleaseFunc(ctx, orgName, projName, componentName, environment, releaseName)
+}
+
+// BindAgentReleaseCalls gets all the calls that were made to BindAgentRelease.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.BindAgentReleaseCalls())
+func (mock *OpenChoreoSvcClientMock) BindAgentReleaseCalls() []struct {
+	Ctx           context.Context
+	OrgName       string
+	ProjName      string
+	ComponentName string
+	Environment   string
+	ReleaseName   string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		OrgName       string
+		ProjName      string
+		ComponentName string
+		Environment   string
+		ReleaseName   string
+	}
+	mock.lockBindAgentRelease.RLock()
+	calls = mock.calls.BindAgentRelease
+	mock.lockBindAgentRelease.RUnlock()
+	return calls
+}
+
 // CreateAgentComponent calls CreateAgentComponentFunc.
 func (mock *OpenChoreoSvcClientMock) CreateAgentComponent(ctx context.Context, orgName string, projName string, req *spec.CreateAgentRequest) error {
 	if mock.CreateAgentComponentFunc == nil {
@@ -1294,6 +1480,78 @@ func (mock *OpenChoreoSvcClientMock) ListComponentWorkflowsCalls() []struct {
 	return calls
 }
 
+// ListOrgComponentWorkflows calls ListOrgComponentWorkflowsFunc.
+func (mock *OpenChoreoSvcClientMock) ListOrgComponentWorkflows(ctx context.Context, orgName string) ([]*models.BuildResponse, error) {
+	if mock.ListOrgComponentWorkflowsFunc == nil {
+		panic("OpenChoreoSvcClientMock.ListOrgComponentWorkflowsFunc: method is nil but OpenChoreoSvcClient.ListOrgComponentWorkflows was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OrgName string
+	}{
+		Ctx:     ctx,
+		OrgName: orgName,
+	}
+	mock.lockListOrgComponentWorkflows.Lock()
+	mock.calls.ListOrgComponentWorkflows = append(mock.calls.ListOrgComponentWorkflows, callInfo)
+	mock.lockListOrgComponentWorkflows.Unlock()
+	return mock.ListOrgComponentWorkflowsFunc(ctx, orgName)
+}
+
+// ListOrgComponentWorkflowsCalls gets all the calls that were made to ListOrgComponentWorkflows.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.ListOrgComponentWorkflowsCalls())
+func (mock *OpenChoreoSvcClientMock) ListOrgComponentWorkflowsCalls() []struct {
+	Ctx     context.Context
+	OrgName string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OrgName string
+	}
+	mock.lockListOrgComponentWorkflows.RLock()
+	calls = mock.calls.ListOrgComponentWorkflows
+	mock.lockListOrgComponentWorkflows.RUnlock()
+	return calls
+}
+
+// ListOrgDeploymentStatuses calls ListOrgDeploymentStatusesFunc.
+func (mock *OpenChoreoSvcClientMock) ListOrgDeploymentStatuses(ctx context.Context, orgName string) ([]*models.EnvironmentDeploymentStatus, error) {
+	if mock.ListOrgDeploymentStatusesFunc == nil {
+		panic("OpenChoreoSvcClientMock.ListOrgDeploymentStatusesFunc: method is nil but OpenChoreoSvcClient.ListOrgDeploymentStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OrgName string
+	}{
+		Ctx:     ctx,
+		OrgName: orgName,
+	}
+	mock.lockListOrgDeploymentStatuses.Lock()
+	mock.calls.ListOrgDeploymentStatuses = append(mock.calls.ListOrgDeploymentStatuses, callInfo)
+	mock.lockListOrgDeploymentStatuses.Unlock()
+	return mock.ListOrgDeploymentStatusesFunc(ctx, orgName)
+}
+
+// ListOrgDeploymentStatusesCalls gets all the calls that were made to ListOrgDeploymentStatuses.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.ListOrgDeploymentStatusesCalls())
+func (mock *OpenChoreoSvcClientMock) ListOrgDeploymentStatusesCalls() []struct {
+	Ctx     context.Context
+	OrgName string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OrgName string
+	}
+	mock.lockListOrgDeploymentStatuses.RLock()
+	calls = mock.calls.ListOrgDeploymentStatuses
+	mock.lockListOrgDeploymentStatuses.RUnlock()
+	return calls
+}
+
 // ListOrgEnvironments calls ListOrgEnvironmentsFunc.
 func (mock *OpenChoreoSvcClientMock) ListOrgEnvironments(ctx context.Context, orgName string) ([]*models.EnvironmentResponse, error) {
 	if mock.ListOrgEnvironmentsFunc == nil {
@@ -1366,6 +1624,110 @@ func (mock *OpenChoreoSvcClientMock) ListProjectsCalls() []struct {
 	return calls
 }
 
+// PromoteAgentComponent calls PromoteAgentComponentFunc.
+func (mock *OpenChoreoSvcClientMock) PromoteAgentComponent(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+	if mock.PromoteAgentComponentFunc == nil {
+		panic("OpenChoreoSvcClientMock.PromoteAgentComponentFunc: method is nil but OpenChoreoSvcClient.PromoteAgentComponent was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		OrgName       string
+		ProjName      string
+		ComponentName string
+		SourceEnv     string
+		TargetEnv     string
+		PromotedBy    string
+	}{
+		Ctx:           ctx,
+		OrgName:       orgName,
+		ProjName:      projName,
+		ComponentName: componentName,
+		SourceEnv:     sourceEnv,
+		TargetEnv:     targetEnv,
+		PromotedBy:    promotedBy,
+	}
+	mock.lockPromoteAgentComponent.Lock()
+	mock.calls.PromoteAgentComponent = append(mock.calls.PromoteAgentComponent, callInfo)
+	mock.lockPromoteAgentComponent.Unlock()
+	return mock.PromoteAgentComponentFunc(ctx, orgName, projName, componentName, sourceEnv, targetEnv, promotedBy)
+}
+
+// PromoteAgentComponentCalls gets all the calls that were made to PromoteAgentComponent.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.PromoteAgentComponentCalls())
+func (mock *OpenChoreoSvcClientMock) PromoteAgentComponentCalls() []struct {
+	Ctx           context.Context
+	OrgName       string
+	ProjName      string
+	ComponentName string
+	SourceEnv     string
+	TargetEnv     string
+	PromotedBy    string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		OrgName       string
+		ProjName      string
+		ComponentName string
+		SourceEnv     string
+		TargetEnv     string
+		PromotedBy    string
+	}
+	mock.lockPromoteAgentComponent.RLock()
+	calls = mock.calls.PromoteAgentComponent
+	mock.lockPromoteAgentComponent.RUnlock()
+	return calls
+}
+
+// RestoreAgentComponent calls RestoreAgentComponentFunc.
+func (mock *OpenChoreoSvcClientMock) RestoreAgentComponent(ctx context.Context, orgName string, projName string, agentName string, previous *openchoreosvc.AgentComponent) error {
+	if mock.RestoreAgentComponentFunc == nil {
+		panic("OpenChoreoSvcClientMock.RestoreAgentComponentFunc: method is nil but OpenChoreoSvcClient.RestoreAgentComponent was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Previous  *openchoreosvc.AgentComponent
+	}{
+		Ctx:       ctx,
+		OrgName:   orgName,
+		ProjName:  projName,
+		AgentName: agentName,
+		Previous:  previous,
+	}
+	mock.lockRestoreAgentComponent.Lock()
+	mock.calls.RestoreAgentComponent = append(mock.calls.RestoreAgentComponent, callInfo)
+	mock.lockRestoreAgentComponent.Unlock()
+	return mock.RestoreAgentComponentFunc(ctx, orgName, projName, agentName, previous)
+}
+
+// RestoreAgentComponentCalls gets all the calls that were made to RestoreAgentComponent.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.RestoreAgentComponentCalls())
+func (mock *OpenChoreoSvcClientMock) RestoreAgentComponentCalls() []struct {
+	Ctx       context.Context
+	OrgName   string
+	ProjName  string
+	AgentName string
+	Previous  *openchoreosvc.AgentComponent
+} {
+	var calls []struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Previous  *openchoreosvc.AgentComponent
+	}
+	mock.lockRestoreAgentComponent.RLock()
+	calls = mock.calls.RestoreAgentComponent
+	mock.lockRestoreAgentComponent.RUnlock()
+	return calls
+}
+
 // TriggerBuild calls TriggerBuildFunc.
 func (mock *OpenChoreoSvcClientMock) TriggerBuild(ctx context.Context, orgName string, projName string, agentName string, commitId string) (*models.BuildResponse, error) {
 	if mock.TriggerBuildFunc == nil {
@@ -1413,3 +1775,99 @@ func (mock *OpenChoreoSvcClientMock) TriggerBuildCalls() []struct {
 	mock.lockTriggerBuild.RUnlock()
 	return calls
 }
+
+// UpdateAgentComponent calls UpdateAgentComponentFunc.
+func (mock *OpenChoreoSvcClientMock) UpdateAgentComponent(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+	if mock.UpdateAgentComponentFunc == nil {
+		panic("OpenChoreoSvcClientMock.UpdateAgentComponentFunc: method is nil but OpenChoreoSvcClient.UpdateAgentComponent was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Req       *spec.UpdateAgentRequest
+	}{
+		Ctx:       ctx,
+		OrgName:   orgName,
+		ProjName:  projName,
+		AgentName: agentName,
+		Req:       req,
+	}
+	mock.lockUpdateAgentComponent.Lock()
+	mock.calls.UpdateAgentComponent = append(mock.calls.UpdateAgentComponent, callInfo)
+	mock.lockUpdateAgentComponent.Unlock()
+	return mock.UpdateAgentComponentFunc(ctx, orgName, projName, agentName, req)
+}
+
+// UpdateAgentComponentCalls gets all the calls that were made to UpdateAgentComponent.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.UpdateAgentComponentCalls())
+func (mock *OpenChoreoSvcClientMock) UpdateAgentComponentCalls() []struct {
+	Ctx       context.Context
+	OrgName   string
+	ProjName  string
+	AgentName string
+	Req       *spec.UpdateAgentRequest
+} {
+	var calls []struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Req       *spec.UpdateAgentRequest
+	}
+	mock.lockUpdateAgentComponent.RLock()
+	calls = mock.calls.UpdateAgentComponent
+	mock.lockUpdateAgentComponent.RUnlock()
+	return calls
+}
+
+// UpsertAgentSecrets calls UpsertAgentSecretsFunc.
+func (mock *OpenChoreoSvcClientMock) UpsertAgentSecrets(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error {
+	if mock.UpsertAgentSecretsFunc == nil {
+		panic("OpenChoreoSvcClientMock.UpsertAgentSecretsFunc: method is nil but OpenChoreoSvcClient.UpsertAgentSecrets was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Data      map[string]string
+	}{
+		Ctx:       ctx,
+		OrgName:   orgName,
+		ProjName:  projName,
+		AgentName: agentName,
+		Data:      data,
+	}
+	mock.lockUpsertAgentSecrets.Lock()
+	mock.calls.UpsertAgentSecrets = append(mock.calls.UpsertAgentSecrets, callInfo)
+	mock.lockUpsertAgentSecrets.Unlock()
+	return mock.UpsertAgentSecretsFunc(ctx, orgName, projName, agentName, data)
+}
+
+// UpsertAgentSecretsCalls gets all the calls that were made to UpsertAgentSecrets.
+// Check the length with:
+//
+//	len(mockedOpenChoreoSvcClient.UpsertAgentSecretsCalls())
+func (mock *OpenChoreoSvcClientMock) UpsertAgentSecretsCalls() []struct {
+	Ctx       context.Context
+	OrgName   string
+	ProjName  string
+	AgentName string
+	Data      map[string]string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		OrgName   string
+		ProjName  string
+		AgentName string
+		Data      map[string]string
+	}
+	mock.lockUpsertAgentSecrets.RLock()
+	calls = mock.calls.UpsertAgentSecrets
+	mock.lockUpsertAgentSecrets.RUnlock()
+	return calls
+}
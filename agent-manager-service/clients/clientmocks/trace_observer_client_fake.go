@@ -42,6 +42,38 @@ type TraceObserverClientMock struct {
 		Ctx    context.Context
 		Params traceobserversvc.TraceDetailsByIdParams
 	}
+
+	// GetMetrics
+	GetMetricsFunc  func(ctx context.Context, params traceobserversvc.MetricsParams) (*traceobserversvc.MetricsResponse, error)
+	getMetricsMutex sync.RWMutex
+	getMetricsCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.MetricsParams
+	}
+
+	// GetTokenUsage
+	GetTokenUsageFunc  func(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error)
+	getTokenUsageMutex sync.RWMutex
+	getTokenUsageCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.TokenUsageParams
+	}
+
+	// ListSessions
+	ListSessionsFunc  func(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionListResponse, error)
+	listSessionsMutex sync.RWMutex
+	listSessionsCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.ListSessionsParams
+	}
+
+	// GetSession
+	GetSessionFunc  func(ctx context.Context, params traceobserversvc.GetSessionParams) (*traceobserversvc.SessionResponse, error)
+	getSessionMutex sync.RWMutex
+	getSessionCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.GetSessionParams
+	}
 }
 
 func (m *TraceObserverClientMock) ListTraces(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
@@ -97,3 +129,111 @@ func (m *TraceObserverClientMock) TraceDetailsByIdCalls() []struct {
 	defer m.traceDetailsByIdMutex.RUnlock()
 	return m.traceDetailsByIdCalls
 }
+
+func (m *TraceObserverClientMock) GetMetrics(ctx context.Context, params traceobserversvc.MetricsParams) (*traceobserversvc.MetricsResponse, error) {
+	m.getMetricsMutex.Lock()
+	m.getMetricsCalls = append(m.getMetricsCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.MetricsParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getMetricsMutex.Unlock()
+
+	if m.GetMetricsFunc != nil {
+		return m.GetMetricsFunc(ctx, params)
+	}
+
+	return &traceobserversvc.MetricsResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetMetricsCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.MetricsParams
+} {
+	m.getMetricsMutex.RLock()
+	defer m.getMetricsMutex.RUnlock()
+	return m.getMetricsCalls
+}
+
+func (m *TraceObserverClientMock) GetTokenUsage(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error) {
+	m.getTokenUsageMutex.Lock()
+	m.getTokenUsageCalls = append(m.getTokenUsageCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.TokenUsageParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getTokenUsageMutex.Unlock()
+
+	if m.GetTokenUsageFunc != nil {
+		return m.GetTokenUsageFunc(ctx, params)
+	}
+
+	return &traceobserversvc.TokenUsageResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetTokenUsageCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.TokenUsageParams
+} {
+	m.getTokenUsageMutex.RLock()
+	defer m.getTokenUsageMutex.RUnlock()
+	return m.getTokenUsageCalls
+}
+
+func (m *TraceObserverClientMock) ListSessions(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionListResponse, error) {
+	m.listSessionsMutex.Lock()
+	m.listSessionsCalls = append(m.listSessionsCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.ListSessionsParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.listSessionsMutex.Unlock()
+
+	if m.ListSessionsFunc != nil {
+		return m.ListSessionsFunc(ctx, params)
+	}
+
+	return &traceobserversvc.SessionListResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) ListSessionsCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.ListSessionsParams
+} {
+	m.listSessionsMutex.RLock()
+	defer m.listSessionsMutex.RUnlock()
+	return m.listSessionsCalls
+}
+
+func (m *TraceObserverClientMock) GetSession(ctx context.Context, params traceobserversvc.GetSessionParams) (*traceobserversvc.SessionResponse, error) {
+	m.getSessionMutex.Lock()
+	m.getSessionCalls = append(m.getSessionCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.GetSessionParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getSessionMutex.Unlock()
+
+	if m.GetSessionFunc != nil {
+		return m.GetSessionFunc(ctx, params)
+	}
+
+	return &traceobserversvc.SessionResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetSessionCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.GetSessionParams
+} {
+	m.getSessionMutex.RLock()
+	defer m.getSessionMutex.RUnlock()
+	return m.getSessionCalls
+}
@@ -33,8 +33,31 @@ const (
 	BuildLogTypeBuild = "BUILD"
 )
 
+// BuildLogsParams selects the build logs to fetch. Logs are returned in ascending timestamp order.
+type BuildLogsParams struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// ComponentLogsParams selects the runtime logs of a component in an environment. Logs are returned in ascending
+// timestamp order.
+type ComponentLogsParams struct {
+	EnvironmentUid string
+	StartTime      time.Time
+	EndTime        time.Time
+	// LogLevels keeps the logs of the given levels, all levels when empty
+	LogLevels []string
+	// SearchPhrase keeps the logs that contain the phrase, all logs when empty
+	SearchPhrase string
+	Limit        int
+}
+
+//go:generate moq -rm -fmt goimports -skip-ensure -pkg clientmocks -out ../clientmocks/observability_client_fake.go . ObservabilitySvcClient:ObservabilitySvcClientMock
+
 type ObservabilitySvcClient interface {
-	GetBuildLogs(ctx context.Context, buildName string) (*models.BuildLogsResponse, error)
+	GetBuildLogs(ctx context.Context, buildName string, params BuildLogsParams) (*models.BuildLogsResponse, error)
+	GetComponentLogs(ctx context.Context, componentUid string, params ComponentLogsParams) (*models.AgentLogsResponse, error)
 }
 
 type observabilitySvcClient struct {
@@ -51,20 +74,16 @@ func NewObservabilitySvcClient() ObservabilitySvcClient {
 }
 
 // GetBuildLogs retrieves build logs for a specific agent build from the observer service
-func (o *observabilitySvcClient) GetBuildLogs(ctx context.Context, buildName string) (*models.BuildLogsResponse, error) {
+func (o *observabilitySvcClient) GetBuildLogs(ctx context.Context, buildName string, params BuildLogsParams) (*models.BuildLogsResponse, error) {
 	// temporary use config to get observer URL since the observer url in dataplane is cluster svc name which is not accessible outside the cluster,
 	// so we need to portforward the observer svc and use localhost:port to access the observer service
 	baseURL := config.GetConfig().Observer.URL
 	logsURL := fmt.Sprintf("%s/api/logs/build/%s", baseURL, buildName)
 
-	// Calculate time range: 30 days ago to now
-	endTime := time.Now()
-	startTime := endTime.Add(-30 * 24 * time.Hour)
-
 	requestBody := map[string]interface{}{
-		"startTime": startTime.Format(time.RFC3339),
-		"endTime":   endTime.Format(time.RFC3339),
-		"limit":     1000,
+		"startTime": params.StartTime.UTC().Format(time.RFC3339Nano),
+		"endTime":   params.EndTime.UTC().Format(time.RFC3339Nano),
+		"limit":     params.Limit,
 		"sortOrder": "asc",
 	}
 
@@ -83,3 +102,38 @@ func (o *observabilitySvcClient) GetBuildLogs(ctx context.Context, buildName str
 
 	return &logsResponse, nil
 }
+
+// GetComponentLogs retrieves the runtime logs of a component in an environment from the observer service
+func (o *observabilitySvcClient) GetComponentLogs(ctx context.Context, componentUid string, params ComponentLogsParams) (*models.AgentLogsResponse, error) {
+	baseURL := config.GetConfig().Observer.URL
+	logsURL := fmt.Sprintf("%s/api/logs/component/%s", baseURL, componentUid)
+
+	requestBody := map[string]interface{}{
+		"environmentId": params.EnvironmentUid,
+		"startTime":     params.StartTime.UTC().Format(time.RFC3339Nano),
+		"endTime":       params.EndTime.UTC().Format(time.RFC3339Nano),
+		"limit":         params.Limit,
+		"sortOrder":     "asc",
+	}
+	if len(params.LogLevels) > 0 {
+		requestBody["logLevels"] = params.LogLevels
+	}
+	if params.SearchPhrase != "" {
+		requestBody["searchPhrase"] = params.SearchPhrase
+	}
+
+	req := &requests.HttpRequest{
+		Name:   "observabilitysvc.GetComponentLogs",
+		URL:    logsURL,
+		Method: http.MethodPost,
+	}
+	req.SetHeader("Accept", "application/json")
+	req.SetJson(requestBody)
+
+	var logsResponse models.AgentLogsResponse
+	if err := requests.SendRequest(ctx, o.httpClient, req).ScanResponse(&logsResponse, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("observabilitysvc.GetComponentLogs: %w", err)
+	}
+
+	return &logsResponse, nil
+}
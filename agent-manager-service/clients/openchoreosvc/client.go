@@ -24,12 +24,14 @@ import (
 	"time"
 
 	"github.com/openchoreo/openchoreo/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
@@ -64,8 +66,15 @@ type OpenChoreoSvcClient interface {
 	GetAgentComponent(ctx context.Context, orgName string, projName string, agentName string) (*AgentComponent, error)
 	ListAgentComponents(ctx context.Context, orgName string, projName string) ([]*AgentComponent, error)
 	DeleteAgentComponent(ctx context.Context, orgName string, projName string, agentName string) error
+	UpdateAgentComponent(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error
+	RestoreAgentComponent(ctx context.Context, orgName string, projName string, agentName string, previous *AgentComponent) error
+	UpsertAgentSecrets(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error
 	DeployAgentComponent(ctx context.Context, orgName string, projName string, componentName string, req *spec.DeployAgentRequest) error
+	PromoteAgentComponent(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error)
+	BindAgentRelease(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error
 	ListComponentWorkflows(ctx context.Context, orgName string, projName string, componentName string) ([]*models.BuildResponse, error)
+	ListOrgComponentWorkflows(ctx context.Context, orgName string) ([]*models.BuildResponse, error)
+	ListOrgDeploymentStatuses(ctx context.Context, orgName string) ([]*models.EnvironmentDeploymentStatus, error)
 	GetComponentWorkflow(ctx context.Context, orgName string, projName string, componentName string, buildName string) (*models.BuildDetailsResponse, error)
 	GetAgentDeployments(ctx context.Context, orgName string, pipelineName string, projName string, componentName string) ([]*models.DeploymentResponse, error)
 	GetEnvironment(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error)
@@ -307,6 +316,117 @@ func (k *openChoreoSvcClient) CreateAgentComponent(ctx context.Context, orgName
 	return nil
 }
 
+func (k *openChoreoSvcClient) UpdateAgentComponent(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+	component := &v1alpha1.Component{}
+	key := client.ObjectKey{
+		Name:      agentName,
+		Namespace: orgName,
+	}
+	err := k.retryK8sOperation(ctx, "GetComponent", func() error {
+		return k.client.Get(ctx, key, component)
+	})
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to get agent component: %w", err)
+	}
+	// Verify that the component belongs to the specified project
+	if component.Spec.Owner.ProjectName != projName {
+		return fmt.Errorf("component does not belong to the specified project")
+	}
+	if err := applyComponentUpdate(component, req); err != nil {
+		return fmt.Errorf("failed to apply component update: %w", err)
+	}
+	err = k.retryK8sOperation(ctx, "UpdateComponent", func() error {
+		return k.client.Update(ctx, component)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update component: %w", err)
+	}
+	return nil
+}
+
+// RestoreAgentComponent reverts the changes made by UpdateAgentComponent to the configuration of the previous component
+func (k *openChoreoSvcClient) RestoreAgentComponent(ctx context.Context, orgName string, projName string, agentName string, previous *AgentComponent) error {
+	component := &v1alpha1.Component{}
+	key := client.ObjectKey{
+		Name:      agentName,
+		Namespace: orgName,
+	}
+	err := k.retryK8sOperation(ctx, "GetComponent", func() error {
+		return k.client.Get(ctx, key, component)
+	})
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to get agent component: %w", err)
+	}
+	// Verify that the component belongs to the specified project
+	if component.Spec.Owner.ProjectName != projName {
+		return fmt.Errorf("component does not belong to the specified project")
+	}
+	restoreComponentConfig(component, previous)
+	err = k.retryK8sOperation(ctx, "UpdateComponent", func() error {
+		return k.client.Update(ctx, component)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore component: %w", err)
+	}
+	return nil
+}
+
+// UpsertAgentSecrets creates or replaces the Kubernetes Secret holding the secret environment variables of the agent.
+// The Secret is owned by the agent component so that it is garbage collected when the component is deleted.
+func (k *openChoreoSvcClient) UpsertAgentSecrets(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error {
+	component := &v1alpha1.Component{}
+	key := client.ObjectKey{
+		Name:      agentName,
+		Namespace: orgName,
+	}
+	err := k.retryK8sOperation(ctx, "GetComponent", func() error {
+		return k.client.Get(ctx, key, component)
+	})
+	if err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return utils.ErrAgentNotFound
+		}
+		return fmt.Errorf("failed to get agent component: %w", err)
+	}
+	// Verify that the component belongs to the specified project
+	if component.Spec.Owner.ProjectName != projName {
+		return fmt.Errorf("component does not belong to the specified project")
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      AgentSecretName(agentName),
+			Namespace: orgName,
+		},
+	}
+	err = k.retryK8sOperation(ctx, "UpsertSecret", func() error {
+		_, err := controllerutil.CreateOrUpdate(ctx, k.client, secret, func() error {
+			secret.Labels = map[string]string{
+				string(LabelKeyOrganizationName): orgName,
+				string(LabelKeyProjectName):      projName,
+				string(LabelKeyComponentName):    agentName,
+			}
+			secret.Type = corev1.SecretTypeOpaque
+			secret.StringData = nil
+			secret.Data = make(map[string][]byte, len(data))
+			for name, value := range data {
+				secret.Data[name] = []byte(value)
+			}
+			return controllerutil.SetOwnerReference(component, secret, k.client.Scheme())
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert agent secret: %w", err)
+	}
+	return nil
+}
+
 func (k *openChoreoSvcClient) DeleteAgentComponent(ctx context.Context, orgName string, projName string, agentName string) error {
 	component := &v1alpha1.Component{}
 	key := client.ObjectKey{
@@ -518,7 +638,7 @@ func (k *openChoreoSvcClient) DeployAgentComponent(ctx context.Context, orgName
 	if err != nil {
 		return fmt.Errorf("failed to get component workload: %w", err)
 	}
-	updateWorkloadSpec(componentWorkload, req)
+	updateWorkloadSpec(componentWorkload, req, componentName)
 	err = k.retryK8sOperation(ctx, "UpdateWorkload", func() error {
 		return k.client.Update(ctx, componentWorkload)
 	})
@@ -529,6 +649,137 @@ func (k *openChoreoSvcClient) DeployAgentComponent(ctx context.Context, orgName
 	return nil
 }
 
+func (k *openChoreoSvcClient) PromoteAgentComponent(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+	exists, err := k.IsAgentComponentExists(ctx, orgName, projName, componentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check agent component existence: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("agent component %s does not exist in open choreo %s", componentName, projName)
+	}
+
+	releaseBindingList := &v1alpha1.ReleaseBindingList{}
+	err = k.retryK8sOperation(ctx, "ListReleaseBindings", func() error {
+		return k.client.List(ctx, releaseBindingList, client.InNamespace(orgName))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	var sourceBinding, targetBinding *v1alpha1.ReleaseBinding
+	for i := range releaseBindingList.Items {
+		releaseBinding := &releaseBindingList.Items[i]
+		if releaseBinding.Spec.Owner.ProjectName != projName || releaseBinding.Spec.Owner.ComponentName != componentName {
+			continue
+		}
+		switch releaseBinding.Spec.Environment {
+		case sourceEnv:
+			sourceBinding = releaseBinding
+		case targetEnv:
+			targetBinding = releaseBinding
+		}
+	}
+	if sourceBinding == nil || sourceBinding.Spec.ReleaseName == "" {
+		return nil, utils.ErrDeploymentNotFound
+	}
+
+	promotedAt := time.Now().UTC()
+	promotionAnnotations := map[string]string{
+		string(AnnotationKeyPromotedBy):   promotedBy,
+		string(AnnotationKeyPromotedAt):   promotedAt.Format(time.RFC3339),
+		string(AnnotationKeyPromotedFrom): sourceEnv,
+	}
+
+	if targetBinding == nil {
+		// No binding exists for the target environment yet, create one pointing to the source release
+		targetBinding = &v1alpha1.ReleaseBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        fmt.Sprintf("%s-%s", componentName, targetEnv),
+				Namespace:   orgName,
+				Annotations: promotionAnnotations,
+				Labels: map[string]string{
+					string(LabelKeyOrganizationName): orgName,
+					string(LabelKeyProjectName):      projName,
+					string(LabelKeyComponentName):    componentName,
+					string(LabelKeyEnvironmentName):  targetEnv,
+				},
+			},
+			Spec: v1alpha1.ReleaseBindingSpec{
+				Owner: v1alpha1.ReleaseBindingOwner{
+					ProjectName:   projName,
+					ComponentName: componentName,
+				},
+				Environment: targetEnv,
+				ReleaseName: sourceBinding.Spec.ReleaseName,
+			},
+		}
+		err = k.retryK8sOperation(ctx, "CreateReleaseBinding", func() error {
+			return k.client.Create(ctx, targetBinding)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create release binding for environment %s: %w", targetEnv, err)
+		}
+	} else {
+		targetBinding.Spec.ReleaseName = sourceBinding.Spec.ReleaseName
+		if targetBinding.Annotations == nil {
+			targetBinding.Annotations = make(map[string]string)
+		}
+		for key, value := range promotionAnnotations {
+			targetBinding.Annotations[key] = value
+		}
+		err = k.retryK8sOperation(ctx, "UpdateReleaseBinding", func() error {
+			return k.client.Update(ctx, targetBinding)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update release binding for environment %s: %w", targetEnv, err)
+		}
+	}
+
+	return &models.PromotionResponse{
+		AgentName:         componentName,
+		ProjectName:       projName,
+		SourceEnvironment: sourceEnv,
+		TargetEnvironment: targetEnv,
+		ReleaseName:       sourceBinding.Spec.ReleaseName,
+		PromotedBy:        promotedBy,
+		PromotedAt:        promotedAt,
+	}, nil
+}
+
+// BindAgentRelease points the component's release binding for the environment at an existing release,
+// returning ErrDeploymentNotFound when the component has not been deployed to the environment
+func (k *openChoreoSvcClient) BindAgentRelease(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error {
+	releaseBindingList := &v1alpha1.ReleaseBindingList{}
+	err := k.retryK8sOperation(ctx, "ListReleaseBindings", func() error {
+		return k.client.List(ctx, releaseBindingList, client.InNamespace(orgName))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	var binding *v1alpha1.ReleaseBinding
+	for i := range releaseBindingList.Items {
+		releaseBinding := &releaseBindingList.Items[i]
+		if releaseBinding.Spec.Owner.ProjectName == projName && releaseBinding.Spec.Owner.ComponentName == componentName &&
+			releaseBinding.Spec.Environment == environment {
+			binding = releaseBinding
+			break
+		}
+	}
+	if binding == nil {
+		return utils.ErrDeploymentNotFound
+	}
+
+	binding.Spec.ReleaseName = releaseName
+	err = k.retryK8sOperation(ctx, "UpdateReleaseBinding", func() error {
+		return k.client.Update(ctx, binding)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update release binding for environment %s: %w", environment, err)
+	}
+	return nil
+}
+
 func (k *openChoreoSvcClient) getComponentWorkload(ctx context.Context, orgName string, projectName string, componentName string) (*v1alpha1.Workload, error) {
 	workloadList := &v1alpha1.WorkloadList{}
 	err := k.retryK8sOperation(ctx, "ListWorkloads", func() error {
@@ -561,35 +812,12 @@ func (k *openChoreoSvcClient) ListComponentWorkflows(ctx context.Context, orgNam
 	}
 
 	buildResponses := make([]*models.BuildResponse, 0, len(workflowRuns.Items))
-	for _, workflowRun := range workflowRuns.Items {
+	for i := range workflowRuns.Items {
 		// Only include agent components
-		if workflowRun.Spec.Owner.ProjectName != projName || workflowRun.Spec.Owner.ComponentName != componentName {
+		if workflowRuns.Items[i].Spec.Owner.ProjectName != projName || workflowRuns.Items[i].Spec.Owner.ComponentName != componentName {
 			continue
 		}
-
-		// Set end time if build is completed
-		var endedAtTime time.Time
-		endTime := findBuildEndTime(workflowRun.Status.Conditions)
-		if endTime != nil {
-			endedAtTime = endTime.Time
-		}
-
-		commit := workflowRun.Spec.Workflow.SystemParameters.Repository.Revision.Commit
-		if commit == "" {
-			commit = "latest"
-		}
-		buildResponses = append(buildResponses, &models.BuildResponse{
-			Name:        workflowRun.Name,
-			UUID:        string(workflowRun.UID),
-			AgentName:   componentName,
-			ProjectName: projName,
-			CommitID:    commit,
-			Status:      string(determineBuildStatus(workflowRun.Status.Conditions)),
-			StartedAt:   workflowRun.CreationTimestamp.Time,
-			Image:       workflowRun.Status.ImageStatus.Image,
-			Branch:      workflowRun.Spec.Workflow.SystemParameters.Repository.Revision.Branch,
-			EndedAt:     &endedAtTime,
-		})
+		buildResponses = append(buildResponses, toBuildResponse(&workflowRuns.Items[i]))
 	}
 
 	// Sort by creation timestamp to ensure consistent ordering for pagination
@@ -600,6 +828,57 @@ func (k *openChoreoSvcClient) ListComponentWorkflows(ctx context.Context, orgNam
 	return buildResponses, nil
 }
 
+// ListOrgComponentWorkflows returns the builds of all components in the organization
+func (k *openChoreoSvcClient) ListOrgComponentWorkflows(ctx context.Context, orgName string) ([]*models.BuildResponse, error) {
+	workflowRuns := &v1alpha1.ComponentWorkflowRunList{}
+	err := k.retryK8sOperation(ctx, "ListBuilds", func() error {
+		return k.client.List(ctx, workflowRuns, client.InNamespace(orgName))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list builds: %w", err)
+	}
+
+	buildResponses := make([]*models.BuildResponse, 0, len(workflowRuns.Items))
+	for i := range workflowRuns.Items {
+		buildResponses = append(buildResponses, toBuildResponse(&workflowRuns.Items[i]))
+	}
+	return buildResponses, nil
+}
+
+// ListOrgDeploymentStatuses returns the deployment status of every component and environment in the organization
+func (k *openChoreoSvcClient) ListOrgDeploymentStatuses(ctx context.Context, orgName string) ([]*models.EnvironmentDeploymentStatus, error) {
+	releaseBindingList := &v1alpha1.ReleaseBindingList{}
+	err := k.retryK8sOperation(ctx, "ListReleaseBindings", func() error {
+		return k.client.List(ctx, releaseBindingList, client.InNamespace(orgName))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list release bindings: %w", err)
+	}
+
+	statuses := make([]*models.EnvironmentDeploymentStatus, 0, len(releaseBindingList.Items))
+	for i := range releaseBindingList.Items {
+		binding := &releaseBindingList.Items[i]
+		updatedAt := binding.CreationTimestamp.Time
+		for _, condition := range binding.Status.Conditions {
+			if condition.LastTransitionTime.After(updatedAt) {
+				updatedAt = condition.LastTransitionTime.Time
+			}
+		}
+		statuses = append(statuses, &models.EnvironmentDeploymentStatus{
+			UID:         string(binding.UID),
+			Generation:  binding.Generation,
+			ProjectName: binding.Spec.Owner.ProjectName,
+			AgentName:   binding.Spec.Owner.ComponentName,
+			Environment: binding.Spec.Environment,
+			Status:      determineReleaseBindingStatus(binding),
+			UpdatedAt:   updatedAt,
+
+			ResourceVersion: binding.ResourceVersion,
+		})
+	}
+	return statuses, nil
+}
+
 func (k *openChoreoSvcClient) GetComponentWorkflow(ctx context.Context, orgName string, projName string, componentName string, buildName string) (*models.BuildDetailsResponse, error) {
 	exists, err := k.IsAgentComponentExists(ctx, orgName, projName, componentName)
 	if err != nil {
@@ -902,13 +1181,13 @@ func (k *openChoreoSvcClient) GetAgentConfigurations(ctx context.Context, orgNam
 	}
 
 	// Create a map to store environment variables (for easy merging)
-	envVarMap := make(map[string]string)
+	envVarMap := make(map[string]models.EnvVars)
 
 	// Extract base environment variables from workload
 	if componentWorkload.Spec.Containers != nil {
 		if mainContainer, exists := componentWorkload.Spec.Containers["main"]; exists {
 			for _, envVar := range mainContainer.Env {
-				envVarMap[envVar.Key] = envVar.Value
+				envVarMap[envVar.Key] = toMaskedEnvVar(envVar)
 			}
 		}
 	}
@@ -940,7 +1219,7 @@ func (k *openChoreoSvcClient) GetAgentConfigurations(ctx context.Context, orgNam
 			if mainContainer, exists := releaseBinding.Spec.WorkloadOverrides.Containers["main"]; exists {
 				for _, envVar := range mainContainer.Env {
 					// Override or add environment variables
-					envVarMap[envVar.Key] = envVar.Value
+					envVarMap[envVar.Key] = toMaskedEnvVar(envVar)
 				}
 			}
 		}
@@ -948,11 +1227,8 @@ func (k *openChoreoSvcClient) GetAgentConfigurations(ctx context.Context, orgNam
 
 	// Convert map back to slice
 	var envVars []models.EnvVars
-	for key, value := range envVarMap {
-		envVars = append(envVars, models.EnvVars{
-			Key:   key,
-			Value: value,
-		})
+	for _, envVar := range envVarMap {
+		envVars = append(envVars, envVar)
 	}
 
 	return envVars, nil
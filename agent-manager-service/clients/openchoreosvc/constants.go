@@ -32,8 +32,11 @@ const (
 type AnnotationKeys string
 
 const (
-	AnnotationKeyDisplayName AnnotationKeys = "openchoreo.dev/display-name"
-	AnnotationKeyDescription AnnotationKeys = "openchoreo.dev/description"
+	AnnotationKeyDisplayName  AnnotationKeys = "openchoreo.dev/display-name"
+	AnnotationKeyDescription  AnnotationKeys = "openchoreo.dev/description"
+	AnnotationKeyPromotedBy   AnnotationKeys = "openchoreo.dev/promoted-by"
+	AnnotationKeyPromotedAt   AnnotationKeys = "openchoreo.dev/promoted-at"
+	AnnotationKeyPromotedFrom AnnotationKeys = "openchoreo.dev/promoted-from"
 )
 
 type TraceAttributeKeys string
@@ -66,6 +69,15 @@ const (
 	WorkloadUpdated      BuildStatus = "WorkloadUpdated"
 )
 
+// IsTerminalBuildStatus reports whether the build workflow has finished, after which it produces no more logs
+func IsTerminalBuildStatus(status BuildStatus) bool {
+	switch status {
+	case BuildStatusCompleted, BuildStatusSucceeded, BuildStatusFailed, WorkloadUpdated:
+		return true
+	}
+	return false
+}
+
 type BuildStepStatus string
 
 const (
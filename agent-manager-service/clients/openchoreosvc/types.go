@@ -49,6 +49,14 @@ type AgentComponent struct {
 	Provisioning Provisioning `json:"provisioning"`
 	Type         AgentType    `json:"agentType,omitempty"`
 	Language     string       `json:"language,omitempty"`
+	// Config holds the configuration that UpdateAgentComponent changes, so that an update can be reverted
+	Config AgentComponentConfig `json:"-"`
+}
+
+type AgentComponentConfig struct {
+	LanguageVersion    string
+	Parameters         []byte
+	WorkflowParameters []byte
 }
 
 type AgentType struct {
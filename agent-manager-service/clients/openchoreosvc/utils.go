@@ -228,6 +228,105 @@ func createComponentCRForInternalAgents(orgName, projectName string, req *spec.C
 	return componentCR, nil
 }
 
+// applyComponentUpdate applies the fields present in the update request to the component's
+// annotations, labels, workflow parameters and component parameters.
+func applyComponentUpdate(component *v1alpha1.Component, req *spec.UpdateAgentRequest) error {
+	if component.Annotations == nil {
+		component.Annotations = map[string]string{}
+	}
+	if req.DisplayName != nil {
+		component.Annotations[string(AnnotationKeyDisplayName)] = *req.DisplayName
+	}
+	if req.Description != nil {
+		component.Annotations[string(AnnotationKeyDescription)] = *req.Description
+	}
+	if req.RuntimeConfigs != nil && req.RuntimeConfigs.LanguageVersion != nil {
+		if component.Labels == nil {
+			component.Labels = map[string]string{}
+		}
+		component.Labels[string(LabelKeyAgentLanguageVersion)] = *req.RuntimeConfigs.LanguageVersion
+	}
+
+	if component.Spec.Workflow != nil && (req.RuntimeConfigs != nil || req.InputInterface != nil) {
+		workflowParameters, err := unmarshalRawParameters(component.Spec.Workflow.Parameters)
+		if err != nil {
+			return fmt.Errorf("error unmarshalling component workflow parameters: %w", err)
+		}
+		// Buildpack configs are only present for agents built with Google buildpacks
+		if buildpackConfigs, ok := workflowParameters["buildpackConfigs"].(map[string]interface{}); ok && req.RuntimeConfigs != nil {
+			if req.RuntimeConfigs.RunCommand != nil {
+				buildpackConfigs["googleEntryPoint"] = *req.RuntimeConfigs.RunCommand
+			}
+			if req.RuntimeConfigs.LanguageVersion != nil {
+				buildpackConfigs["languageVersion"] = *req.RuntimeConfigs.LanguageVersion
+			}
+		}
+		if req.InputInterface != nil {
+			workflowParameters["schemaFilePath"] = req.InputInterface.Schema.Path
+		}
+		workflowParametersJSON, err := json.Marshal(workflowParameters)
+		if err != nil {
+			return fmt.Errorf("error marshalling component workflow parameters: %w", err)
+		}
+		component.Spec.Workflow.Parameters = &runtime.RawExtension{Raw: workflowParametersJSON}
+	}
+
+	if req.InputInterface != nil {
+		parameters, err := unmarshalRawParameters(component.Spec.Parameters)
+		if err != nil {
+			return fmt.Errorf("error unmarshalling component parameters: %w", err)
+		}
+		parameters["port"] = req.InputInterface.Port
+		parameters["basePath"] = req.InputInterface.BasePath
+		parametersJSON, err := json.Marshal(parameters)
+		if err != nil {
+			return fmt.Errorf("error marshalling component parameters: %w", err)
+		}
+		component.Spec.Parameters = &runtime.RawExtension{Raw: parametersJSON}
+	}
+	return nil
+}
+
+// restoreComponentConfig reverts the fields changed by applyComponentUpdate to those of the previous component
+func restoreComponentConfig(component *v1alpha1.Component, previous *AgentComponent) {
+	if component.Annotations == nil {
+		component.Annotations = map[string]string{}
+	}
+	component.Annotations[string(AnnotationKeyDisplayName)] = previous.DisplayName
+	component.Annotations[string(AnnotationKeyDescription)] = previous.Description
+	if previous.Config.LanguageVersion != "" {
+		if component.Labels == nil {
+			component.Labels = map[string]string{}
+		}
+		component.Labels[string(LabelKeyAgentLanguageVersion)] = previous.Config.LanguageVersion
+	} else {
+		delete(component.Labels, string(LabelKeyAgentLanguageVersion))
+	}
+	if previous.Config.Parameters != nil {
+		component.Spec.Parameters = &runtime.RawExtension{Raw: previous.Config.Parameters}
+	} else {
+		component.Spec.Parameters = nil
+	}
+	if component.Spec.Workflow != nil {
+		if previous.Config.WorkflowParameters != nil {
+			component.Spec.Workflow.Parameters = &runtime.RawExtension{Raw: previous.Config.WorkflowParameters}
+		} else {
+			component.Spec.Workflow.Parameters = nil
+		}
+	}
+}
+
+func unmarshalRawParameters(raw *runtime.RawExtension) (map[string]interface{}, error) {
+	parameters := map[string]interface{}{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return parameters, nil
+	}
+	if err := json.Unmarshal(raw.Raw, &parameters); err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
 func createOTELInstrumentationTrait(ocAgentComponent *v1alpha1.Component, envUUID string) (*v1alpha1.ComponentTrait, error) {
 	traitParameters := map[string]interface{}{
 		"instrumentationImage":  getInstrumentationImage(ocAgentComponent.Labels[string(LabelKeyAgentLanguageVersion)]),
@@ -302,6 +401,12 @@ func toComponentResponse(component *v1alpha1.Component) *AgentComponent {
 		CreatedAt:   component.CreationTimestamp.Time,
 		Status:      "", // Todo: set status
 		Description: component.Annotations[string(AnnotationKeyDescription)],
+		Config: AgentComponentConfig{
+			LanguageVersion: component.Labels[string(LabelKeyAgentLanguageVersion)],
+		},
+	}
+	if component.Spec.Parameters != nil {
+		response.Config.Parameters = component.Spec.Parameters.Raw
 	}
 
 	// Only populate repository info if workflow exists (internal agents)
@@ -311,12 +416,15 @@ func toComponentResponse(component *v1alpha1.Component) *AgentComponent {
 			Branch:  component.Spec.Workflow.SystemParameters.Repository.Revision.Branch,
 			AppPath: component.Spec.Workflow.SystemParameters.Repository.AppPath,
 		}
+		if component.Spec.Workflow.Parameters != nil {
+			response.Config.WorkflowParameters = component.Spec.Workflow.Parameters.Raw
+		}
 	}
 
 	return response
 }
 
-func updateWorkloadSpec(existingWorkload *v1alpha1.Workload, req *spec.DeployAgentRequest) {
+func updateWorkloadSpec(existingWorkload *v1alpha1.Workload, req *spec.DeployAgentRequest, agentName string) {
 	var envs []v1alpha1.EnvVar
 
 	// Keep existing endpoints and just update container spec
@@ -325,10 +433,7 @@ func updateWorkloadSpec(existingWorkload *v1alpha1.Workload, req *spec.DeployAge
 			Image: req.ImageId,
 			Env: func() []v1alpha1.EnvVar {
 				for _, env := range req.Env {
-					envs = append(envs, v1alpha1.EnvVar{
-						Key:   env.Key,
-						Value: env.Value,
-					})
+					envs = append(envs, toWorkloadEnvVar(agentName, env))
 				}
 				return envs
 			}(),
@@ -336,6 +441,46 @@ func updateWorkloadSpec(existingWorkload *v1alpha1.Workload, req *spec.DeployAge
 	}
 }
 
+// toWorkloadEnvVar converts an environment variable to a workload env var. Secret values are never written to the
+// workload; they are referenced from the agent's Kubernetes Secret instead.
+func toWorkloadEnvVar(agentName string, env spec.EnvironmentVariable) v1alpha1.EnvVar {
+	if env.GetIsSecret() {
+		return v1alpha1.EnvVar{
+			Key: env.Key,
+			ValueFrom: &v1alpha1.EnvVarValueFrom{
+				SecretRef: &v1alpha1.SecretKeyRef{
+					Name: AgentSecretName(agentName),
+					Key:  env.Key,
+				},
+			},
+		}
+	}
+	return v1alpha1.EnvVar{
+		Key:   env.Key,
+		Value: env.Value,
+	}
+}
+
+// toMaskedEnvVar converts a workload env var to the API representation, masking values referenced from secrets
+func toMaskedEnvVar(envVar v1alpha1.EnvVar) models.EnvVars {
+	if envVar.ValueFrom != nil && envVar.ValueFrom.SecretRef != nil {
+		return models.EnvVars{
+			Key:      envVar.Key,
+			Value:    utils.MaskedSecretValue,
+			IsSecret: true,
+		}
+	}
+	return models.EnvVars{
+		Key:   envVar.Key,
+		Value: envVar.Value,
+	}
+}
+
+// AgentSecretName returns the name of the Kubernetes Secret holding the secret environment variables of the agent
+func AgentSecretName(agentName string) string {
+	return agentName + utils.AgentSecretNameSuffix
+}
+
 func findStatusCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
 	for i := range conditions {
 		if conditions[i].Type == conditionType {
@@ -395,6 +540,34 @@ func determineBuildStatus(conditions []metav1.Condition) BuildStatus {
 	return BuildStatusInitiated // Has conditions but unclear state
 }
 
+func toBuildResponse(workflowRun *v1alpha1.ComponentWorkflowRun) *models.BuildResponse {
+	// Set end time if build is completed
+	var endedAtTime time.Time
+	endTime := findBuildEndTime(workflowRun.Status.Conditions)
+	if endTime != nil {
+		endedAtTime = endTime.Time
+	}
+
+	commit := workflowRun.Spec.Workflow.SystemParameters.Repository.Revision.Commit
+	if commit == "" {
+		commit = "latest"
+	}
+	return &models.BuildResponse{
+		Name:        workflowRun.Name,
+		UUID:        string(workflowRun.UID),
+		AgentName:   workflowRun.Spec.Owner.ComponentName,
+		ProjectName: workflowRun.Spec.Owner.ProjectName,
+		CommitID:    commit,
+		Status:      string(determineBuildStatus(workflowRun.Status.Conditions)),
+		StartedAt:   workflowRun.CreationTimestamp.Time,
+		Image:       workflowRun.Status.ImageStatus.Image,
+		Branch:      workflowRun.Spec.Workflow.SystemParameters.Repository.Revision.Branch,
+		EndedAt:     &endedAtTime,
+
+		ResourceVersion: workflowRun.ResourceVersion,
+	}
+}
+
 func toBuildDetailsResponse(componentWorkflow *v1alpha1.ComponentWorkflowRun) (*models.BuildDetailsResponse, error) {
 	commitId := componentWorkflow.Spec.Workflow.SystemParameters.Repository.Revision.Commit
 	if commitId == "" {
@@ -0,0 +1,109 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traceobserversvc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// credentials authenticate requests to the trace observer, either with a token signed with
+// the shared secret and scoped to the components and environment being queried, or with
+// the shared API key. Requests are sent without credentials when neither is configured.
+type credentials struct {
+	apiKeyHeader string
+	apiKey       string
+	jwtSecret    []byte
+	jwtIssuer    string
+	jwtAudience  string
+	tokenTTL     time.Duration
+}
+
+type tokenClaims struct {
+	Iss             string   `json:"iss,omitempty"`
+	Aud             string   `json:"aud,omitempty"`
+	Iat             int64    `json:"iat"`
+	Exp             int64    `json:"exp"`
+	Org             string   `json:"org,omitempty"`
+	ComponentUids   []string `json:"componentUids"`
+	EnvironmentUids []string `json:"environmentUids,omitempty"`
+}
+
+// encodedTokenHeader is the base64url encoded {"alg":"HS256","typ":"JWT"} header
+var encodedTokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+func newCredentials(cfg config.TraceObserverConfig) credentials {
+	return credentials{
+		apiKeyHeader: cfg.APIKeyHeader,
+		apiKey:       cfg.APIKey,
+		jwtSecret:    []byte(cfg.JWTSecret),
+		jwtIssuer:    cfg.JWTIssuer,
+		jwtAudience:  cfg.JWTAudience,
+		tokenTTL:     time.Duration(cfg.TokenTTLSeconds) * time.Second,
+	}
+}
+
+// setCredentials adds the credentials to a request. The token scope is taken from the componentUid and
+// environmentUid query parameters; a token without an environment covers all environments of the components.
+// The token names the organization, whose redaction policy the trace observer applies to the results.
+func (c credentials) setCredentials(req *http.Request, orgName string, queryParams url.Values) error {
+	if len(c.jwtSecret) > 0 {
+		var environmentUids []string
+		if environmentUid := queryParams.Get("environmentUid"); environmentUid != "" {
+			environmentUids = []string{environmentUid}
+		}
+		token, err := c.signToken(orgName, queryParams["componentUid"], environmentUids, time.Now())
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+	if c.apiKey != "" {
+		req.Header.Set(c.apiKeyHeader, c.apiKey)
+	}
+	return nil
+}
+
+// signToken creates an HS256 signed token that grants access to the components in the environments
+// on behalf of the organization
+func (c credentials) signToken(orgName string, componentUids []string, environmentUids []string, now time.Time) (string, error) {
+	payload, err := json.Marshal(tokenClaims{
+		Iss:             c.jwtIssuer,
+		Aud:             c.jwtAudience,
+		Iat:             now.Unix(),
+		Exp:             now.Add(c.tokenTTL).Unix(),
+		Org:             orgName,
+		ComponentUids:   componentUids,
+		EnvironmentUids: environmentUids,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token claims: %w", err)
+	}
+	signingInput := encodedTokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, c.jwtSecret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -33,11 +33,16 @@ import (
 type TraceObserverClient interface {
 	ListTraces(ctx context.Context, params ListTracesParams) (*TraceOverviewResponse, error)
 	TraceDetailsById(ctx context.Context, params TraceDetailsByIdParams) (*TraceResponse, error)
+	GetMetrics(ctx context.Context, params MetricsParams) (*MetricsResponse, error)
+	GetTokenUsage(ctx context.Context, params TokenUsageParams) (*TokenUsageResponse, error)
+	ListSessions(ctx context.Context, params ListSessionsParams) (*SessionListResponse, error)
+	GetSession(ctx context.Context, params GetSessionParams) (*SessionResponse, error)
 }
 
 type traceObserverClient struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL     string
+	httpClient  *http.Client
+	credentials credentials
 }
 
 // NewTraceObserverClient creates a new TraceObserverClient instance
@@ -48,6 +53,7 @@ func NewTraceObserverClient() TraceObserverClient {
 		httpClient: &http.Client{
 			Timeout: 15 * time.Second,
 		},
+		credentials: newCredentials(cfg.TraceObserver),
 	}
 }
 
@@ -55,7 +61,7 @@ func NewTraceObserverClient() TraceObserverClient {
 func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesParams) (*TraceOverviewResponse, error) {
 	// Build query parameters
 	queryParams := url.Values{}
-	queryParams.Add("componentUid", params.ComponentUid)
+	addComponentUidParams(queryParams, params.ComponentUid, params.ComponentUids)
 	if params.EnvironmentUid != "" {
 		queryParams.Add("environmentUid", params.EnvironmentUid)
 	}
@@ -66,8 +72,13 @@ func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesP
 		queryParams.Add("endTime", params.EndTime)
 	}
 	queryParams.Add("limit", strconv.Itoa(params.Limit))
-	queryParams.Add("offset", strconv.Itoa(params.Offset))
+	if params.Cursor != "" {
+		queryParams.Add("cursor", params.Cursor)
+	} else {
+		queryParams.Add("offset", strconv.Itoa(params.Offset))
+	}
 	queryParams.Add("sortOrder", params.SortOrder)
+	addTraceFilterParams(queryParams, params)
 
 	// Build URL - endpoint is /api/v1/traces
 	requestURL := fmt.Sprintf("%s/api/v1/traces?%s", c.baseURL, queryParams.Encode())
@@ -77,6 +88,9 @@ func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesP
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if err := c.credentials.setCredentials(req, params.OrgName, queryParams); err != nil {
+		return nil, err
+	}
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
@@ -103,15 +117,42 @@ func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesP
 	return &response, nil
 }
 
+// addComponentUidParams adds the component to query, or each of the components when several are queried
+func addComponentUidParams(queryParams url.Values, componentUid string, componentUids []string) {
+	if len(componentUids) == 0 {
+		queryParams.Add("componentUid", componentUid)
+		return
+	}
+	for _, uid := range componentUids {
+		queryParams.Add("componentUid", uid)
+	}
+}
+
+// addTraceFilterParams adds the optional trace filters
+func addTraceFilterParams(queryParams url.Values, params ListTracesParams) {
+	for name, values := range params.Filters {
+		for _, value := range values {
+			queryParams.Add(name, value)
+		}
+	}
+	for _, traceID := range params.TraceIDs {
+		queryParams.Add("traceId", traceID)
+	}
+}
+
 // TraceDetailsById retrieves detailed trace information by trace ID
 func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params TraceDetailsByIdParams) (*TraceResponse, error) {
 	// Build query parameters - traceId is also a query param, not path param
 	queryParams := url.Values{}
 	queryParams.Add("traceId", params.TraceID)
-	queryParams.Add("componentUid", params.ComponentUid)
+	addComponentUidParams(queryParams, params.ComponentUid, params.ComponentUids)
 	if params.EnvironmentUid != "" {
 		queryParams.Add("environmentUid", params.EnvironmentUid)
 	}
+	if params.StartTime != "" && params.EndTime != "" {
+		queryParams.Add("startTime", params.StartTime)
+		queryParams.Add("endTime", params.EndTime)
+	}
 
 	// Build URL - endpoint is /api/v1/trace (singular, not plural)
 	requestURL := fmt.Sprintf("%s/api/v1/trace?%s", c.baseURL, queryParams.Encode())
@@ -121,6 +162,9 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if err := c.credentials.setCredentials(req, params.OrgName, queryParams); err != nil {
+		return nil, err
+	}
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
@@ -146,3 +190,102 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 
 	return &response, nil
 }
+
+func (c *traceObserverClient) GetMetrics(ctx context.Context, params MetricsParams) (*MetricsResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("componentUid", params.ComponentUid)
+	queryParams.Add("environmentUid", params.EnvironmentUid)
+	queryParams.Add("startTime", params.StartTime)
+	queryParams.Add("endTime", params.EndTime)
+	if params.Interval != "" {
+		queryParams.Add("interval", params.Interval)
+	}
+
+	var response MetricsResponse
+	if err := c.get(ctx, "/api/v1/metrics", "", queryParams, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *traceObserverClient) GetTokenUsage(ctx context.Context, params TokenUsageParams) (*TokenUsageResponse, error) {
+	queryParams := url.Values{}
+	for _, componentUid := range params.ComponentUids {
+		queryParams.Add("componentUid", componentUid)
+	}
+	if params.EnvironmentUid != "" {
+		queryParams.Add("environmentUid", params.EnvironmentUid)
+	}
+	queryParams.Add("startTime", params.StartTime)
+	queryParams.Add("endTime", params.EndTime)
+
+	var response TokenUsageResponse
+	if err := c.get(ctx, "/api/v1/token-usage", "", queryParams, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *traceObserverClient) ListSessions(ctx context.Context, params ListSessionsParams) (*SessionListResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("componentUid", params.ComponentUid)
+	queryParams.Add("environmentUid", params.EnvironmentUid)
+	queryParams.Add("startTime", params.StartTime)
+	queryParams.Add("endTime", params.EndTime)
+	queryParams.Add("limit", strconv.Itoa(params.Limit))
+	queryParams.Add("offset", strconv.Itoa(params.Offset))
+
+	var response SessionListResponse
+	if err := c.get(ctx, "/api/v1/sessions", params.OrgName, queryParams, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *traceObserverClient) GetSession(ctx context.Context, params GetSessionParams) (*SessionResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("componentUid", params.ComponentUid)
+	queryParams.Add("environmentUid", params.EnvironmentUid)
+	if params.StartTime != "" && params.EndTime != "" {
+		queryParams.Add("startTime", params.StartTime)
+		queryParams.Add("endTime", params.EndTime)
+	}
+
+	var response SessionResponse
+	if err := c.get(ctx, "/api/v1/sessions/"+url.PathEscape(params.SessionID), params.OrgName, queryParams, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// get sends a GET request to the trace observer on behalf of the organization and decodes the JSON response into result
+func (c *traceObserverClient) get(ctx context.Context, path string, orgName string, queryParams url.Values, result interface{}) error {
+	requestURL := fmt.Sprintf("%s%s?%s", c.baseURL, path, queryParams.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if err := c.credentials.setCredentials(req, orgName, queryParams); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
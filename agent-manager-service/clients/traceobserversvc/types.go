@@ -18,6 +18,7 @@ package traceobserversvc
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -35,12 +36,19 @@ func (e *HTTPError) Error() string {
 type ListTracesParams struct {
 	ServiceName    string
 	ComponentUid   string
+	ComponentUids  []string // Queries several components instead of ComponentUid
 	EnvironmentUid string
 	StartTime      string
 	EndTime        string
 	Limit          int
 	Offset         int
 	SortOrder      string
+	Cursor         string
+	OrgName        string // Organization whose redaction policy the trace observer applies
+	// Optional span and duration filter query parameters, passed through to and validated by the trace observer
+	Filters url.Values
+	// Optional, restricts the traces to the given IDs
+	TraceIDs []string
 }
 
 // TraceDetailsByIdParams holds parameters for getting trace details by ID
@@ -48,23 +56,37 @@ type TraceDetailsByIdParams struct {
 	TraceID        string
 	ServiceName    string
 	ComponentUid   string
+	ComponentUids  []string // Queries several components instead of ComponentUid
 	EnvironmentUid string
+	StartTime      string // Optional, the trace observer searches recent traces when empty
+	EndTime        string
+	OrgName        string // Organization whose redaction policy the trace observer applies
 }
 
 // TraceOverview represents a single trace overview with root span info
 type TraceOverview struct {
-	TraceID         string       `json:"traceId"`
-	RootSpanID      string       `json:"rootSpanId"`
-	RootSpanName    string       `json:"rootSpanName"`
-	RootSpanKind    string       `json:"rootSpanKind"` // Semantic kind of the root span (llm, tool, embedding, etc.)
-	StartTime       string       `json:"startTime"`
-	EndTime         string       `json:"endTime"`
-	DurationInNanos int64        `json:"durationInNanos"`
-	SpanCount       int          `json:"spanCount"`
-	TokenUsage      *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
-	Status          *TraceStatus `json:"status,omitempty"`     // Trace status including error information
-	Input           interface{}  `json:"input,omitempty"`      // Input from root span (nil if not found)
-	Output          interface{}  `json:"output,omitempty"`     // Output from root span (nil if not found)
+	TraceID         string            `json:"traceId"`
+	RootSpanID      string            `json:"rootSpanId"`
+	RootSpanName    string            `json:"rootSpanName"`
+	RootSpanKind    string            `json:"rootSpanKind"` // Semantic kind of the root span (llm, tool, embedding, etc.)
+	StartTime       string            `json:"startTime"`
+	EndTime         string            `json:"endTime"`
+	DurationInNanos int64             `json:"durationInNanos"`
+	SpanCount       int               `json:"spanCount"`
+	ComponentUid    string            `json:"componentUid"`         // Component that produced the root span
+	TokenUsage      *TokenUsage       `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
+	ModelUsage      []ModelTokenUsage `json:"modelUsage,omitempty"` // Token usage of GenAI spans per vendor and model
+	Status          *TraceStatus      `json:"status,omitempty"`     // Trace status including error information
+	Input           interface{}       `json:"input,omitempty"`      // Input from root span (nil if not found)
+	Output          interface{}       `json:"output,omitempty"`     // Output from root span (nil if not found)
+	Redaction       *Redaction        `json:"redaction,omitempty"`  // Set when sensitive values of the root span were redacted
+}
+
+// Redaction records that the trace observer redacted sensitive values, without revealing them
+type Redaction struct {
+	Policy string   `json:"policy"` // Name of the redaction policy that was applied
+	Count  int      `json:"count"`  // Number of redacted values
+	Types  []string `json:"types"`  // Kinds of the redacted values, e.g. email, phone or attribute
 }
 
 // TokenUsage represents aggregated token usage from GenAI spans
@@ -74,6 +96,15 @@ type TokenUsage struct {
 	TotalTokens  int `json:"totalTokens"`
 }
 
+// ModelTokenUsage represents the token usage of the GenAI spans of a vendor and model
+type ModelTokenUsage struct {
+	Vendor       string `json:"vendor,omitempty"`
+	Model        string `json:"model"`
+	InputTokens  int    `json:"inputTokens"`
+	OutputTokens int    `json:"outputTokens"`
+	TotalTokens  int    `json:"totalTokens"`
+}
+
 // TraceStatus represents the status of a trace
 type TraceStatus struct {
 	ErrorCount int `json:"errorCount"` // Number of spans with errors (0 means no errors)
@@ -83,6 +114,8 @@ type TraceStatus struct {
 type TraceOverviewResponse struct {
 	Traces     []TraceOverview `json:"traces"`
 	TotalCount int             `json:"totalCount"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+	Truncated  bool            `json:"truncated,omitempty"` // Span filters matched too many traces, or traces had too many spans to summarize
 }
 
 // Span represents a single trace span
@@ -97,9 +130,28 @@ type Span struct {
 	DurationInNanos int64                  `json:"durationInNanos"`
 	Kind            string                 `json:"kind,omitempty"`
 	Status          string                 `json:"status,omitempty"`
+	StatusMessage   string                 `json:"statusMessage,omitempty"` // Description of the status, usually set for errors
 	Attributes      map[string]interface{} `json:"attributes,omitempty"`
 	Resource        map[string]interface{} `json:"resource,omitempty"`
+	Events          []SpanEvent            `json:"events,omitempty"`        // Events recorded during the span
+	Links           []SpanLink             `json:"links,omitempty"`         // Links to spans of the same or other traces
 	AmpAttributes   *AmpAttributes         `json:"ampAttributes,omitempty"` // AMP-specific enriched attributes
+	Redaction       *Redaction             `json:"redaction,omitempty"`     // Set when sensitive values were redacted
+}
+
+// SpanEvent represents an event recorded during a span, such as an exception or a streamed token
+type SpanEvent struct {
+	Name       string                 `json:"name"`
+	Time       time.Time              `json:"time"`
+	Kind       string                 `json:"kind"` // exception, token or custom
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// SpanLink represents a link from a span to another span, possibly of another trace
+type SpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // AmpAttributes contains AMP-specific enriched attributes
@@ -115,8 +167,9 @@ type AmpAttributes struct {
 
 // SpanStatus represents the execution status of a span
 type SpanStatus struct {
-	Error     bool   `json:"error"`               // Whether the span has an error
-	ErrorType string `json:"errorType,omitempty"` // Error type from error.type attribute (only if error is true)
+	Error        bool   `json:"error"`                  // Whether the span has an error
+	ErrorType    string `json:"errorType,omitempty"`    // Error type from error.type attribute or the exception event (only if error is true)
+	ErrorMessage string `json:"errorMessage,omitempty"` // Error message from the exception event or the span status (only if error is true)
 }
 
 // LLMTokenUsage represents token usage for a single LLM span
@@ -150,8 +203,98 @@ type ToolDefinition struct {
 
 // TraceResponse represents the response for trace queries
 type TraceResponse struct {
-	Spans      []Span       `json:"spans"`
-	TotalCount int          `json:"totalCount"`
-	TokenUsage *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
-	Status     *TraceStatus `json:"status,omitempty"`     // Trace status including error information
+	Spans      []Span            `json:"spans"`
+	TotalCount int               `json:"totalCount"`
+	TokenUsage *TokenUsage       `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
+	ModelUsage []ModelTokenUsage `json:"modelUsage,omitempty"` // Token usage of GenAI spans per vendor and model
+	Status     *TraceStatus      `json:"status,omitempty"`     // Trace status including error information
+}
+
+type MetricsParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Interval       string
+}
+
+type MetricsResponse struct {
+	Interval string          `json:"interval"`
+	Summary  MetricsBucket   `json:"summary"`
+	Buckets  []MetricsBucket `json:"buckets"`
+}
+
+type MetricsBucket struct {
+	Timestamp    string              `json:"timestamp,omitempty"`
+	RequestCount int                 `json:"requestCount"`
+	ErrorCount   int                 `json:"errorCount"`
+	ErrorRate    float64             `json:"errorRate"`
+	Latency      *LatencyPercentiles `json:"latency,omitempty"` // Root span latency, nil when there are no requests
+	TokenUsage   TokenUsage          `json:"tokenUsage"`
+}
+
+type LatencyPercentiles struct {
+	P50InNanos int64 `json:"p50InNanos"`
+	P95InNanos int64 `json:"p95InNanos"`
+	P99InNanos int64 `json:"p99InNanos"`
+}
+
+type TokenUsageParams struct {
+	ComponentUids  []string
+	EnvironmentUid string // Optional, all environments when empty
+	StartTime      string
+	EndTime        string
+}
+
+type TokenUsageResponse struct {
+	Usage []DailyTokenUsage `json:"usage"`
+}
+
+// DailyTokenUsage holds the token usage of a component, vendor and model on a day (UTC)
+type DailyTokenUsage struct {
+	ComponentUid string `json:"componentUid"`
+	Date         string `json:"date"` // YYYY-MM-DD
+	ModelTokenUsage
+}
+
+type ListSessionsParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Limit          int
+	Offset         int
+	OrgName        string // Organization whose redaction policy the trace observer applies
+}
+
+type GetSessionParams struct {
+	SessionID      string
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string // Optional, the trace observer searches recent sessions when empty
+	EndTime        string
+	OrgName        string // Organization whose redaction policy the trace observer applies
+}
+
+// SessionOverview summarizes the turns (traces) of a session
+type SessionOverview struct {
+	SessionID  string     `json:"sessionId"`
+	StartTime  string     `json:"startTime"`
+	EndTime    string     `json:"endTime"`
+	TurnCount  int        `json:"turnCount"`
+	ErrorCount int        `json:"errorCount"` // Number of turns with at least one error span
+	TokenUsage TokenUsage `json:"tokenUsage"`
+}
+
+type SessionListResponse struct {
+	Sessions   []SessionOverview `json:"sessions"`
+	TotalCount int               `json:"totalCount"`
+	Truncated  bool              `json:"truncated,omitempty"` // Too many spans or traces to load, so some sessions may be missing or incomplete
+}
+
+// SessionResponse holds a session with its turns ordered by start time
+type SessionResponse struct {
+	SessionOverview
+	Turns     []TraceOverview `json:"turns"`
+	Truncated bool            `json:"truncated,omitempty"` // Too many spans or traces to load, so some turns may be missing or incomplete
 }
@@ -17,6 +17,7 @@
 package traceobserversvc
 
 import (
+	"encoding/json"
 	"net/http"
 )
 
@@ -27,3 +28,26 @@ func IsNotFound(err error) bool {
 	}
 	return false
 }
+
+func IsBadRequest(err error) bool {
+	if httpErr, ok := err.(*HTTPError); ok {
+		return httpErr.StatusCode == http.StatusBadRequest
+	}
+	return false
+}
+
+// ErrorMessage returns the message of an error response of the trace observer, or the error text
+// when the response has no message
+func ErrorMessage(err error) string {
+	httpErr, ok := err.(*HTTPError)
+	if !ok {
+		return err.Error()
+	}
+	var response struct {
+		Message string `json:"message"`
+	}
+	if jsonErr := json.Unmarshal([]byte(httpErr.Message), &response); jsonErr != nil || response.Message == "" {
+		return httpErr.Message
+	}
+	return response.Message
+}
@@ -49,6 +49,15 @@ type Config struct {
 	// Trace Observer service configuration (for distributed tracing)
 	TraceObserver TraceObserverConfig
 
+	// Secrets configuration (for secret environment variables)
+	Secrets SecretsConfig
+
+	// JWT verification configuration
+	JWT JWTConfig
+
+	// Build and deployment webhook configuration
+	Webhooks WebhooksConfig
+
 	IsLocalDevEnv bool
 
 	// Default Chat API configuration
@@ -89,6 +98,62 @@ type ObserverConfig struct {
 type TraceObserverConfig struct {
 	// Trace Observer service URL
 	URL string
+	// Header carrying the API key, used when the trace observer runs in api-key auth mode
+	APIKeyHeader string
+	// Shared API key sent to the trace observer; not sent when empty. Requests authenticated with the
+	// API key name no organization, so the trace observer applies its default redaction policy to them.
+	APIKey string `json:"-"`
+	// Secret shared with the trace observer in jwt auth mode, used to sign a token scoped to the
+	// components and environments of each request; takes precedence over the API key
+	JWTSecret string `json:"-"`
+	// "iss" and "aud" claims of the signed tokens
+	JWTIssuer   string
+	JWTAudience string
+	// Lifetime of the signed tokens
+	TokenTTLSeconds int
+}
+
+type SecretsConfig struct {
+	// Base64 encoded 32 byte key used to wrap per-secret data keys
+	EncryptionKey string `json:"-"`
+	// Identifier stored alongside each secret, which selects the key that decrypts it
+	EncryptionKeyID string
+	// Comma separated keyId=key pairs of rotated out keys, only used to decrypt the secrets they encrypted
+	PreviousEncryptionKeys string `json:"-"`
+}
+
+type JWTConfig struct {
+	// When enabled, token signatures and registered claims are verified; otherwise claims are only extracted
+	VerificationEnabled bool
+	// URL of the JWKS used to verify token signatures
+	JWKSURL string
+	// Expected "iss" claim; not checked when empty
+	Issuer string
+	// Expected "aud" claim; not checked when empty
+	Audience string
+	// How long fetched signing keys are cached
+	JWKSCacheTTLSeconds int
+	// Allowed clock skew when checking "exp" and "nbf"
+	ClockSkewSeconds int
+}
+
+type WebhooksConfig struct {
+	// When enabled, build and deployment status changes are detected and delivered to webhook subscriptions
+	Enabled bool
+	// How often workflow runs and release bindings are checked for status changes
+	PollIntervalSeconds int
+	// How often pending deliveries are sent
+	DeliveryIntervalSeconds int
+	// Timeout of a single delivery request
+	DeliveryTimeoutSeconds int
+	// Number of attempts before a delivery is marked as failed
+	MaxDeliveryAttempts int
+	// Delay before the first retry; doubled on every further attempt
+	RetryBaseDelaySeconds int
+	// Upper bound of the delay between retries
+	RetryMaxDelaySeconds int
+	// When set, webhooks may target loopback, link-local and private addresses, e.g. in local development
+	AllowPrivateNetworks bool
 }
 
 type POSTGRESQL struct {
@@ -130,7 +130,43 @@ func loadEnvs() {
 
 	// Trace Observer service configuration - for distributed tracing
 	config.TraceObserver = TraceObserverConfig{
-		URL: r.readOptionalString("TRACE_OBSERVER_URL", "http://localhost:9098"),
+		URL:             r.readOptionalString("TRACE_OBSERVER_URL", "http://localhost:9098"),
+		APIKeyHeader:    r.readOptionalString("TRACE_OBSERVER_API_KEY_HEADER", "X-API-Key"),
+		APIKey:          r.readOptionalString("TRACE_OBSERVER_API_KEY", ""),
+		JWTSecret:       r.readOptionalString("TRACE_OBSERVER_JWT_SECRET", ""),
+		JWTIssuer:       r.readOptionalString("TRACE_OBSERVER_JWT_ISSUER", "agent-manager-service"),
+		JWTAudience:     r.readOptionalString("TRACE_OBSERVER_JWT_AUDIENCE", "traces-observer-service"),
+		TokenTTLSeconds: int(r.readOptionalInt64("TRACE_OBSERVER_TOKEN_TTL_SECONDS", 60)),
+	}
+
+	// Secrets configuration - secret environment variables are rejected when no key is configured
+	config.Secrets = SecretsConfig{
+		EncryptionKey:   r.readOptionalString("SECRETS_ENCRYPTION_KEY", ""),
+		EncryptionKeyID: r.readOptionalString("SECRETS_ENCRYPTION_KEY_ID", "default"),
+		// Keys are rotated by setting a new key and ID and moving the old ones here
+		PreviousEncryptionKeys: r.readOptionalString("SECRETS_PREVIOUS_ENCRYPTION_KEYS", ""),
+	}
+
+	// JWT verification configuration - disabled when the service runs behind a trusted gateway
+	config.JWT = JWTConfig{
+		VerificationEnabled: r.readOptionalBool("JWT_VERIFICATION_ENABLED", false),
+		JWKSURL:             r.readOptionalString("JWT_JWKS_URL", ""),
+		Issuer:              r.readOptionalString("JWT_ISSUER", ""),
+		Audience:            r.readOptionalString("JWT_AUDIENCE", ""),
+		JWKSCacheTTLSeconds: int(r.readOptionalInt64("JWT_JWKS_CACHE_TTL_SECONDS", 300)),
+		ClockSkewSeconds:    int(r.readOptionalInt64("JWT_CLOCK_SKEW_SECONDS", 60)),
+	}
+
+	// Webhook configuration - status changes are only tracked while enabled
+	config.Webhooks = WebhooksConfig{
+		Enabled:                 r.readOptionalBool("WEBHOOKS_ENABLED", true),
+		PollIntervalSeconds:     int(r.readOptionalInt64("WEBHOOKS_POLL_INTERVAL_SECONDS", 15)),
+		DeliveryIntervalSeconds: int(r.readOptionalInt64("WEBHOOKS_DELIVERY_INTERVAL_SECONDS", 5)),
+		DeliveryTimeoutSeconds:  int(r.readOptionalInt64("WEBHOOKS_DELIVERY_TIMEOUT_SECONDS", 10)),
+		MaxDeliveryAttempts:     int(r.readOptionalInt64("WEBHOOKS_MAX_DELIVERY_ATTEMPTS", 6)),
+		RetryBaseDelaySeconds:   int(r.readOptionalInt64("WEBHOOKS_RETRY_BASE_DELAY_SECONDS", 30)),
+		RetryMaxDelaySeconds:    int(r.readOptionalInt64("WEBHOOKS_RETRY_MAX_DELAY_SECONDS", 3600)),
+		AllowPrivateNetworks:    r.readOptionalBool("WEBHOOKS_ALLOW_PRIVATE_NETWORKS", false),
 	}
 
 	config.IsLocalDevEnv = r.readOptionalBool("IS_LOCAL_DEV_ENV", false)
@@ -138,12 +174,57 @@ func loadEnvs() {
 
 	// Validate HTTP server configurations
 	validateHTTPServerConfigs(config, r)
+	validateJWTConfigs(config, r)
+	validateTraceObserverConfigs(config, r)
+	validateWebhookConfigs(config, r)
 
 	r.logAndExitIfErrorsFound()
 
 	slog.Info("configReader: configs loaded")
 }
 
+func validateJWTConfigs(cfg *Config, r *configReader) {
+	if !cfg.JWT.VerificationEnabled {
+		return
+	}
+	if cfg.JWT.JWKSURL == "" {
+		r.errors = append(r.errors, fmt.Errorf("JWT_JWKS_URL is required when JWT_VERIFICATION_ENABLED is true"))
+	}
+	if cfg.JWT.JWKSCacheTTLSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("JWT_JWKS_CACHE_TTL_SECONDS must be greater than 0, got %d", cfg.JWT.JWKSCacheTTLSeconds))
+	}
+	if cfg.JWT.ClockSkewSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("JWT_CLOCK_SKEW_SECONDS must not be negative, got %d", cfg.JWT.ClockSkewSeconds))
+	}
+}
+
+func validateTraceObserverConfigs(cfg *Config, r *configReader) {
+	if cfg.TraceObserver.JWTSecret != "" && cfg.TraceObserver.TokenTTLSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRACE_OBSERVER_TOKEN_TTL_SECONDS must be greater than 0, got %d", cfg.TraceObserver.TokenTTLSeconds))
+	}
+}
+
+func validateWebhookConfigs(cfg *Config, r *configReader) {
+	if !cfg.Webhooks.Enabled {
+		return
+	}
+	if cfg.Webhooks.PollIntervalSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("WEBHOOKS_POLL_INTERVAL_SECONDS must be greater than 0, got %d", cfg.Webhooks.PollIntervalSeconds))
+	}
+	if cfg.Webhooks.DeliveryIntervalSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("WEBHOOKS_DELIVERY_INTERVAL_SECONDS must be greater than 0, got %d", cfg.Webhooks.DeliveryIntervalSeconds))
+	}
+	if cfg.Webhooks.DeliveryTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("WEBHOOKS_DELIVERY_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.Webhooks.DeliveryTimeoutSeconds))
+	}
+	if cfg.Webhooks.MaxDeliveryAttempts <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("WEBHOOKS_MAX_DELIVERY_ATTEMPTS must be greater than 0, got %d", cfg.Webhooks.MaxDeliveryAttempts))
+	}
+	if cfg.Webhooks.RetryBaseDelaySeconds <= 0 || cfg.Webhooks.RetryMaxDelaySeconds < cfg.Webhooks.RetryBaseDelaySeconds {
+		r.errors = append(r.errors, fmt.Errorf("WEBHOOKS_RETRY_BASE_DELAY_SECONDS must be greater than 0 and not exceed WEBHOOKS_RETRY_MAX_DELAY_SECONDS"))
+	}
+}
+
 func validateHTTPServerConfigs(cfg *Config, r *configReader) {
 	if cfg.ServerPort < 1 || cfg.ServerPort > 65535 {
 		r.errors = append(r.errors, fmt.Errorf("SERVER_PORT must be between 1 and 65535, got %d", cfg.ServerPort))
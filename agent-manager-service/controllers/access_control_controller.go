@@ -0,0 +1,270 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type AccessControlController interface {
+	ListOrganizationMembers(w http.ResponseWriter, r *http.Request)
+	SetOrganizationMemberRole(w http.ResponseWriter, r *http.Request)
+	RemoveOrganizationMember(w http.ResponseWriter, r *http.Request)
+	ListProjectMembers(w http.ResponseWriter, r *http.Request)
+	SetProjectMemberRole(w http.ResponseWriter, r *http.Request)
+	RemoveProjectMember(w http.ResponseWriter, r *http.Request)
+}
+
+type accessControlController struct {
+	accessControlManager services.AccessControlManager
+}
+
+// NewAccessControlController returns a new AccessControlController instance.
+func NewAccessControlController(accessControlManager services.AccessControlManager) AccessControlController {
+	return &accessControlController{
+		accessControlManager: accessControlManager,
+	}
+}
+
+func (c *accessControlController) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	members, err := c.accessControlManager.ListOrganizationMembers(ctx, userIdpId, orgName)
+	if err != nil {
+		log.Error("ListOrganizationMembers: failed to list organization members", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list organization members")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToMemberListResponse(members))
+}
+
+func (c *accessControlController) SetOrganizationMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	memberIdpId, err := uuid.Parse(r.PathValue(utils.PathParamUserIdpId))
+	if err != nil {
+		log.Error("SetOrganizationMemberRole: invalid user ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload spec.UpdateMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetOrganizationMemberRole: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	member, err := c.accessControlManager.SetOrganizationMemberRole(ctx, userIdpId, orgName, memberIdpId, utils.Role(payload.Role))
+	if err != nil {
+		log.Error("SetOrganizationMemberRole: failed to set organization member role", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrInvalidRole) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, utils.ErrCannotModifyOrgOwner) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Cannot change the role of the organization owner")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to set organization member role")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToMemberResponse(member))
+}
+
+func (c *accessControlController) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	memberIdpId, err := uuid.Parse(r.PathValue(utils.PathParamUserIdpId))
+	if err != nil {
+		log.Error("RemoveOrganizationMember: invalid user ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	err = c.accessControlManager.RemoveOrganizationMember(ctx, userIdpId, orgName, memberIdpId)
+	if err != nil {
+		log.Error("RemoveOrganizationMember: failed to remove organization member", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrCannotModifyOrgOwner) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Cannot remove the organization owner")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to remove organization member")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+func (c *accessControlController) ListProjectMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projectName := r.PathValue(utils.PathParamProjName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	members, err := c.accessControlManager.ListProjectMembers(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		log.Error("ListProjectMembers: failed to list project members", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list project members")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToMemberListResponse(members))
+}
+
+func (c *accessControlController) SetProjectMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projectName := r.PathValue(utils.PathParamProjName)
+	memberIdpId, err := uuid.Parse(r.PathValue(utils.PathParamUserIdpId))
+	if err != nil {
+		log.Error("SetProjectMemberRole: invalid user ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload spec.UpdateMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetProjectMemberRole: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	member, err := c.accessControlManager.SetProjectMemberRole(ctx, userIdpId, orgName, projectName, memberIdpId, utils.Role(payload.Role))
+	if err != nil {
+		log.Error("SetProjectMemberRole: failed to set project member role", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrInvalidRole) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to set project member role")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToMemberResponse(member))
+}
+
+func (c *accessControlController) RemoveProjectMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projectName := r.PathValue(utils.PathParamProjName)
+	memberIdpId, err := uuid.Parse(r.PathValue(utils.PathParamUserIdpId))
+	if err != nil {
+		log.Error("RemoveProjectMember: invalid user ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	err = c.accessControlManager.RemoveProjectMember(ctx, userIdpId, orgName, projectName, memberIdpId)
+	if err != nil {
+		log.Error("RemoveProjectMember: failed to remove project member", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to remove project member")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
@@ -17,15 +17,18 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
@@ -35,15 +38,20 @@ type AgentController interface {
 	ListAgents(w http.ResponseWriter, r *http.Request)
 	GetAgent(w http.ResponseWriter, r *http.Request)
 	CreateAgent(w http.ResponseWriter, r *http.Request)
+	UpdateAgent(w http.ResponseWriter, r *http.Request)
 	DeleteAgent(w http.ResponseWriter, r *http.Request)
 	BuildAgent(w http.ResponseWriter, r *http.Request)
 	DeployAgent(w http.ResponseWriter, r *http.Request)
+	PromoteAgent(w http.ResponseWriter, r *http.Request)
+	GetDeploymentHistory(w http.ResponseWriter, r *http.Request)
+	RollbackAgent(w http.ResponseWriter, r *http.Request)
 	ListAgentBuilds(w http.ResponseWriter, r *http.Request)
 	GetAgentDeployments(w http.ResponseWriter, r *http.Request)
 	GetAgentEndpoints(w http.ResponseWriter, r *http.Request)
 	GetBuild(w http.ResponseWriter, r *http.Request)
 	GetAgentConfigurations(w http.ResponseWriter, r *http.Request)
 	GetBuildLogs(w http.ResponseWriter, r *http.Request)
+	StreamBuildLogs(w http.ResponseWriter, r *http.Request)
 	GenerateName(w http.ResponseWriter, r *http.Request)
 }
 
@@ -196,9 +204,20 @@ func (c *agentController) CreateAgent(w http.ResponseWriter, r *http.Request) {
 			utils.WriteErrorResponse(w, http.StatusConflict, "Agent already exists")
 			return
 		}
+		if errors.Is(err, utils.ErrSecretsNotConfigured) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Secret environment variables are not enabled")
+			return
+		}
+		if errors.Is(err, utils.ErrSecretValueRequired) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create agent")
 		return
 	}
+	if payload.RuntimeConfigs != nil {
+		payload.RuntimeConfigs.Env = utils.MaskSecretEnvironmentVariables(payload.RuntimeConfigs.Env)
+	}
 	response := &spec.AgentResponse{
 		Name:           payload.Name,
 		DisplayName:    payload.DisplayName,
@@ -213,6 +232,63 @@ func (c *agentController) CreateAgent(w http.ResponseWriter, r *http.Request) {
 	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
 }
 
+func (c *agentController) UpdateAgent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	// Parse and validate request body
+	var payload spec.UpdateAgentRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateAgent: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := utils.ValidateAgentUpdatePayload(payload); err != nil {
+		log.Error("UpdateAgent: invalid agent payload", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	agent, err := c.agentService.UpdateAgent(ctx, userIdpId, orgName, projName, agentName, &payload)
+	if err != nil {
+		log.Error("UpdateAgent: failed to update agent", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrInvalidAgentUpdate) || errors.Is(err, utils.ErrSecretValueRequired) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, utils.ErrSecretsNotConfigured) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Secret environment variables are not enabled")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to update agent")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToAgentResponse(agent))
+}
+
 func (c *agentController) DeleteAgent(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
@@ -292,35 +368,108 @@ func (c *agentController) GetBuildLogs(w http.ResponseWriter, r *http.Request) {
 	agentName := r.PathValue(utils.PathParamAgentName)
 	buildName := r.PathValue(utils.PathParamBuildName)
 
+	// Parse query parameters
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		limitStr = strconv.Itoa(utils.DefaultBuildLogsLimit)
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < utils.MinLimit || limit > utils.MaxBuildLogsLimit {
+		log.Error("GetBuildLogs: invalid limit parameter", "limit", limitStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit parameter: must be between %d and %d", utils.MinLimit, utils.MaxBuildLogsLimit))
+		return
+	}
+	cursor := r.URL.Query().Get("cursor")
+
 	// Extract user info from JWT token
 	tokenClaims := jwtassertion.GetTokenClaims(ctx)
 	userIdpId := tokenClaims.Sub
-	buildLogs, err := c.agentService.GetBuildLogs(ctx, userIdpId, orgName, projName, agentName, buildName)
+	buildLogs, err := c.agentService.GetBuildLogs(ctx, userIdpId, orgName, projName, agentName, buildName, limit, cursor)
 	if err != nil {
 		log.Error("GetBuildLogs: failed to get build logs", "error", err)
-		if errors.Is(err, utils.ErrOrganizationNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
-			return
-		}
-		if errors.Is(err, utils.ErrProjectNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
-			return
-		}
-		if errors.Is(err, utils.ErrAgentNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
-			return
-		}
-		if errors.Is(err, utils.ErrBuildNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Build not found")
-			return
-		}
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get build logs")
+		writeBuildLogsError(w, err)
 		return
 	}
 	buildLogsResponse := utils.ConvertToBuildLogsResponse(*buildLogs)
 	utils.WriteSuccessResponse(w, http.StatusOK, buildLogsResponse)
 }
 
+func (c *agentController) StreamBuildLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	buildName := r.PathValue(utils.PathParamBuildName)
+
+	// Reconnecting EventSource clients send the id of the last event they received
+	cursor := r.Header.Get("Last-Event-ID")
+	if cursor == "" {
+		cursor = r.URL.Query().Get("cursor")
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	// The stream is started with the first event, so that validation errors are still returned as regular responses
+	var sse *utils.SSEWriter
+	emit := func(event models.LogStreamEvent) error {
+		if sse == nil {
+			var err error
+			if sse, err = utils.NewSSEWriter(w); err != nil {
+				return err
+			}
+		}
+		switch event.Type {
+		case utils.LogStreamEventLog:
+			return sse.WriteEvent(event.Cursor, event.Type, utils.ConvertToLogEntry(*event.Log))
+		case utils.LogStreamEventComplete:
+			return sse.WriteEvent(event.Cursor, event.Type, spec.BuildLogStreamComplete{Status: event.Status})
+		default:
+			return sse.WriteComment(event.Type)
+		}
+	}
+	err := c.agentService.StreamBuildLogs(ctx, userIdpId, orgName, projName, agentName, buildName, cursor, emit)
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	log.Error("StreamBuildLogs: failed to stream build logs", "error", err)
+	if sse == nil {
+		writeBuildLogsError(w, err)
+		return
+	}
+	if writeErr := sse.WriteEvent("", "error", spec.ErrorResponse{Message: "Failed to stream build logs"}); writeErr != nil {
+		log.Debug("StreamBuildLogs: failed to write error event", "error", writeErr)
+	}
+}
+
+func writeBuildLogsError(w http.ResponseWriter, err error) {
+	if errors.Is(err, utils.ErrInvalidCursor) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cursor")
+		return
+	}
+	if errors.Is(err, utils.ErrOrganizationNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+		return
+	}
+	if errors.Is(err, utils.ErrProjectNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+		return
+	}
+	if errors.Is(err, utils.ErrAgentNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+		return
+	}
+	if errors.Is(err, utils.ErrBuildNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Build not found")
+		return
+	}
+	utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get build logs")
+}
+
 func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
@@ -347,6 +496,11 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if err := utils.ValidateEnvironmentVariables(payload.Env); err != nil {
+		log.Error("DeployAgent: invalid environment variables", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	deployedEnv, err := c.agentService.DeployAgent(ctx, userIdpId, orgName, projName, agentName, &payload)
 	if err != nil {
@@ -363,6 +517,14 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
 			return
 		}
+		if errors.Is(err, utils.ErrSecretsNotConfigured) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Secret environment variables are not enabled")
+			return
+		}
+		if errors.Is(err, utils.ErrSecretValueRequired) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to deploy agent")
 		return
 	}
@@ -376,6 +538,159 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
 }
 
+func (c *agentController) PromoteAgent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sourceEnv := r.PathValue(utils.PathParamEnvironment)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	// Request body is optional; the target defaults to the next environment in the pipeline
+	var payload spec.PromoteAgentRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil && !errors.Is(err, io.EOF) {
+		log.Error("PromoteAgent: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	promotion, err := c.agentService.PromoteAgent(ctx, userIdpId, orgName, projName, agentName, sourceEnv, &payload)
+	if err != nil {
+		log.Error("PromoteAgent: failed to promote agent", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		if errors.Is(err, utils.ErrDeploymentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Agent is not deployed in environment %s", sourceEnv))
+			return
+		}
+		if errors.Is(err, utils.ErrInvalidPromotionPath) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Promotion path is not allowed by the deployment pipeline")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to promote agent")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, utils.ConvertToPromoteAgentResponse(promotion))
+}
+
+func (c *agentController) GetDeploymentHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.PathValue(utils.PathParamEnvironment)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	revisions, err := c.agentService.GetDeploymentHistory(ctx, userIdpId, orgName, projName, agentName, environment)
+	if err != nil {
+		log.Error("GetDeploymentHistory: failed to get deployment history", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get deployment history")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToDeploymentHistoryResponse(revisions))
+}
+
+func (c *agentController) RollbackAgent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.PathValue(utils.PathParamEnvironment)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	// Parse and validate request body
+	var payload spec.RollbackAgentRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("RollbackAgent: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if payload.Revision < 1 {
+		log.Error("RollbackAgent: revision must be a positive number", "revision", payload.Revision)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	revision, err := c.agentService.RollbackAgent(ctx, userIdpId, orgName, projName, agentName, environment, &payload)
+	if err != nil {
+		log.Error("RollbackAgent: failed to rollback agent", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrDeploymentRevisionNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Deployment revision %d not found in environment %s", payload.Revision, environment))
+			return
+		}
+		if errors.Is(err, utils.ErrDeploymentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Agent is not deployed in environment %s", environment))
+			return
+		}
+		if errors.Is(err, utils.ErrRollbackNotSupported) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Revision %d of environment %s has no recorded release to roll back to; promote the revision again instead", payload.Revision, environment))
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to rollback agent")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, utils.ConvertToDeploymentRevisionResponse(revision))
+}
+
 func (c *agentController) ListAgentBuilds(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
@@ -658,6 +973,9 @@ func (c *agentController) GetAgentConfigurations(w http.ResponseWriter, r *http.
 			Key:   config.Key,
 			Value: config.Value,
 		}
+		if config.IsSecret {
+			configurationItems[i].IsSecret = spec.PtrBool(true)
+		}
 	}
 
 	configurationsResponse := spec.ConfigurationResponse{
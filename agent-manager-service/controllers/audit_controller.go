@@ -0,0 +1,138 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type AuditController interface {
+	ListAuditEvents(w http.ResponseWriter, r *http.Request)
+}
+
+type auditController struct {
+	auditManager services.AuditManager
+}
+
+// NewAuditController returns a new AuditController instance.
+func NewAuditController(auditManager services.AuditManager) AuditController {
+	return &auditController{
+		auditManager: auditManager,
+	}
+}
+
+func (c *auditController) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	// Parse query parameters
+	query := r.URL.Query()
+	limitStr := query.Get("limit")
+	if limitStr == "" {
+		limitStr = strconv.Itoa(utils.DefaultLimit)
+	}
+	offsetStr := query.Get("offset")
+	if offsetStr == "" {
+		offsetStr = strconv.Itoa(utils.DefaultOffset)
+	}
+
+	// Parse and validate pagination parameters
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < utils.MinLimit || limit > utils.MaxLimit {
+		log.Error("ListAuditEvents: invalid limit parameter", "limit", limitStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit parameter: must be between %d and %d", utils.MinLimit, utils.MaxLimit))
+		return
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < utils.MinOffset {
+		log.Error("ListAuditEvents: invalid offset parameter", "offset", offsetStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid offset parameter: must be %d or greater", utils.MinOffset))
+		return
+	}
+
+	// Parse filters
+	filter := models.AuditEventFilter{
+		Action:      query.Get("action"),
+		ProjectName: query.Get("projectName"),
+		AgentName:   query.Get("agentName"),
+		Result:      query.Get("result"),
+	}
+	if actor := query.Get("actor"); actor != "" {
+		actorId, err := uuid.Parse(actor)
+		if err != nil {
+			log.Error("ListAuditEvents: invalid actor parameter", "actor", actor)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid actor parameter: must be a user ID")
+			return
+		}
+		filter.Actor = &actorId
+	}
+	if filter.Result != "" && filter.Result != utils.AuditResultSuccess && filter.Result != utils.AuditResultFailure {
+		log.Error("ListAuditEvents: invalid result parameter", "result", filter.Result)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid result parameter: must be 'success' or 'failure'")
+		return
+	}
+	if startTime := query.Get("startTime"); startTime != "" {
+		from, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			log.Error("ListAuditEvents: invalid startTime format", "startTime", startTime)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return
+		}
+		filter.From = &from
+	}
+	if endTime := query.Get("endTime"); endTime != "" {
+		to, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			log.Error("ListAuditEvents: invalid endTime format", "endTime", endTime)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return
+		}
+		filter.To = &to
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	events, total, err := c.auditManager.ListAuditEvents(ctx, userIdpId, orgName, filter, limit, offset)
+	if err != nil {
+		log.Error("ListAuditEvents: failed to list audit events", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list audit events")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToAuditEventListResponse(events, total, int32(limit), int32(offset)))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type ModelPriceController interface {
+	CreateModelPrice(w http.ResponseWriter, r *http.Request)
+	ListModelPrices(w http.ResponseWriter, r *http.Request)
+	UpdateModelPrice(w http.ResponseWriter, r *http.Request)
+	DeleteModelPrice(w http.ResponseWriter, r *http.Request)
+}
+
+type modelPriceController struct {
+	modelPriceManager services.ModelPriceManager
+}
+
+// NewModelPriceController returns a new ModelPriceController instance.
+func NewModelPriceController(modelPriceManager services.ModelPriceManager) ModelPriceController {
+	return &modelPriceController{
+		modelPriceManager: modelPriceManager,
+	}
+}
+
+func (c *modelPriceController) CreateModelPrice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload spec.ModelPriceRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateModelPrice: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateModelPricePayload(payload); err != nil {
+		log.Error("CreateModelPrice: invalid model price payload", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	price, err := c.modelPriceManager.CreateModelPrice(ctx, userIdpId, orgName, &payload)
+	if err != nil {
+		log.Error("CreateModelPrice: failed to create model price", "error", err)
+		writeModelPriceError(w, err, "Failed to create model price")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, utils.ConvertToModelPriceResponse(price))
+}
+
+func (c *modelPriceController) ListModelPrices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	prices, err := c.modelPriceManager.ListModelPrices(ctx, userIdpId, orgName)
+	if err != nil {
+		log.Error("ListModelPrices: failed to list model prices", "error", err)
+		writeModelPriceError(w, err, "Failed to list model prices")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToModelPriceListResponse(prices))
+}
+
+func (c *modelPriceController) UpdateModelPrice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	priceId, err := uuid.Parse(r.PathValue(utils.PathParamPriceId))
+	if err != nil {
+		log.Error("UpdateModelPrice: invalid model price ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid model price ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload spec.ModelPriceRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateModelPrice: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateModelPricePayload(payload); err != nil {
+		log.Error("UpdateModelPrice: invalid model price payload", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	price, err := c.modelPriceManager.UpdateModelPrice(ctx, userIdpId, orgName, priceId, &payload)
+	if err != nil {
+		log.Error("UpdateModelPrice: failed to update model price", "error", err)
+		writeModelPriceError(w, err, "Failed to update model price")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToModelPriceResponse(price))
+}
+
+func (c *modelPriceController) DeleteModelPrice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	priceId, err := uuid.Parse(r.PathValue(utils.PathParamPriceId))
+	if err != nil {
+		log.Error("DeleteModelPrice: invalid model price ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid model price ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	if err := c.modelPriceManager.DeleteModelPrice(ctx, userIdpId, orgName, priceId); err != nil {
+		log.Error("DeleteModelPrice: failed to delete model price", "error", err)
+		writeModelPriceError(w, err, "Failed to delete model price")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+// writeModelPriceError maps model price manager errors to HTTP responses
+func writeModelPriceError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrModelPriceNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Model price not found")
+	case errors.Is(err, utils.ErrInvalidModelPriceRange):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "effectiveTo must be after effectiveFrom")
+	case errors.Is(err, utils.ErrModelPriceConflict):
+		utils.WriteErrorResponse(w, http.StatusConflict, "The effective period overlaps an existing price of the model")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
@@ -17,19 +17,36 @@
 package controllers
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 type ObservabilityController interface {
 	ListTraces(w http.ResponseWriter, r *http.Request)
+	ListProjectTraces(w http.ResponseWriter, r *http.Request)
 	GetTrace(w http.ResponseWriter, r *http.Request)
+	GetOrgTrace(w http.ResponseWriter, r *http.Request)
+	GetMetrics(w http.ResponseWriter, r *http.Request)
+	GetAgentCost(w http.ResponseWriter, r *http.Request)
+	GetProjectCost(w http.ResponseWriter, r *http.Request)
+	ListSessions(w http.ResponseWriter, r *http.Request)
+	GetSession(w http.ResponseWriter, r *http.Request)
+	GetAgentLogs(w http.ResponseWriter, r *http.Request)
+	StreamAgentLogs(w http.ResponseWriter, r *http.Request)
 }
 
 type observabilityController struct {
@@ -52,6 +69,678 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 	projName := r.PathValue(utils.PathParamProjName)
 	agentName := r.PathValue(utils.PathParamAgentName)
 
+	params, ok := parseListTracesRequest(w, r, "ListTraces")
+	if !ok {
+		return
+	}
+	params.OrgName = orgName
+	params.ProjectName = projName
+	params.AgentName = agentName
+
+	// Call the service
+	response, err := c.observabilityService.ListTraces(ctx, params)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		if errors.Is(err, utils.ErrInvalidTraceFilter) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		log.Error("ListTraces: failed to list traces", "serviceName", agentName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve traces")
+		return
+	}
+
+	log.Info("ListTraces: successfully retrieved traces", "serviceName", agentName, "totalCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) ListProjectTraces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+
+	params, ok := parseListTracesRequest(w, r, "ListProjectTraces")
+	if !ok {
+		return
+	}
+	params.OrgName = orgName
+	params.ProjectName = projName
+
+	response, err := c.observabilityService.ListProjectTraces(ctx, params)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		if errors.Is(err, utils.ErrInvalidTraceFilter) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		log.Error("ListProjectTraces: failed to list traces", "projectName", projName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve traces")
+		return
+	}
+
+	log.Info("ListProjectTraces: successfully retrieved traces", "projectName", projName, "totalCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	// Validate traceID
+	if traceID == "" {
+		log.Error("GetTrace: traceId is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: traceId is required")
+		return
+	}
+
+	// Optional query parameters
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetTrace: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	// The time range is an optional hint of when the trace ran, recent traces are searched when it is omitted
+	startTime, endTime, ok := parseOptionalTimeRange(w, r, "GetTrace")
+	if !ok {
+		return
+	}
+
+	// A full trace also has the spans of the other agents that took part in the trace
+	fullTrace := false
+	if fullTraceStr := r.URL.Query().Get("fullTrace"); fullTraceStr != "" {
+		parsedFullTrace, err := strconv.ParseBool(fullTraceStr)
+		if err != nil {
+			log.Error("GetTrace: invalid fullTrace parameter", "fullTrace", fullTraceStr)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid fullTrace parameter: must be true or false")
+			return
+		}
+		fullTrace = parsedFullTrace
+	}
+
+	// Build parameters for the service
+	params := services.TraceDetailsRequest{
+		TraceID:     traceID,
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		FullTrace:   fullTrace,
+		UserIdpId:   jwtassertion.GetTokenClaims(ctx).Sub,
+	}
+
+	// Call the service
+	response, err := c.observabilityService.GetTraceDetails(ctx, params)
+	if err != nil {
+		// Check if it's a "not found" error
+		if errors.Is(err, services.ErrTraceNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Trace not found")
+			return
+		}
+		// Other errors are internal server errors
+		log.Error("GetTrace: failed to get trace details", "traceId", traceID, "agentName", agentName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve trace details")
+		return
+	}
+
+	log.Info("GetTrace: successfully retrieved trace details", "traceId", traceID, "agentName", agentName, "spanCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetOrgTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetOrgTrace: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	// The time range is an optional hint of when the trace ran, recent traces are searched when it is omitted
+	startTime, endTime, ok := parseOptionalTimeRange(w, r, "GetOrgTrace")
+	if !ok {
+		return
+	}
+
+	params := services.TraceDetailsRequest{
+		TraceID:     traceID,
+		OrgName:     orgName,
+		Environment: environment,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		UserIdpId:   jwtassertion.GetTokenClaims(ctx).Sub,
+	}
+
+	response, err := c.observabilityService.GetOrgTraceDetails(ctx, params)
+	if err != nil {
+		if errors.Is(err, services.ErrTraceNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Trace not found")
+			return
+		}
+		log.Error("GetOrgTrace: failed to get trace details", "traceId", traceID, "orgName", orgName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve trace details")
+		return
+	}
+
+	log.Info("GetOrgTrace: successfully retrieved trace details", "traceId", traceID, "orgName", orgName,
+		"spanCount", response.TotalCount, "agentCount", len(response.Agents))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetMetrics: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	startTimeStr := r.URL.Query().Get("startTime")
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		log.Error("GetMetrics: invalid startTime", "startTime", startTimeStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+		return
+	}
+
+	endTimeStr := r.URL.Query().Get("endTime")
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		log.Error("GetMetrics: invalid endTime", "endTime", endTimeStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+		return
+	}
+
+	if !startTime.Before(endTime) {
+		log.Error("GetMetrics: startTime is not before endTime", "startTime", startTimeStr, "endTime", endTimeStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid time range: startTime must be before endTime")
+		return
+	}
+
+	interval := r.URL.Query().Get("interval")
+	if interval != "" {
+		duration, err := time.ParseDuration(interval)
+		if err != nil || duration < utils.MinMetricsInterval || duration%time.Second != 0 {
+			log.Error("GetMetrics: invalid interval parameter", "interval", interval)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid interval parameter: must be a duration of at least 1m (e.g., 5m, 1h)")
+			return
+		}
+		if endTime.Sub(startTime)/duration > utils.MaxMetricsBuckets {
+			log.Error("GetMetrics: interval too small for time range", "interval", interval)
+			utils.WriteErrorResponse(w, http.StatusBadRequest,
+				fmt.Sprintf("Invalid interval parameter: the time range must not contain more than %d intervals", utils.MaxMetricsBuckets))
+			return
+		}
+	}
+
+	params := services.MetricsRequest{
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+		StartTime:   startTimeStr,
+		EndTime:     endTimeStr,
+		Interval:    interval,
+	}
+
+	response, err := c.observabilityService.GetMetrics(ctx, params)
+	if err != nil {
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		log.Error("GetMetrics: failed to get metrics", "agentName", agentName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve metrics")
+		return
+	}
+
+	log.Info("GetMetrics: successfully retrieved metrics", "agentName", agentName, "bucketCount", len(response.Buckets))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetAgentCost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	params, err := parseCostRequest(r.URL.Query())
+	if err != nil {
+		log.Error("GetAgentCost: invalid parameters", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cost parameter: "+err.Error())
+		return
+	}
+	params.OrgName = orgName
+	params.ProjectName = projName
+	params.AgentName = agentName
+
+	response, err := c.observabilityService.GetAgentCost(ctx, params)
+	if err != nil {
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		log.Error("GetAgentCost: failed to get agent cost", "agentName", agentName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve agent cost")
+		return
+	}
+
+	log.Info("GetAgentCost: successfully retrieved agent cost", "agentName", agentName, "modelCount", len(response.Models))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetProjectCost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+
+	params, err := parseCostRequest(r.URL.Query())
+	if err != nil {
+		log.Error("GetProjectCost: invalid parameters", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cost parameter: "+err.Error())
+		return
+	}
+	params.OrgName = orgName
+	params.ProjectName = projName
+
+	response, err := c.observabilityService.GetProjectCost(ctx, params)
+	if err != nil {
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		log.Error("GetProjectCost: failed to get project cost", "projectName", projName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve project cost")
+		return
+	}
+
+	log.Info("GetProjectCost: successfully retrieved project cost", "projectName", projName, "agentCount", len(response.Agents))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) ListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ListSessions: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	startTimeStr := r.URL.Query().Get("startTime")
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		log.Error("ListSessions: invalid startTime", "startTime", startTimeStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+		return
+	}
+
+	endTimeStr := r.URL.Query().Get("endTime")
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		log.Error("ListSessions: invalid endTime", "endTime", endTimeStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+		return
+	}
+
+	if !startTime.Before(endTime) {
+		log.Error("ListSessions: startTime is not before endTime", "startTime", startTimeStr, "endTime", endTimeStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid time range: startTime must be before endTime")
+		return
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		limitStr = "10"
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < 1 || limit > 100 {
+		log.Error("ListSessions: invalid limit parameter", "limit", limitStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter: must be between 1 and 100")
+		return
+	}
+
+	offsetStr := r.URL.Query().Get("offset")
+	if offsetStr == "" {
+		offsetStr = "0"
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		log.Error("ListSessions: invalid offset parameter", "offset", offsetStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter: must be 0 or greater")
+		return
+	}
+
+	params := services.ListSessionsRequest{
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+		StartTime:   startTimeStr,
+		EndTime:     endTimeStr,
+		Limit:       limit,
+		Offset:      offset,
+	}
+
+	response, err := c.observabilityService.ListSessions(ctx, params)
+	if err != nil {
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		log.Error("ListSessions: failed to list sessions", "agentName", agentName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+
+	log.Info("ListSessions: successfully retrieved sessions", "agentName", agentName, "totalCount", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sessionID := r.PathValue(utils.PathParamSessionId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetSession: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	// The time range is optional, recent sessions are searched when it is omitted
+	startTime, endTime, ok := parseOptionalTimeRange(w, r, "GetSession")
+	if !ok {
+		return
+	}
+
+	params := services.SessionRequest{
+		SessionID:   sessionID,
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+
+	response, err := c.observabilityService.GetSession(ctx, params)
+	if err != nil {
+		if errors.Is(err, services.ErrSessionNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Session not found")
+			return
+		}
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+			return
+		}
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		log.Error("GetSession: failed to get session", "sessionId", sessionID, "agentName", agentName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve session")
+		return
+	}
+
+	log.Info("GetSession: successfully retrieved session", "sessionId", sessionID, "agentName", agentName, "turnCount", len(response.Turns))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetAgentLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	params, ok := parseAgentLogsRequest(w, r, "GetAgentLogs")
+	if !ok {
+		return
+	}
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		limitStr = strconv.Itoa(utils.DefaultAgentLogsLimit)
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < utils.MinLimit || limit > utils.MaxAgentLogsLimit {
+		log.Error("GetAgentLogs: invalid limit parameter", "limit", limitStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit parameter: must be between %d and %d", utils.MinLimit, utils.MaxAgentLogsLimit))
+		return
+	}
+	params.OrgName = orgName
+	params.ProjectName = projName
+	params.AgentName = agentName
+	params.Limit = limit
+	params.Cursor = r.URL.Query().Get("cursor")
+
+	response, err := c.observabilityService.GetAgentLogs(ctx, params)
+	if err != nil {
+		log.Error("GetAgentLogs: failed to get agent logs", "agentName", agentName, "error", err)
+		writeAgentLogsError(w, err)
+		return
+	}
+
+	log.Info("GetAgentLogs: successfully retrieved agent logs", "agentName", agentName, "logCount", len(response.Logs))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) StreamAgentLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	params, ok := parseAgentLogsRequest(w, r, "StreamAgentLogs")
+	if !ok {
+		return
+	}
+	params.OrgName = orgName
+	params.ProjectName = projName
+	params.AgentName = agentName
+	// Reconnecting EventSource clients send the id of the last event they received
+	params.Cursor = r.Header.Get("Last-Event-ID")
+	if params.Cursor == "" {
+		params.Cursor = r.URL.Query().Get("cursor")
+	}
+
+	// The stream is started with the first event, so that validation errors are still returned as regular responses
+	var sse *utils.SSEWriter
+	emit := func(event models.LogStreamEvent) error {
+		if sse == nil {
+			var err error
+			if sse, err = utils.NewSSEWriter(w); err != nil {
+				return err
+			}
+		}
+		switch event.Type {
+		case utils.LogStreamEventLog:
+			return sse.WriteEvent(event.Cursor, event.Type, event.Log)
+		case utils.LogStreamEventComplete:
+			return sse.WriteEvent(event.Cursor, event.Type, struct{}{})
+		default:
+			return sse.WriteComment(event.Type)
+		}
+	}
+	err := c.observabilityService.StreamAgentLogs(ctx, params, emit)
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	log.Error("StreamAgentLogs: failed to stream agent logs", "agentName", agentName, "error", err)
+	if sse == nil {
+		writeAgentLogsError(w, err)
+		return
+	}
+	if writeErr := sse.WriteEvent("", "error", spec.ErrorResponse{Message: "Failed to stream agent logs"}); writeErr != nil {
+		log.Debug("StreamAgentLogs: failed to write error event", "error", writeErr)
+	}
+}
+
+func writeAgentLogsError(w http.ResponseWriter, err error) {
+	if errors.Is(err, utils.ErrInvalidCursor) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cursor")
+		return
+	}
+	if errors.Is(err, utils.ErrAgentNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+		return
+	}
+	if errors.Is(err, utils.ErrEnvironmentNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+		return
+	}
+	if errors.Is(err, services.ErrTraceNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Trace not found")
+		return
+	}
+	utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve agent logs")
+}
+
+// parseAgentLogsRequest parses the environment, time range, level, search and trace query parameters of an
+// agent log request. It writes an error response and returns false when they are invalid.
+func parseAgentLogsRequest(w http.ResponseWriter, r *http.Request, operation string) (services.AgentLogsRequest, bool) {
+	log := logger.GetLogger(r.Context())
+	query := r.URL.Query()
+
+	environment := query.Get("environment")
+	if environment == "" {
+		log.Error(operation + ": environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return services.AgentLogsRequest{}, false
+	}
+	params := services.AgentLogsRequest{
+		Environment: environment,
+		Search:      query.Get("search"),
+		TraceID:     query.Get("traceId"),
+	}
+
+	if since := query.Get("since"); since != "" {
+		startTime, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			log.Error(operation+": invalid since parameter", "since", since)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid since parameter: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return services.AgentLogsRequest{}, false
+		}
+		params.StartTime = startTime
+	}
+	if until := query.Get("until"); until != "" {
+		endTime, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			log.Error(operation+": invalid until parameter", "until", until)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid until parameter: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return services.AgentLogsRequest{}, false
+		}
+		params.EndTime = endTime
+	}
+	if !params.StartTime.IsZero() && !params.EndTime.IsZero() && !params.StartTime.Before(params.EndTime) {
+		log.Error(operation+": since is not before until", "since", params.StartTime, "until", params.EndTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid time range: since must be before until")
+		return services.AgentLogsRequest{}, false
+	}
+
+	if level := query.Get("level"); level != "" {
+		for _, value := range strings.Split(level, ",") {
+			value = strings.ToUpper(strings.TrimSpace(value))
+			if !slices.Contains(utils.AgentLogLevels, value) {
+				log.Error(operation+": invalid level parameter", "level", level)
+				utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid level parameter: must be a comma separated list of "+strings.Join(utils.AgentLogLevels, ", "))
+				return services.AgentLogsRequest{}, false
+			}
+			params.LogLevels = append(params.LogLevels, value)
+		}
+	}
+	return params, true
+}
+
+// parseListTracesRequest parses the pagination, time range, sort order and filter query parameters of a trace list.
+// It writes an error response and returns false when they are invalid.
+func parseListTracesRequest(w http.ResponseWriter, r *http.Request, operation string) (services.ListTracesRequest, bool) {
+	log := logger.GetLogger(r.Context())
+
 	// Parse query parameters
 	limitStr := r.URL.Query().Get("limit")
 	if limitStr == "" {
@@ -65,24 +754,31 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 	// Parse and validate pagination parameters
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil || limit < 1 || limit > 100 {
-		log.Error("ListTraces: invalid limit parameter", "limit", limitStr)
+		log.Error(operation+": invalid limit parameter", "limit", limitStr)
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter: must be between 1 and 100")
-		return
+		return services.ListTracesRequest{}, false
 	}
 
 	offset, err := strconv.Atoi(offsetStr)
 	if err != nil || offset < 0 {
-		log.Error("ListTraces: invalid offset parameter", "offset", offsetStr)
+		log.Error(operation+": invalid offset parameter", "offset", offsetStr)
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter: must be 0 or greater")
-		return
+		return services.ListTracesRequest{}, false
+	}
+
+	cursor := r.URL.Query().Get("cursor")
+	if cursor != "" && offset > 0 {
+		log.Error(operation + ": cursor and offset used together")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid parameters: cursor and offset cannot be used together")
+		return services.ListTracesRequest{}, false
 	}
 
 	// Optional query parameters
 	environment := r.URL.Query().Get("environment")
 	if environment == "" {
-		log.Error("ListTraces: environment is required")
+		log.Error(operation + ": environment is required")
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
-		return
+		return services.ListTracesRequest{}, false
 	}
 
 	startTime := r.URL.Query().Get("startTime")
@@ -91,28 +787,28 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 	// Validate time range parameters if provided
 	if startTime != "" || endTime != "" {
 		if startTime == "" {
-			log.Error("ListTraces: startTime is required")
+			log.Error(operation + ": startTime is required")
 			utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: startTime is required")
-			return
+			return services.ListTracesRequest{}, false
 		}
 		if endTime == "" {
-			log.Error("ListTraces: endTime is required")
+			log.Error(operation + ": endTime is required")
 			utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: endTime is required")
-			return
+			return services.ListTracesRequest{}, false
 		}
 
 		// Validate RFC3339 format for startTime
 		if _, err := time.Parse(time.RFC3339, startTime); err != nil {
-			log.Error("ListTraces: invalid startTime format", "startTime", startTime, "error", err)
+			log.Error(operation+": invalid startTime format", "startTime", startTime, "error", err)
 			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
-			return
+			return services.ListTracesRequest{}, false
 		}
 
 		// Validate RFC3339 format for endTime
 		if _, err := time.Parse(time.RFC3339, endTime); err != nil {
-			log.Error("ListTraces: invalid endTime format", "endTime", endTime, "error", err)
+			log.Error(operation+": invalid endTime format", "endTime", endTime, "error", err)
 			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
-			return
+			return services.ListTracesRequest{}, false
 		}
 	}
 
@@ -121,84 +817,111 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 		sortOrder = "desc"
 	}
 	if sortOrder != "asc" && sortOrder != "desc" {
-		log.Error("ListTraces: invalid sortOrder parameter", "sortOrder", sortOrder)
+		log.Error(operation+": invalid sortOrder parameter", "sortOrder", sortOrder)
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid sortOrder parameter: must be 'asc' or 'desc'")
-		return
+		return services.ListTracesRequest{}, false
 	}
 
-	// Build parameters for the service
-	params := services.ListTracesRequest{
-		OrgName:     orgName,
-		ProjectName: projName,
-		AgentName:   agentName,
+	filters, err := parseTraceFilters(r.URL.Query())
+	if err != nil {
+		log.Error(operation+": invalid filter parameter", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid filter parameter: "+err.Error())
+		return services.ListTracesRequest{}, false
+	}
+
+	return services.ListTracesRequest{
 		Environment: environment,
 		StartTime:   startTime,
 		EndTime:     endTime,
 		Limit:       limit,
 		Offset:      offset,
 		SortOrder:   sortOrder,
+		Cursor:      cursor,
+		Filters:     filters,
+	}, true
+}
+
+// parseOptionalTimeRange validates the optional startTime and endTime query parameters, which are set together.
+// It writes an error response and returns false when they are invalid.
+func parseOptionalTimeRange(w http.ResponseWriter, r *http.Request, operation string) (string, string, bool) {
+	log := logger.GetLogger(r.Context())
+
+	startTime := r.URL.Query().Get("startTime")
+	endTime := r.URL.Query().Get("endTime")
+	if startTime == "" && endTime == "" {
+		return "", "", true
 	}
 
-	// Call the service
-	response, err := c.observabilityService.ListTraces(ctx, params)
+	start, err := time.Parse(time.RFC3339, startTime)
 	if err != nil {
-		log.Error("ListTraces: failed to list traces", "serviceName", agentName, "error", err)
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve traces")
-		return
+		log.Error(operation+": invalid startTime", "startTime", startTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime: must be RFC3339 (e.g., 2025-12-20T10:00:00Z) and set with endTime")
+		return "", "", false
 	}
-
-	log.Info("ListTraces: successfully retrieved traces", "serviceName", agentName, "totalCount", response.TotalCount)
-	utils.WriteSuccessResponse(w, http.StatusOK, response)
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		log.Error(operation+": invalid endTime", "endTime", endTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime: must be RFC3339 (e.g., 2025-12-20T10:00:00Z) and set with startTime")
+		return "", "", false
+	}
+	if !start.Before(end) {
+		log.Error(operation+": startTime is not before endTime", "startTime", startTime, "endTime", endTime)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid time range: startTime must be before endTime")
+		return "", "", false
+	}
+	return startTime, endTime, true
 }
 
-func (c *observabilityController) GetTrace(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	log := logger.GetLogger(ctx)
-
-	// Extract path parameters
-	orgName := r.PathValue(utils.PathParamOrgName)
-	projName := r.PathValue(utils.PathParamProjName)
-	agentName := r.PathValue(utils.PathParamAgentName)
-	traceID := r.PathValue(utils.PathParamTraceId)
-
-	// Validate traceID
-	if traceID == "" {
-		log.Error("GetTrace: traceId is required")
-		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: traceId is required")
-		return
+// parseCostRequest parses the time range and optional environment of a cost rollup
+func parseCostRequest(query url.Values) (services.CostRequest, error) {
+	params := services.CostRequest{
+		Environment: query.Get("environment"),
 	}
 
-	// Optional query parameters
-	environment := r.URL.Query().Get("environment")
-	if environment == "" {
-		log.Error("GetTrace: environment is required")
-		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
-		return
+	startTime, err := time.Parse(time.RFC3339, query.Get("startTime"))
+	if err != nil {
+		return params, fmt.Errorf("startTime must be RFC3339 (e.g., 2025-12-01T00:00:00Z)")
+	}
+	endTime, err := time.Parse(time.RFC3339, query.Get("endTime"))
+	if err != nil {
+		return params, fmt.Errorf("endTime must be RFC3339 (e.g., 2025-12-31T23:59:59Z)")
+	}
+	if !startTime.Before(endTime) {
+		return params, fmt.Errorf("startTime must be before endTime")
+	}
+	if endTime.Sub(startTime) > utils.MaxCostRangeDays*24*time.Hour {
+		return params, fmt.Errorf("time range must not exceed %d days", utils.MaxCostRangeDays)
 	}
 
-	// Build parameters for the service
-	params := services.TraceDetailsRequest{
-		TraceID:     traceID,
-		OrgName:     orgName,
-		ProjectName: projName,
-		AgentName:   agentName,
-		Environment: environment,
+	params.StartTime = startTime
+	params.EndTime = endTime
+	return params, nil
+}
+
+// traceFilterParams are the span and duration filter query parameters, which are passed through to
+// the trace observer so that the filters are validated in one place
+var traceFilterParams = []string{"spanKind", "errorsOnly", "model", "toolName", "search", "attribute", "minDuration", "maxDuration"}
+
+// parseTraceFilters takes the optional trace filter query parameters. Only the rating filter, which is
+// applied from the trace annotations, is validated here; the trace observer validates the others.
+func parseTraceFilters(query url.Values) (services.TraceFilters, error) {
+	filters := services.TraceFilters{}
+
+	for _, name := range traceFilterParams {
+		for _, value := range query[name] {
+			if filters.Params == nil {
+				filters.Params = url.Values{}
+			}
+			filters.Params.Add(name, value)
+		}
 	}
 
-	// Call the service
-	response, err := c.observabilityService.GetTraceDetails(ctx, params)
-	if err != nil {
-		// Check if it's a "not found" error
-		if errors.Is(err, services.ErrTraceNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Trace not found")
-			return
+	if rating := query.Get("rating"); rating != "" {
+		if !utils.IsValidTraceRating(rating) {
+			return filters, fmt.Errorf("rating must be '%s' or '%s'", utils.TraceRatingPositive, utils.TraceRatingNegative)
 		}
-		// Other errors are internal server errors
-		log.Error("GetTrace: failed to get trace details", "traceId", traceID, "agentName", agentName, "error", err)
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve trace details")
-		return
+		filters.Rating = rating
 	}
 
-	log.Info("GetTrace: successfully retrieved trace details", "traceId", traceID, "agentName", agentName, "spanCount", response.TotalCount)
-	utils.WriteSuccessResponse(w, http.StatusOK, response)
+	return filters, nil
 }
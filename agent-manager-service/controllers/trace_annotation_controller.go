@@ -0,0 +1,222 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type TraceAnnotationController interface {
+	CreateTraceAnnotation(w http.ResponseWriter, r *http.Request)
+	ListTraceAnnotations(w http.ResponseWriter, r *http.Request)
+	DeleteTraceAnnotation(w http.ResponseWriter, r *http.Request)
+	CreateAgentFeedbackKey(w http.ResponseWriter, r *http.Request)
+	RecordTraceFeedback(w http.ResponseWriter, r *http.Request)
+}
+
+type traceAnnotationController struct {
+	traceAnnotationManager services.TraceAnnotationManager
+}
+
+// NewTraceAnnotationController returns a new TraceAnnotationController instance.
+func NewTraceAnnotationController(traceAnnotationManager services.TraceAnnotationManager) TraceAnnotationController {
+	return &traceAnnotationController{
+		traceAnnotationManager: traceAnnotationManager,
+	}
+}
+
+func (c *traceAnnotationController) CreateTraceAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceId := r.PathValue(utils.PathParamTraceId)
+	if len(traceId) > utils.MaxTraceIdLength {
+		log.Error("CreateTraceAnnotation: invalid trace ID", "traceId", traceId)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid trace ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload models.TraceAnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateTraceAnnotation: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateTraceAnnotationPayload(payload); err != nil {
+		log.Error("CreateTraceAnnotation: invalid trace annotation payload", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	annotation, err := c.traceAnnotationManager.CreateTraceAnnotation(ctx, userIdpId, orgName, projName, agentName, traceId, &payload)
+	if err != nil {
+		log.Error("CreateTraceAnnotation: failed to create trace annotation", "traceId", traceId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to create trace annotation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, annotation)
+}
+
+func (c *traceAnnotationController) ListTraceAnnotations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceId := r.PathValue(utils.PathParamTraceId)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	response, err := c.traceAnnotationManager.ListTraceAnnotations(ctx, userIdpId, orgName, projName, agentName, traceId)
+	if err != nil {
+		log.Error("ListTraceAnnotations: failed to list trace annotations", "traceId", traceId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to list trace annotations")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *traceAnnotationController) DeleteTraceAnnotation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceId := r.PathValue(utils.PathParamTraceId)
+	annotationId, err := uuid.Parse(r.PathValue(utils.PathParamAnnotationId))
+	if err != nil {
+		log.Error("DeleteTraceAnnotation: invalid annotation ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid annotation ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	if err := c.traceAnnotationManager.DeleteTraceAnnotation(ctx, userIdpId, orgName, projName, agentName, traceId, annotationId); err != nil {
+		log.Error("DeleteTraceAnnotation: failed to delete trace annotation", "traceId", traceId, "annotationId", annotationId, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to delete trace annotation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+// CreateAgentFeedbackKey issues the key that a deployed agent presents to report end-user feedback.
+// The key is only returned in this response.
+func (c *traceAnnotationController) CreateAgentFeedbackKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	feedbackKey, err := c.traceAnnotationManager.CreateAgentFeedbackKey(ctx, userIdpId, orgName, projName, agentName)
+	if err != nil {
+		log.Error("CreateAgentFeedbackKey: failed to issue agent feedback key", "agentName", agentName, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to issue agent feedback key")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, feedbackKey)
+}
+
+// RecordTraceFeedback stores end-user feedback reported by a deployed agent. It is served on the ingest API and
+// authenticated with the feedback key issued to the agent.
+func (c *traceAnnotationController) RecordTraceFeedback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	feedbackKey := r.Header.Get(utils.AgentFeedbackKeyHeader)
+
+	var payload models.TraceFeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("RecordTraceFeedback: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateTraceFeedbackPayload(payload); err != nil {
+		log.Error("RecordTraceFeedback: invalid trace feedback payload", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	annotation, err := c.traceAnnotationManager.RecordTraceFeedback(ctx, orgName, projName, agentName, feedbackKey, &payload)
+	if err != nil {
+		log.Error("RecordTraceFeedback: failed to record trace feedback", "traceId", payload.TraceID, "error", err)
+		writeTraceAnnotationError(w, err, "Failed to record trace feedback")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, annotation)
+}
+
+// writeTraceAnnotationError maps trace annotation manager errors to HTTP responses
+func writeTraceAnnotationError(w http.ResponseWriter, err error, fallbackMessage string) {
+	switch {
+	case errors.Is(err, utils.ErrInvalidAgentFeedbackKey):
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Invalid agent feedback key")
+	case errors.Is(err, utils.ErrOrganizationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, utils.ErrProjectNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+	case errors.Is(err, utils.ErrAgentNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
+	case errors.Is(err, utils.ErrTraceAnnotationNotFound):
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Trace annotation not found")
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMessage)
+	}
+}
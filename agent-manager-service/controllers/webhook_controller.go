@@ -0,0 +1,261 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type WebhookController interface {
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	GetWebhook(w http.ResponseWriter, r *http.Request)
+	DeleteWebhook(w http.ResponseWriter, r *http.Request)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request)
+}
+
+type webhookController struct {
+	webhookManager services.WebhookManager
+}
+
+// NewWebhookController returns a new WebhookController instance.
+func NewWebhookController(webhookManager services.WebhookManager) WebhookController {
+	return &webhookController{
+		webhookManager: webhookManager,
+	}
+}
+
+func (c *webhookController) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	var payload spec.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateWebhook: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := utils.ValidateWebhookCreatePayload(payload); err != nil {
+		log.Error("CreateWebhook: invalid webhook payload", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	webhook, err := c.webhookManager.CreateWebhook(ctx, userIdpId, orgName, &payload)
+	if err != nil {
+		log.Error("CreateWebhook: failed to create webhook", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrProjectNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
+			return
+		}
+		if errors.Is(err, utils.ErrWebhookURLNotAllowed) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "url must resolve to public addresses")
+			return
+		}
+		if errors.Is(err, utils.ErrSecretsNotConfigured) {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Webhook secrets cannot be stored because secrets encryption is not enabled")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, utils.ConvertToWebhookResponse(webhook))
+}
+
+func (c *webhookController) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	webhooks, err := c.webhookManager.ListWebhooks(ctx, userIdpId, orgName)
+	if err != nil {
+		log.Error("ListWebhooks: failed to list webhooks", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list webhooks")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToWebhookListResponse(webhooks))
+}
+
+func (c *webhookController) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	webhookId, err := uuid.Parse(r.PathValue(utils.PathParamWebhookId))
+	if err != nil {
+		log.Error("GetWebhook: invalid webhook ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid webhook ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	webhook, err := c.webhookManager.GetWebhook(ctx, userIdpId, orgName, webhookId)
+	if err != nil {
+		log.Error("GetWebhook: failed to get webhook", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrWebhookNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get webhook")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToWebhookResponse(webhook))
+}
+
+func (c *webhookController) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	webhookId, err := uuid.Parse(r.PathValue(utils.PathParamWebhookId))
+	if err != nil {
+		log.Error("DeleteWebhook: invalid webhook ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid webhook ID")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	if err := c.webhookManager.DeleteWebhook(ctx, userIdpId, orgName, webhookId); err != nil {
+		log.Error("DeleteWebhook: failed to delete webhook", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrWebhookNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to delete webhook")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
+}
+
+func (c *webhookController) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	webhookId, err := uuid.Parse(r.PathValue(utils.PathParamWebhookId))
+	if err != nil {
+		log.Error("ListWebhookDeliveries: invalid webhook ID", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid webhook ID")
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+	limitStr := query.Get("limit")
+	if limitStr == "" {
+		limitStr = strconv.Itoa(utils.DefaultLimit)
+	}
+	offsetStr := query.Get("offset")
+	if offsetStr == "" {
+		offsetStr = strconv.Itoa(utils.DefaultOffset)
+	}
+
+	// Parse and validate pagination parameters
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < utils.MinLimit || limit > utils.MaxLimit {
+		log.Error("ListWebhookDeliveries: invalid limit parameter", "limit", limitStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit parameter: must be between %d and %d", utils.MinLimit, utils.MaxLimit))
+		return
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < utils.MinOffset {
+		log.Error("ListWebhookDeliveries: invalid offset parameter", "offset", offsetStr)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Invalid offset parameter: must be %d or greater", utils.MinOffset))
+		return
+	}
+	status := query.Get("status")
+	if status != "" && status != utils.WebhookDeliveryStatusPending && status != utils.WebhookDeliveryStatusSucceeded && status != utils.WebhookDeliveryStatusFailed {
+		log.Error("ListWebhookDeliveries: invalid status parameter", "status", status)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid status parameter: must be 'pending', 'succeeded' or 'failed'")
+		return
+	}
+
+	// Extract user info from JWT token
+	tokenClaims := jwtassertion.GetTokenClaims(ctx)
+	userIdpId := tokenClaims.Sub
+
+	deliveries, total, err := c.webhookManager.ListWebhookDeliveries(ctx, userIdpId, orgName, webhookId, status, limit, offset)
+	if err != nil {
+		log.Error("ListWebhookDeliveries: failed to list webhook deliveries", "error", err)
+		if errors.Is(err, utils.ErrOrganizationNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+			return
+		}
+		if errors.Is(err, utils.ErrWebhookNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list webhook deliveries")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, utils.ConvertToWebhookDeliveryListResponse(deliveries, total, int32(limit), int32(offset)))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table deployment_revisions
+var migration008 = migration{
+	ID: 8,
+	Migrate: func(db *gorm.DB) error {
+		createTable := `CREATE TABLE deployment_revisions
+(
+   id               UUID PRIMARY KEY,
+   agent_id         UUID NOT NULL,
+   environment      VARCHAR(100) NOT NULL,
+   revision         INTEGER NOT NULL,
+   image_id         TEXT NOT NULL,
+   env              JSONB,
+   action           VARCHAR(20) NOT NULL,
+   source_revision  INTEGER,
+   deployed_by      VARCHAR(100) NOT NULL,
+   created_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_deployment_revisions_agent_id FOREIGN KEY (agent_id) REFERENCES agents(id) ON DELETE CASCADE,
+   CONSTRAINT deployment_action_enum check (action in ('deploy', 'promote', 'rollback'))
+)`
+
+		createIndex := `CREATE UNIQUE INDEX uk_deployment_revisions_agent_env_revision ON deployment_revisions(agent_id, environment, revision)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createTable, createIndex); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
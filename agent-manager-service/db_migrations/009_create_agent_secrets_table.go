@@ -0,0 +1,49 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table agent_secrets
+var migration009 = migration{
+	ID: 9,
+	Migrate: func(db *gorm.DB) error {
+		createTable := `CREATE TABLE agent_secrets
+(
+   id                  UUID PRIMARY KEY,
+   agent_id            UUID NOT NULL,
+   key                 VARCHAR(253) NOT NULL,
+   key_id              VARCHAR(100) NOT NULL,
+   encrypted_data_key  BYTEA NOT NULL,
+   ciphertext          BYTEA NOT NULL,
+   created_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_agent_secrets_agent_id FOREIGN KEY (agent_id) REFERENCES agents(id) ON DELETE CASCADE
+)`
+
+		createIndex := `CREATE UNIQUE INDEX uk_agent_secrets_agent_key ON agent_secrets(agent_id, key)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createTable, createIndex); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create tables organization_members and project_members
+var migration010 = migration{
+	ID: 10,
+	Migrate: func(db *gorm.DB) error {
+		createOrgMembersTable := `CREATE TABLE organization_members
+(
+   id            UUID PRIMARY KEY,
+   org_id        UUID NOT NULL,
+   user_idp_id   UUID NOT NULL,
+   role          VARCHAR(20) NOT NULL,
+   created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_organization_members_org_id FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+   CONSTRAINT organization_member_role_enum check (role in ('admin', 'developer', 'viewer'))
+)`
+
+		createOrgMembersIndex := `CREATE UNIQUE INDEX uk_organization_members_org_user ON organization_members(org_id, user_idp_id)`
+
+		createProjectMembersTable := `CREATE TABLE project_members
+(
+   id            UUID PRIMARY KEY,
+   project_id    UUID NOT NULL,
+   user_idp_id   UUID NOT NULL,
+   role          VARCHAR(20) NOT NULL,
+   created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_project_members_project_id FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+   CONSTRAINT project_member_role_enum check (role in ('developer', 'viewer'))
+)`
+
+		createProjectMembersIndex := `CREATE UNIQUE INDEX uk_project_members_project_user ON project_members(project_id, user_idp_id)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createOrgMembersTable, createOrgMembersIndex, createProjectMembersTable, createProjectMembersIndex); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table audit_events. Events are append-only, so updates and deletes are rejected by a trigger.
+// The table has no foreign keys so that events outlive the resources they describe.
+var migration011 = migration{
+	ID: 11,
+	Migrate: func(db *gorm.DB) error {
+		createTable := `CREATE TABLE audit_events
+(
+   id              UUID PRIMARY KEY,
+   org_name        VARCHAR(100) NOT NULL,
+   actor           UUID NOT NULL,
+   action          VARCHAR(100) NOT NULL,
+   target          TEXT NOT NULL,
+   project_name    VARCHAR(100),
+   agent_name      VARCHAR(100),
+   correlation_id  VARCHAR(100) NOT NULL,
+   result          VARCHAR(20) NOT NULL,
+   status_code     INTEGER NOT NULL,
+   created_at      TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT audit_event_result_enum check (result in ('success', 'failure'))
+)`
+
+		createIndex := `CREATE INDEX idx_audit_events_org_created_at ON audit_events(org_name, created_at DESC)`
+
+		createTriggerFunction := `CREATE FUNCTION reject_audit_event_modification() RETURNS trigger AS $$
+BEGIN
+   RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql`
+
+		createTrigger := `CREATE TRIGGER trg_audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION reject_audit_event_modification()`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createTable, createIndex, createTriggerFunction, createTrigger); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+var migration012 = migration{
+	ID: 12,
+	Migrate: func(db *gorm.DB) error {
+		createWebhooksTable := `CREATE TABLE webhooks
+(
+   id                  UUID PRIMARY KEY,
+   org_id              UUID NOT NULL,
+   project_id          UUID,
+   url                 TEXT NOT NULL,
+   event_types         JSONB NOT NULL,
+   key_id              VARCHAR(100) NOT NULL,
+   encrypted_data_key  BYTEA NOT NULL,
+   secret_ciphertext   BYTEA NOT NULL,
+   enabled             BOOLEAN NOT NULL DEFAULT TRUE,
+   created_by          UUID NOT NULL,
+   created_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_webhooks_org_id FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+   CONSTRAINT fk_webhooks_project_id FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+)`
+
+		createWebhooksIndex := `CREATE INDEX idx_webhooks_org_id ON webhooks(org_id)`
+
+		createDeliveriesTable := `CREATE TABLE webhook_deliveries
+(
+   id                UUID PRIMARY KEY,
+   webhook_id        UUID NOT NULL,
+   event_id          UUID NOT NULL,
+   event_type        VARCHAR(100) NOT NULL,
+   payload           JSONB NOT NULL,
+   status            VARCHAR(20) NOT NULL,
+   attempts          INTEGER NOT NULL DEFAULT 0,
+   next_attempt_at   TIMESTAMPTZ NOT NULL,
+   last_status_code  INTEGER,
+   last_error        TEXT,
+   delivered_at      TIMESTAMPTZ,
+   created_at        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at        TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_webhook_deliveries_webhook_id FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE,
+   CONSTRAINT webhook_delivery_status_enum check (status in ('pending', 'succeeded', 'failed'))
+)`
+
+		createDeliveriesIndex := `CREATE INDEX idx_webhook_deliveries_webhook_created_at ON webhook_deliveries(webhook_id, created_at DESC)`
+
+		createPendingDeliveriesIndex := `CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending'`
+
+		createEventStatesTable := `CREATE TABLE webhook_event_states
+(
+   resource_key  VARCHAR(512) PRIMARY KEY,
+   phase         VARCHAR(50) NOT NULL,
+   updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, createWebhooksTable, createWebhooksIndex, createDeliveriesTable,
+				createDeliveriesIndex, createPendingDeliveriesIndex, createEventStatesTable); err != nil {
+				return err
+			}
+			return nil
+		})
+	},
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+var migration013 = migration{
+	ID: 13,
+	Migrate: func(db *gorm.DB) error {
+		createModelPricesTable := `CREATE TABLE model_prices
+(
+   id                    UUID PRIMARY KEY,
+   org_id                UUID NOT NULL,
+   vendor                VARCHAR(100) NOT NULL DEFAULT '',
+   model                 VARCHAR(200) NOT NULL,
+   input_price_per_1k    NUMERIC(20, 10) NOT NULL,
+   output_price_per_1k   NUMERIC(20, 10) NOT NULL,
+   effective_from        TIMESTAMPTZ NOT NULL,
+   effective_to          TIMESTAMPTZ,
+   created_by            UUID NOT NULL,
+   created_at            TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   updated_at            TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_model_prices_org_id FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+   CONSTRAINT uq_model_prices_org_vendor_model_from UNIQUE (org_id, vendor, model, effective_from),
+   CONSTRAINT model_prices_non_negative check (input_price_per_1k >= 0 AND output_price_per_1k >= 0),
+   CONSTRAINT model_prices_effective_range check (effective_to IS NULL OR effective_to > effective_from)
+)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, createModelPricesTable)
+		})
+	},
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table trace_annotations
+var migration014 = migration{
+	ID: 14,
+	Migrate: func(db *gorm.DB) error {
+		createTraceAnnotationsTable := `CREATE TABLE trace_annotations
+(
+   id            UUID PRIMARY KEY,
+   org_id        UUID NOT NULL,
+   project_id    UUID NOT NULL,
+   agent_name    VARCHAR(100) NOT NULL,
+   trace_id      VARCHAR(64) NOT NULL,
+   span_id       VARCHAR(32) NOT NULL DEFAULT '',
+   rating        VARCHAR(10),
+   score         DOUBLE PRECISION,
+   note          TEXT NOT NULL DEFAULT '',
+   tags          JSONB NOT NULL DEFAULT '[]',
+   source        VARCHAR(20) NOT NULL,
+   created_by    UUID,
+   end_user_id   VARCHAR(255) NOT NULL DEFAULT '',
+   created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_trace_annotations_org_id FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+   CONSTRAINT fk_trace_annotations_project_id FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+   CONSTRAINT trace_annotations_rating check (rating IS NULL OR rating IN ('positive', 'negative')),
+   CONSTRAINT trace_annotations_source check (source IN ('user', 'end-user'))
+)`
+
+		createTraceIndex := `CREATE INDEX idx_trace_annotations_project_agent_trace ON trace_annotations(project_id, agent_name, trace_id)`
+		createRatingIndex := `CREATE INDEX idx_trace_annotations_project_rating ON trace_annotations(project_id, rating, created_at) WHERE rating IS NOT NULL`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, createTraceAnnotationsTable, createTraceIndex, createRatingIndex)
+		})
+	},
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// create table agent_feedback_keys
+var migration015 = migration{
+	ID: 15,
+	Migrate: func(db *gorm.DB) error {
+		createAgentFeedbackKeysTable := `CREATE TABLE agent_feedback_keys
+(
+   id            UUID PRIMARY KEY,
+   org_id        UUID NOT NULL,
+   project_id    UUID NOT NULL,
+   agent_name    VARCHAR(100) NOT NULL,
+   key_hash      VARCHAR(64) NOT NULL,
+   created_by    UUID NOT NULL,
+   created_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+   CONSTRAINT fk_agent_feedback_keys_org_id FOREIGN KEY (org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+   CONSTRAINT fk_agent_feedback_keys_project_id FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+   CONSTRAINT uq_agent_feedback_keys_project_agent UNIQUE (project_id, agent_name)
+)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, createAgentFeedbackKeysTable)
+		})
+	},
+}
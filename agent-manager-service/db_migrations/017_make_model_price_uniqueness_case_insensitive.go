@@ -0,0 +1,38 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// make the model price uniqueness ignore case, as vendors and models are matched case-insensitively when pricing traces
+var migration017 = migration{
+	ID: 17,
+	Migrate: func(db *gorm.DB) error {
+		dropUniqueConstraint := `ALTER TABLE model_prices DROP CONSTRAINT uq_model_prices_org_vendor_model_from`
+		createUniqueIndex := `CREATE UNIQUE INDEX uq_model_prices_org_vendor_model_from
+   ON model_prices (org_id, LOWER(vendor), LOWER(model), effective_from)`
+
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := runSQL(tx, dropUniqueConstraint); err != nil {
+				return err
+			}
+			return runSQL(tx, createUniqueIndex)
+		})
+	},
+}
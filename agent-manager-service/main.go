@@ -109,6 +109,15 @@ func main() {
 
 	stopCh := signals.SetupSignalHandler()
 
+	if cfg.Webhooks.Enabled {
+		dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+		go func() {
+			<-stopCh
+			stopDispatcher()
+		}()
+		go dependencies.WebhookDispatcher.Run(dispatcherCtx)
+	}
+
 	go func() {
 		<-stopCh
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
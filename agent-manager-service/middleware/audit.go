@@ -0,0 +1,98 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// AuditRecorder stores audit events for mutating operations
+type AuditRecorder interface {
+	RecordEvent(ctx context.Context, event *models.AuditEvent) error
+}
+
+// RecordAudit returns a RouteOption that records an audit event with the given action
+// once the route's handler has written its response
+func RecordAudit(recorder AuditRecorder, action string) RouteOption {
+	return func(handler http.HandlerFunc) http.HandlerFunc {
+		return WithAudit(handler, recorder, action)
+	}
+}
+
+// WithAudit wraps a handler and records who performed the action, on which target, and with what result.
+// Failing to record the event is logged and does not change the response.
+func WithAudit(handler http.HandlerFunc, recorder AuditRecorder, action string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		handler(sw, r)
+
+		ctx := r.Context()
+		result := utils.AuditResultSuccess
+		if sw.status >= http.StatusBadRequest {
+			result = utils.AuditResultFailure
+		}
+		event := &models.AuditEvent{
+			OrgName:       r.PathValue(utils.PathParamOrgName),
+			Action:        action,
+			Target:        r.URL.Path,
+			ProjectName:   r.PathValue(utils.PathParamProjName),
+			AgentName:     r.PathValue(utils.PathParamAgentName),
+			CorrelationID: utils.GetCorrelationId(ctx),
+			Result:        result,
+			StatusCode:    sw.status,
+		}
+		if tokenClaims := jwtassertion.GetTokenClaims(ctx); tokenClaims != nil {
+			event.Actor = tokenClaims.Sub
+		}
+
+		// The event is recorded even if the client has gone away after the response was written
+		if err := recorder.RecordEvent(context.WithoutCancel(ctx), event); err != nil {
+			logger.GetLogger(ctx).Error("WithAudit: failed to record audit event", "action", action, "error", err)
+		}
+	}
+}
+
+// statusResponseWriter remembers the status code written by a handler
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (w *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Authorizer decides whether a user may perform an action in an organization, or in a project of it
+// when projectName is not empty
+type Authorizer interface {
+	Authorize(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, permission utils.Permission) error
+}
+
+// RouteOption wraps the handler of a route registered with HandleFuncWithValidation
+type RouteOption func(handler http.HandlerFunc) http.HandlerFunc
+
+// RequirePermission returns a RouteOption that only lets the request through when the caller
+// holds the permission on the organization and project named in the route
+func RequirePermission(authorizer Authorizer, permission utils.Permission) RouteOption {
+	return func(handler http.HandlerFunc) http.HandlerFunc {
+		return WithPermission(handler, authorizer, permission)
+	}
+}
+
+// WithPermission wraps a handler and checks that the caller holds the permission on the
+// {orgName} and optional {projName} path parameters of the matched route
+func WithPermission(handler http.HandlerFunc, authorizer Authorizer, permission utils.Permission) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		log := logger.GetLogger(ctx)
+
+		tokenClaims := jwtassertion.GetTokenClaims(ctx)
+		if tokenClaims == nil {
+			utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		orgName := r.PathValue(utils.PathParamOrgName)
+		projectName := r.PathValue(utils.PathParamProjName)
+		err := authorizer.Authorize(ctx, tokenClaims.Sub, orgName, projectName, permission)
+		if err != nil {
+			if errors.Is(err, utils.ErrOrganizationNotFound) {
+				utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
+				return
+			}
+			if errors.Is(err, utils.ErrPermissionDenied) {
+				utils.WriteErrorResponse(w, http.StatusForbidden, "Insufficient permissions to perform this action")
+				return
+			}
+			log.Error("WithPermission: failed to authorize request", "permission", permission, "error", err)
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to authorize request")
+			return
+		}
+
+		handler(w, r)
+	}
+}
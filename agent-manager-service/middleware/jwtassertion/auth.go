@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -52,6 +53,19 @@ const (
 )
 
 func JWTAuthMiddleware(header string) func(http.Handler) http.Handler {
+	return newJWTMiddleware(header, func(_ context.Context, tokenString string) (*TokenClaims, error) {
+		// we don't need to validate the token, just extract the claims
+		return extractClaimsFromJWT(tokenString)
+	})
+}
+
+// JWTVerificationMiddleware verifies the token signature and registered claims with the verifier before
+// extracting the claims. Use it when the service is not deployed behind a gateway that validates tokens.
+func JWTVerificationMiddleware(header string, verifier *Verifier) func(http.Handler) http.Handler {
+	return newJWTMiddleware(header, verifier.Verify)
+}
+
+func newJWTMiddleware(header string, parseClaims func(ctx context.Context, tokenString string) (*TokenClaims, error)) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			tokenString := r.Header.Get(header)
@@ -61,9 +75,12 @@ func JWTAuthMiddleware(header string) func(http.Handler) http.Handler {
 			}
 			// replace "Bearer " prefix
 			tokenString = strings.Replace(tokenString, "Bearer ", "", 1)
-			// we don't need to validate the token, just extract the claims
-			claims, err := extractClaimsFromJWT(tokenString)
+			claims, err := parseClaims(r.Context(), tokenString)
 			if err != nil {
+				if errors.Is(err, ErrJWKSUnavailable) {
+					utils.WriteErrorResponse(w, http.StatusServiceUnavailable, fmt.Sprintf("unable to verify jwt: %v", err))
+					return
+				}
 				utils.WriteErrorResponse(w, http.StatusUnauthorized, fmt.Sprintf("invalid jwt: %v", err))
 				return
 			}
@@ -0,0 +1,228 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwtassertion
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minJWKSRefreshInterval limits how often an unknown key id or an unavailable JWKS endpoint can trigger a JWKS refetch
+const minJWKSRefreshInterval = 10 * time.Second
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jwksKeySet fetches the signing keys published at a JWKS URL and caches them by key id
+type jwksKeySet struct {
+	url        string
+	cacheTTL   time.Duration
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time     // Time of the last successful fetch
+	attemptedAt time.Time     // Time of the last fetch, successful or not
+	lastErr     error         // Error of the last fetch, nil when it succeeded
+	refreshing  chan struct{} // Closed when the fetch in progress completes, nil when none is in progress
+}
+
+func newJWKSKeySet(url string, cacheTTL time.Duration) *jwksKeySet {
+	return &jwksKeySet{
+		url:      url,
+		cacheTTL: cacheTTL,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+// getKey returns the key with the given id. The JWKS is refetched when the cache has expired, or when the key id is
+// unknown so that rotated keys are picked up without waiting for the cache to expire. Refetches are made outside
+// the lock, shared by concurrent callers, and made at most once per minJWKSRefreshInterval even when they fail.
+func (k *jwksKeySet) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	for {
+		k.mu.Lock()
+		key, found := k.lookup(kid)
+		if found && time.Since(k.fetchedAt) <= k.cacheTTL {
+			k.mu.Unlock()
+			return key, nil
+		}
+		if refreshing := k.refreshing; refreshing != nil {
+			k.mu.Unlock()
+			if found {
+				// Keep using the cached key while it is being refreshed
+				return key, nil
+			}
+			select {
+			case <-refreshing:
+				continue
+			case <-ctx.Done():
+				return nil, fmt.Errorf("%w: %w", ErrJWKSUnavailable, ctx.Err())
+			}
+		}
+		if time.Since(k.attemptedAt) < minJWKSRefreshInterval {
+			lastErr := k.lastErr
+			k.mu.Unlock()
+			return fetchResult(key, found, lastErr)
+		}
+		refreshing := make(chan struct{})
+		k.refreshing = refreshing
+		k.attemptedAt = time.Now()
+		k.mu.Unlock()
+
+		// The fetch is shared with other callers, so it must not be cancelled with this caller's request
+		keys, err := k.fetch(context.WithoutCancel(ctx))
+
+		k.mu.Lock()
+		k.lastErr = err
+		if err == nil {
+			k.keys = keys
+			k.fetchedAt = time.Now()
+		}
+		k.refreshing = nil
+		close(refreshing)
+		key, found = k.lookup(kid)
+		k.mu.Unlock()
+		return fetchResult(key, found, err)
+	}
+}
+
+// fetchResult returns the key found after a fetch. A cached key is kept while the JWKS endpoint is unavailable.
+func fetchResult(key crypto.PublicKey, found bool, fetchErr error) (crypto.PublicKey, error) {
+	if found {
+		return key, nil
+	}
+	if fetchErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrJWKSUnavailable, fetchErr)
+	}
+	return nil, ErrUnknownSigningKey
+}
+
+// lookup finds a cached key. Tokens without a key id are accepted only when the JWKS has a single key.
+func (k *jwksKeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, found := k.keys[kid]
+	return key, found
+}
+
+// fetch downloads the JWKS and returns its signing keys by key id
+func (k *jwksKeySet) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := k.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks endpoint returned status %d", resp.StatusCode)
+	}
+
+	var keySet jsonWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&keySet); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Skip keys that cannot be used for RS256 or ES256 rather than rejecting the whole set
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		if !elliptic.P256().IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %s", jwk.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", jwk.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, fmt.Errorf("missing value")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
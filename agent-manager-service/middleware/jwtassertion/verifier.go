@@ -0,0 +1,213 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwtassertion
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrUnknownSigningKey    = errors.New("unknown signing key")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrMissingExpiry        = errors.New("token has no expiry")
+	ErrTokenExpired         = errors.New("token has expired")
+	ErrTokenNotYetValid     = errors.New("token is not valid yet")
+	ErrInvalidIssuer        = errors.New("invalid token issuer")
+	ErrInvalidAudience      = errors.New("invalid token audience")
+	ErrJWKSUnavailable      = errors.New("signing keys are unavailable")
+)
+
+const (
+	algRS256 = "RS256"
+	algES256 = "ES256"
+)
+
+// VerifierConfig holds the settings used to verify tokens
+type VerifierConfig struct {
+	JWKSURL   string
+	Issuer    string
+	Audience  string
+	CacheTTL  time.Duration
+	ClockSkew time.Duration
+}
+
+// Verifier verifies RS256 and ES256 signed tokens against a JWKS and validates the registered claims
+type Verifier struct {
+	keySet    *jwksKeySet
+	issuer    string
+	audience  string
+	clockSkew time.Duration
+}
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type registeredClaims struct {
+	Iss string   `json:"iss"`
+	Aud audience `json:"aud"`
+	Exp *int64   `json:"exp"`
+	Nbf *int64   `json:"nbf"`
+}
+
+// audience accepts both the single string and the array form of the "aud" claim
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("aud must be a string or an array of strings")
+	}
+	*a = multiple
+	return nil
+}
+
+func NewVerifier(cfg VerifierConfig) *Verifier {
+	return &Verifier{
+		keySet:    newJWKSKeySet(cfg.JWKSURL, cfg.CacheTTL),
+		issuer:    cfg.Issuer,
+		audience:  cfg.Audience,
+		clockSkew: cfg.ClockSkew,
+	}
+}
+
+// Verify checks the token signature and the exp, nbf, iss and aud claims, and returns the token claims
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*TokenClaims, error) {
+	parts := strings.Split(tokenString, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: found %d parts", ErrMalformedToken, len(parts))
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode header: %w", ErrMalformedToken, err)
+	}
+	if header.Alg != algRS256 && header.Alg != algES256 {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode signature: %w", ErrMalformedToken, err)
+	}
+
+	key, err := v.keySet.getKey(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims TokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode payload: %w", ErrMalformedToken, err)
+	}
+	var registered registeredClaims
+	if err := decodeSegment(parts[1], &registered); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode payload: %w", ErrMalformedToken, err)
+	}
+	if err := v.validateClaims(&registered, time.Now()); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+func (v *Verifier) validateClaims(claims *registeredClaims, now time.Time) error {
+	if claims.Exp == nil {
+		return ErrMissingExpiry
+	}
+	if now.After(time.Unix(*claims.Exp, 0).Add(v.clockSkew)) {
+		return ErrTokenExpired
+	}
+	if claims.Nbf != nil && now.Before(time.Unix(*claims.Nbf, 0).Add(-v.clockSkew)) {
+		return ErrTokenNotYetValid
+	}
+	if v.issuer != "" && claims.Iss != v.issuer {
+		return fmt.Errorf("%w: expected %q, got %q", ErrInvalidIssuer, v.issuer, claims.Iss)
+	}
+	if v.audience != "" && !containsString(claims.Aud, v.audience) {
+		return fmt.Errorf("%w: expected %q", ErrInvalidAudience, v.audience)
+	}
+	return nil
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signingInput))
+	switch alg {
+	case algRS256:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: key type does not match algorithm %s", ErrInvalidSignature, alg)
+		}
+		if err := rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+	case algES256:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: key type does not match algorithm %s", ErrInvalidSignature, alg)
+		}
+		// JWS encodes ES256 signatures as the 32 byte big-endian R and S values concatenated
+		if len(signature) != 64 {
+			return ErrInvalidSignature
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(ecKey, digest[:], r, s) {
+			return ErrInvalidSignature
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, alg)
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
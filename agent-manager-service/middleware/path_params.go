@@ -44,7 +44,12 @@ func WithPathParamValidation(handler http.HandlerFunc, requiredParams ...string)
 
 // HandleFuncWithValidation is a helper that registers a route with automatic path parameter validation
 // It extracts parameter names from the pattern and applies validation automatically
-func HandleFuncWithValidation(mux *http.ServeMux, pattern string, handler http.HandlerFunc) {
+// Options such as RequirePermission run after the path parameters are validated
+func HandleFuncWithValidation(mux *http.ServeMux, pattern string, handler http.HandlerFunc, opts ...RouteOption) {
+	for i := len(opts) - 1; i >= 0; i-- {
+		handler = opts[i](handler)
+	}
+
 	// Extract parameter names from pattern like "GET /orgs/{orgName}/projects/{projName}"
 	params := extractPathParams(pattern)
 
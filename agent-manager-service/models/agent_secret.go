@@ -0,0 +1,36 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AgentSecret is the DB model for an envelope encrypted secret environment variable value. The value is encrypted with a
+// random data key, and the data key is encrypted with the configured key identified by KeyID.
+type AgentSecret struct {
+	ID               uuid.UUID `gorm:"column:id;primaryKey"`
+	AgentID          uuid.UUID `gorm:"column:agent_id"`
+	Key              string    `gorm:"column:key"`
+	KeyID            string    `gorm:"column:key_id"`
+	EncryptedDataKey []byte    `gorm:"column:encrypted_data_key"`
+	Ciphertext       []byte    `gorm:"column:ciphertext"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+	UpdatedAt        time.Time `gorm:"column:updated_at"`
+}
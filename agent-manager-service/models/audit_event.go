@@ -0,0 +1,63 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DB Model
+type AuditEvent struct {
+	ID            uuid.UUID `gorm:"column:id;primaryKey"`
+	OrgName       string    `gorm:"column:org_name"`
+	Actor         uuid.UUID `gorm:"column:actor"`
+	Action        string    `gorm:"column:action"`
+	Target        string    `gorm:"column:target"`
+	ProjectName   string    `gorm:"column:project_name"`
+	AgentName     string    `gorm:"column:agent_name"`
+	CorrelationID string    `gorm:"column:correlation_id"`
+	Result        string    `gorm:"column:result"`
+	StatusCode    int       `gorm:"column:status_code"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+}
+
+// AuditEventFilter narrows the audit events listed for an organization. Zero values match everything.
+type AuditEventFilter struct {
+	Actor       *uuid.UUID
+	Action      string
+	ProjectName string
+	AgentName   string
+	Result      string
+	From        *time.Time
+	To          *time.Time
+}
+
+// API Response DTO
+type AuditEventResponse struct {
+	ID            string    `json:"id"`
+	Actor         string    `json:"actor"`
+	Action        string    `json:"action"`
+	Target        string    `json:"target"`
+	ProjectName   string    `json:"projectName,omitempty"`
+	AgentName     string    `json:"agentName,omitempty"`
+	CorrelationID string    `json:"correlationId"`
+	Result        string    `json:"result"`
+	StatusCode    int       `json:"statusCode"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
@@ -31,6 +31,17 @@ type DeploymentResponse struct {
 	Endpoints                  []Endpoint                  `json:"endpoints"`
 }
 
+// PromotionResponse represents the result of promoting an agent release between environments
+type PromotionResponse struct {
+	AgentName         string    `json:"agentName"`
+	ProjectName       string    `json:"projectName"`
+	SourceEnvironment string    `json:"sourceEnvironment"`
+	TargetEnvironment string    `json:"targetEnvironment"`
+	ReleaseName       string    `json:"releaseName"`
+	PromotedBy        string    `json:"promotedBy"`
+	PromotedAt        time.Time `json:"promotedAt"`
+}
+
 // PromotionTargetEnvironment represents environment promotion targets
 type PromotionTargetEnvironment struct {
 	Name        string `json:"name"`
@@ -57,8 +68,9 @@ type Endpoint struct {
 
 // EnvVars represents environment variables
 type EnvVars struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	IsSecret bool   `json:"isSecret,omitempty"`
 }
 
 // Build represents a build instance
@@ -73,6 +85,22 @@ type BuildResponse struct {
 	Image       string     `json:"image,omitempty"`
 	Branch      string     `json:"branch,omitempty"`
 	EndedAt     *time.Time `json:"endedAt,omitempty"`
+	// ResourceVersion changes whenever the workflow run is updated
+	ResourceVersion string `json:"-"`
+}
+
+// EnvironmentDeploymentStatus is the deployment status of an agent in a single environment.
+// Generation changes whenever the deployment is updated.
+type EnvironmentDeploymentStatus struct {
+	UID         string
+	Generation  int64
+	ProjectName string
+	AgentName   string
+	Environment string
+	Status      string
+	UpdatedAt   time.Time
+	// ResourceVersion changes whenever the release binding is updated
+	ResourceVersion string
 }
 
 // BuildStep represents a step in the build process
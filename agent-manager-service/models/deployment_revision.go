@@ -0,0 +1,50 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DB Model
+type DeploymentRevision struct {
+	ID             uuid.UUID `gorm:"column:id;primaryKey"`
+	AgentID        uuid.UUID `gorm:"column:agent_id"`
+	Environment    string    `gorm:"column:environment"`
+	Revision       int       `gorm:"column:revision"`
+	ImageId        string    `gorm:"column:image_id"`
+	ReleaseName    string    `gorm:"column:release_name"` // Release bound to the environment, set for promoted revisions
+	Env            []EnvVars `gorm:"column:env;type:jsonb;serializer:json"`
+	Action         string    `gorm:"column:action"`
+	SourceRevision *int      `gorm:"column:source_revision"`
+	DeployedBy     string    `gorm:"column:deployed_by"`
+	CreatedAt      time.Time `gorm:"column:created_at"`
+}
+
+// DeploymentRevisionResponse represents a single entry in an agent's deployment history
+type DeploymentRevisionResponse struct {
+	Revision       int       `json:"revision"`
+	Environment    string    `json:"environment"`
+	ImageId        string    `json:"imageId"`
+	Env            []EnvVars `json:"env,omitempty"`
+	Action         string    `json:"action"`
+	SourceRevision *int      `json:"sourceRevision,omitempty"`
+	DeployedBy     string    `json:"deployedBy"`
+	DeployedAt     time.Time `json:"deployedAt"`
+}
@@ -73,4 +73,26 @@ type BuildLogsResponse struct {
 	Logs       []LogEntry `json:"logs"`
 	TotalCount int32      `json:"totalCount"`
 	TookMs     float32    `json:"tookMs"`
+	// NextCursor resumes after the last returned log; empty when there are no more logs
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// LogStreamEvent is an event of a live build or agent runtime log stream
+type LogStreamEvent struct {
+	// Type is one of the LogStreamEvent* constants
+	Type string
+	// Cursor resumes the stream after this event
+	Cursor string
+	Log    *LogEntry
+	// Status is the final build status, set on the completion event of a build log stream
+	Status string
+}
+
+// AgentLogsResponse is a page of the runtime logs of an agent
+type AgentLogsResponse struct {
+	Logs       []LogEntry `json:"logs"`
+	TotalCount int32      `json:"totalCount"`
+	TookMs     float32    `json:"tookMs"`
+	// NextCursor resumes after the last returned log; empty when there are no more logs
+	NextCursor string `json:"nextCursor,omitempty"`
 }
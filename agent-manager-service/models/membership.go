@@ -0,0 +1,52 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationMember is the DB model for a role granted to a user on an organization.
+// The user that owns the organization is an implicit admin and has no row in this table.
+type OrganizationMember struct {
+	ID        uuid.UUID `gorm:"column:id;primaryKey"`
+	OrgID     uuid.UUID `gorm:"column:org_id"`
+	UserIdpId uuid.UUID `gorm:"column:user_idp_id"`
+	Role      string    `gorm:"column:role"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// ProjectMember is the DB model for a role granted to a user on a single project
+type ProjectMember struct {
+	ID        uuid.UUID `gorm:"column:id;primaryKey"`
+	ProjectID uuid.UUID `gorm:"column:project_id"`
+	UserIdpId uuid.UUID `gorm:"column:user_idp_id"`
+	Role      string    `gorm:"column:role"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// API Response DTO
+type MemberResponse struct {
+	UserIdpId string    `json:"userIdpId"`
+	Role      string    `json:"role"`
+	IsOwner   bool      `json:"isOwner,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
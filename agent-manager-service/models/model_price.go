@@ -0,0 +1,110 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DB Model
+type ModelPrice struct {
+	ID               uuid.UUID  `gorm:"column:id;primaryKey"`
+	OrgID            uuid.UUID  `gorm:"column:org_id"`
+	Vendor           string     `gorm:"column:vendor"` // Empty matches any vendor
+	Model            string     `gorm:"column:model"`
+	InputPricePer1K  float64    `gorm:"column:input_price_per_1k"`
+	OutputPricePer1K float64    `gorm:"column:output_price_per_1k"`
+	EffectiveFrom    time.Time  `gorm:"column:effective_from"`
+	EffectiveTo      *time.Time `gorm:"column:effective_to"`
+	CreatedBy        uuid.UUID  `gorm:"column:created_by"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at"`
+}
+
+// API Response DTO
+type ModelPriceResponse struct {
+	ID               string     `json:"id"`
+	Vendor           string     `json:"vendor,omitempty"`
+	Model            string     `json:"model"`
+	InputPricePer1K  float64    `json:"inputPricePer1k"`
+	OutputPricePer1K float64    `json:"outputPricePer1k"`
+	Currency         string     `json:"currency"`
+	EffectiveFrom    time.Time  `json:"effectiveFrom"`
+	EffectiveTo      *time.Time `json:"effectiveTo,omitempty"`
+	CreatedBy        string     `json:"createdBy"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
+// CostEstimate is the estimated LLM cost of a trace or span, computed from token usage and model prices
+type CostEstimate struct {
+	Currency       string  `json:"currency"`
+	InputCost      float64 `json:"inputCost"`
+	OutputCost     float64 `json:"outputCost"`
+	TotalCost      float64 `json:"totalCost"`
+	UnpricedTokens int     `json:"unpricedTokens,omitempty"` // Tokens of models without a price, not included in the cost
+}
+
+// CostSummary holds token totals and their estimated cost
+type CostSummary struct {
+	InputTokens    int     `json:"inputTokens"`
+	OutputTokens   int     `json:"outputTokens"`
+	TotalTokens    int     `json:"totalTokens"`
+	InputCost      float64 `json:"inputCost"`
+	OutputCost     float64 `json:"outputCost"`
+	TotalCost      float64 `json:"totalCost"`
+	UnpricedTokens int     `json:"unpricedTokens"` // Tokens of models without a price, not included in the cost
+}
+
+// ModelCost holds the token usage and estimated cost of a vendor and model
+type ModelCost struct {
+	Vendor string `json:"vendor,omitempty"`
+	Model  string `json:"model"`
+	Priced bool   `json:"priced"` // Whether a price was found for all of the model's usage
+	CostSummary
+}
+
+// AgentCost holds the token usage and estimated cost of an agent
+type AgentCost struct {
+	AgentName string      `json:"agentName"`
+	Models    []ModelCost `json:"models"`
+	CostSummary
+}
+
+// AgentCostResponse is the cost rollup of an agent over a time range
+type AgentCostResponse struct {
+	AgentName   string      `json:"agentName"`
+	Environment string      `json:"environment,omitempty"` // Omitted when all environments are included
+	StartTime   time.Time   `json:"startTime"`
+	EndTime     time.Time   `json:"endTime"`
+	Currency    string      `json:"currency"`
+	Models      []ModelCost `json:"models"`
+	CostSummary
+}
+
+// ProjectCostResponse is the cost rollup of a project and its agents over a time range
+type ProjectCostResponse struct {
+	ProjectName string      `json:"projectName"`
+	Environment string      `json:"environment,omitempty"` // Omitted when all environments are included
+	StartTime   time.Time   `json:"startTime"`
+	EndTime     time.Time   `json:"endTime"`
+	Currency    string      `json:"currency"`
+	Agents      []AgentCost `json:"agents"`
+	CostSummary
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DB Model
+type TraceAnnotation struct {
+	ID        uuid.UUID  `gorm:"column:id;primaryKey"`
+	OrgID     uuid.UUID  `gorm:"column:org_id"`
+	ProjectID uuid.UUID  `gorm:"column:project_id"`
+	AgentName string     `gorm:"column:agent_name"`
+	TraceID   string     `gorm:"column:trace_id"`
+	SpanID    string     `gorm:"column:span_id"` // Empty when the whole trace is annotated
+	Rating    *string    `gorm:"column:rating"`  // Thumbs up or down, either positive or negative
+	Score     *float64   `gorm:"column:score"`
+	Note      string     `gorm:"column:note"`
+	Tags      []string   `gorm:"column:tags;type:jsonb;serializer:json"`
+	Source    string     `gorm:"column:source"`      // Whether a console user or an end user of the agent gave the annotation
+	CreatedBy *uuid.UUID `gorm:"column:created_by"`  // Console user, unset for end-user feedback
+	EndUserID string     `gorm:"column:end_user_id"` // Optional identifier of the end user, as reported by the agent
+	CreatedAt time.Time  `gorm:"column:created_at"`
+}
+
+// TraceAnnotationRequest is the body to annotate a trace, or one of its spans when SpanID is set
+type TraceAnnotationRequest struct {
+	SpanID string   `json:"spanId,omitempty"`
+	Rating *string  `json:"rating,omitempty"`
+	Score  *float64 `json:"score,omitempty"`
+	Note   string   `json:"note,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// TraceFeedbackRequest is the end-user feedback that a deployed agent reports for one of its traces
+type TraceFeedbackRequest struct {
+	TraceID   string `json:"traceId"`
+	EndUserID string `json:"endUserId,omitempty"`
+	TraceAnnotationRequest
+}
+
+// API Response DTO
+type TraceAnnotationResponse struct {
+	ID        string    `json:"id"`
+	TraceID   string    `json:"traceId"`
+	SpanID    string    `json:"spanId,omitempty"`
+	Rating    *string   `json:"rating,omitempty"`
+	Score     *float64  `json:"score,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	Tags      []string  `json:"tags"`
+	Source    string    `json:"source"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	EndUserID string    `json:"endUserId,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TraceAnnotationListResponse holds the annotations of a trace, oldest first, with their summary
+type TraceAnnotationListResponse struct {
+	Annotations []TraceAnnotationResponse `json:"annotations"`
+	Summary     AnnotationSummary         `json:"summary"`
+}
+
+// AnnotationSummary counts the annotations of a trace
+type AnnotationSummary struct {
+	Count         int      `json:"count"`
+	PositiveCount int      `json:"positiveCount"`
+	NegativeCount int      `json:"negativeCount"`
+	AverageScore  *float64 `json:"averageScore,omitempty"` // Omitted when no annotation has a score
+}
+
+// AgentFeedbackKey is the credential that a deployed agent presents to report end-user feedback. Only the
+// SHA-256 hash of the key is stored; the key itself is returned once, when it is issued.
+type AgentFeedbackKey struct {
+	ID        uuid.UUID `gorm:"column:id;primaryKey"`
+	OrgID     uuid.UUID `gorm:"column:org_id"`
+	ProjectID uuid.UUID `gorm:"column:project_id"`
+	AgentName string    `gorm:"column:agent_name"`
+	KeyHash   string    `gorm:"column:key_hash"`
+	CreatedBy uuid.UUID `gorm:"column:created_by"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// AgentFeedbackKeyResponse holds a newly issued feedback key of an agent
+type AgentFeedbackKeyResponse struct {
+	AgentName string    `json:"agentName"`
+	Key       string    `json:"key"` // Returned only when issued, the previous key of the agent stops working
+	CreatedAt time.Time `json:"createdAt"`
+}
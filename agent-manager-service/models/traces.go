@@ -24,18 +24,29 @@ import (
 
 // TraceOverview represents a summary of a trace
 type TraceOverview struct {
-	TraceID         string       `json:"traceId"`
-	RootSpanID      string       `json:"rootSpanId"`
-	RootSpanName    string       `json:"rootSpanName"`
-	RootSpanKind    string       `json:"rootSpanKind"` // Semantic kind of the root span (llm, tool, embedding, etc.)
-	StartTime       string       `json:"startTime"`
-	EndTime         string       `json:"endTime"`
-	DurationInNanos int64        `json:"durationInNanos"`
-	SpanCount       int          `json:"spanCount"`
-	TokenUsage      *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
-	Status          *TraceStatus `json:"status,omitempty"`     // Trace status including error information
-	Input           interface{}  `json:"input,omitempty"`      // Input from root span (nil if not found)
-	Output          interface{}  `json:"output,omitempty"`     // Output from root span (nil if not found)
+	TraceID         string             `json:"traceId"`
+	RootSpanID      string             `json:"rootSpanId"`
+	RootSpanName    string             `json:"rootSpanName"`
+	RootSpanKind    string             `json:"rootSpanKind"` // Semantic kind of the root span (llm, tool, embedding, etc.)
+	StartTime       string             `json:"startTime"`
+	EndTime         string             `json:"endTime"`
+	DurationInNanos int64              `json:"durationInNanos"`
+	SpanCount       int                `json:"spanCount"`
+	AgentName       string             `json:"agentName,omitempty"`     // Agent that produced the root span, set when traces of several agents are listed
+	TokenUsage      *TokenUsage        `json:"tokenUsage,omitempty"`    // Aggregated token usage from GenAI spans
+	EstimatedCost   *CostEstimate      `json:"estimatedCost,omitempty"` // Estimated LLM cost from token usage and model prices
+	Status          *TraceStatus       `json:"status,omitempty"`        // Trace status including error information
+	Input           interface{}        `json:"input,omitempty"`         // Input from root span (nil if not found)
+	Output          interface{}        `json:"output,omitempty"`        // Output from root span (nil if not found)
+	Redaction       *Redaction         `json:"redaction,omitempty"`     // Set when the trace observer redacted sensitive values of the root span
+	Annotations     *AnnotationSummary `json:"annotations,omitempty"`   // Counts of the trace's feedback and annotations, omitted when it has none
+}
+
+// Redaction records that sensitive values were redacted by the trace observer, without revealing them
+type Redaction struct {
+	Policy string   `json:"policy"` // Name of the redaction policy that was applied
+	Count  int      `json:"count"`  // Number of redacted values
+	Types  []string `json:"types"`  // Kinds of the redacted values, e.g. email, phone or attribute
 }
 
 // TokenUsage represents aggregated token usage from GenAI spans
@@ -54,6 +65,33 @@ type TraceStatus struct {
 type TraceOverviewResponse struct {
 	Traces     []TraceOverview `json:"traces"`
 	TotalCount int             `json:"totalCount"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+	Truncated  bool            `json:"truncated,omitempty"` // Span filters matched too many traces, or traces had too many spans to summarize
+}
+
+// SessionOverview summarizes the turns (traces) of a conversation session
+type SessionOverview struct {
+	SessionID     string        `json:"sessionId"`
+	StartTime     string        `json:"startTime"`               // Start time of the first turn
+	EndTime       string        `json:"endTime"`                 // End time of the last turn to finish
+	TurnCount     int           `json:"turnCount"`               // Number of traces in the session
+	ErrorCount    int           `json:"errorCount"`              // Number of turns with at least one error span
+	TokenUsage    TokenUsage    `json:"tokenUsage"`              // Token totals of the GenAI spans of all turns
+	EstimatedCost *CostEstimate `json:"estimatedCost,omitempty"` // Estimated LLM cost of the turns, only set with the turns
+}
+
+// SessionListResponse represents a page of sessions, most recently active first
+type SessionListResponse struct {
+	Sessions   []SessionOverview `json:"sessions"`
+	TotalCount int               `json:"totalCount"`
+	Truncated  bool              `json:"truncated,omitempty"` // Too many spans or traces to load, so some sessions may be missing or incomplete
+}
+
+// SessionResponse represents a session with its turns ordered by start time
+type SessionResponse struct {
+	SessionOverview
+	Turns     []TraceOverview `json:"turns"`
+	Truncated bool            `json:"truncated,omitempty"` // Too many spans or traces to load, so some turns may be missing or incomplete
 }
 
 // Span represents a single span in a trace
@@ -68,9 +106,15 @@ type Span struct {
 	EndTime         time.Time              `json:"endTime,omitempty"`
 	DurationInNanos int64                  `json:"durationInNanos"`
 	Status          string                 `json:"status,omitempty"`
+	StatusMessage   string                 `json:"statusMessage,omitempty"` // Description of the status, usually set for errors
 	Attributes      map[string]interface{} `json:"attributes,omitempty"`
 	Resource        map[string]interface{} `json:"resource,omitempty"`
+	Events          []SpanEvent            `json:"events,omitempty"`        // Events recorded during the span (exceptions, streamed tokens, ...)
+	Links           []SpanLink             `json:"links,omitempty"`         // Links to spans of the same or other traces
 	AmpAttributes   *AmpAttributes         `json:"ampAttributes,omitempty"` // AMP-specific enriched attributes
+	Redaction       *Redaction             `json:"redaction,omitempty"`     // Set when the trace observer redacted sensitive values
+	Agent           *TraceAgent            `json:"agent,omitempty"`         // Agent that produced the span, set when spans of several agents are returned
+	AgentBoundary   bool                   `json:"agentBoundary,omitempty"` // Set when the parent span belongs to another agent, where the trace crosses into this agent
 }
 
 // AmpAttributes contains AMP-specific enriched attributes
@@ -106,11 +150,20 @@ type ToolDefinition struct {
 	Parameters  string `json:"parameters,omitempty"`  // JSON schema of parameters
 }
 
-// SpanEvent represents an event within a span (for future use)
+// SpanEvent represents an event within a span
 type SpanEvent struct {
-	Name       string            `json:"name"`
-	Timestamp  time.Time         `json:"timestamp"`
-	Attributes map[string]string `json:"attributes,omitempty"`
+	Name       string                 `json:"name"`
+	Timestamp  time.Time              `json:"timestamp"`
+	Kind       string                 `json:"kind"` // exception, token or custom
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// SpanLink represents a link from a span to another span. Links to other traces connect
+// the traces of async agent hand-offs and can be followed with the trace ID and span ID.
+type SpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // SpanStatus represents the status of a span (for future use)
@@ -121,8 +174,40 @@ type SpanStatus struct {
 
 // TraceResponse represents the response for trace details
 type TraceResponse struct {
-	Spans      []Span       `json:"spans"`
-	TotalCount int          `json:"totalCount"`
-	TokenUsage *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
-	Status     *TraceStatus `json:"status,omitempty"`     // Trace status including error information
+	Spans         []Span        `json:"spans"`
+	TotalCount    int           `json:"totalCount"`
+	TokenUsage    *TokenUsage   `json:"tokenUsage,omitempty"`    // Aggregated token usage from GenAI spans
+	EstimatedCost *CostEstimate `json:"estimatedCost,omitempty"` // Estimated LLM cost from token usage and model prices
+	Status        *TraceStatus  `json:"status,omitempty"`        // Trace status including error information
+	Agents        []TraceAgent  `json:"agents,omitempty"`        // Agents that produced spans of the trace, set when spans of several agents are returned
+}
+
+// TraceAgent identifies an agent that took part in a trace
+type TraceAgent struct {
+	ProjectName string `json:"projectName"`
+	AgentName   string `json:"agentName"`
+}
+
+// AgentMetricsResponse represents time-bucketed metrics of an agent in an environment
+type AgentMetricsResponse struct {
+	Interval string               `json:"interval"` // Width of each bucket, e.g. 5m or 1h
+	Summary  AgentMetricsBucket   `json:"summary"`  // Metrics over the whole time range
+	Buckets  []AgentMetricsBucket `json:"buckets"`  // Metrics per time bucket in ascending time order
+}
+
+// AgentMetricsBucket holds the metrics of a time bucket
+type AgentMetricsBucket struct {
+	Timestamp    string              `json:"timestamp,omitempty"` // Start of the bucket, omitted for the summary
+	RequestCount int                 `json:"requestCount"`
+	ErrorCount   int                 `json:"errorCount"` // Number of traces with at least one error span
+	ErrorRate    float64             `json:"errorRate"`
+	Latency      *LatencyPercentiles `json:"latency,omitempty"` // Root span latency, omitted when there are no requests
+	TokenUsage   TokenUsage          `json:"tokenUsage"`
+}
+
+// LatencyPercentiles holds latency percentiles in nanoseconds
+type LatencyPercentiles struct {
+	P50InNanos int64 `json:"p50InNanos"`
+	P95InNanos int64 `json:"p95InNanos"`
+	P99InNanos int64 `json:"p99InNanos"`
 }
@@ -0,0 +1,115 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DB Model
+type Webhook struct {
+	ID               uuid.UUID  `gorm:"column:id;primaryKey"`
+	OrgID            uuid.UUID  `gorm:"column:org_id"`
+	ProjectID        *uuid.UUID `gorm:"column:project_id"`
+	ProjectName      string     `gorm:"column:project_name;->"`
+	URL              string     `gorm:"column:url"`
+	EventTypes       []string   `gorm:"column:event_types;type:jsonb;serializer:json"`
+	KeyID            string     `gorm:"column:key_id"`
+	EncryptedDataKey []byte     `gorm:"column:encrypted_data_key"`
+	SecretCiphertext []byte     `gorm:"column:secret_ciphertext"`
+	Enabled          bool       `gorm:"column:enabled"`
+	CreatedBy        uuid.UUID  `gorm:"column:created_by"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at"`
+}
+
+// DB Model
+type WebhookDelivery struct {
+	ID             uuid.UUID            `gorm:"column:id;primaryKey"`
+	WebhookID      uuid.UUID            `gorm:"column:webhook_id"`
+	EventID        uuid.UUID            `gorm:"column:event_id"`
+	EventType      string               `gorm:"column:event_type"`
+	Payload        *WebhookEventPayload `gorm:"column:payload;type:jsonb;serializer:json"`
+	Status         string               `gorm:"column:status"`
+	Attempts       int                  `gorm:"column:attempts"`
+	NextAttemptAt  time.Time            `gorm:"column:next_attempt_at"`
+	LastStatusCode *int                 `gorm:"column:last_status_code"`
+	LastError      *string              `gorm:"column:last_error"`
+	DeliveredAt    *time.Time           `gorm:"column:delivered_at"`
+	CreatedAt      time.Time            `gorm:"column:created_at"`
+	UpdatedAt      time.Time            `gorm:"column:updated_at"`
+}
+
+// WebhookEventState is the last observed phase of a build or deployment, used to detect transitions
+type WebhookEventState struct {
+	ResourceKey string    `gorm:"column:resource_key;primaryKey"`
+	Phase       string    `gorm:"column:phase"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+}
+
+// WebhookEventPayload is the JSON body posted to webhook subscribers
+type WebhookEventPayload struct {
+	ID          string                  `json:"id"`
+	Type        string                  `json:"type"`
+	OccurredAt  time.Time               `json:"occurredAt"`
+	OrgName     string                  `json:"orgName"`
+	ProjectName string                  `json:"projectName"`
+	AgentName   string                  `json:"agentName"`
+	Build       *WebhookBuildEvent      `json:"build,omitempty"`
+	Deployment  *WebhookDeploymentEvent `json:"deployment,omitempty"`
+}
+
+type WebhookBuildEvent struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	CommitID  string     `json:"commitId,omitempty"`
+	Image     string     `json:"image,omitempty"`
+	StartedAt time.Time  `json:"startedAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
+
+type WebhookDeploymentEvent struct {
+	Environment string `json:"environment"`
+	Status      string `json:"status"`
+}
+
+// API Response DTO
+type WebhookResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	ProjectName string    `json:"projectName,omitempty"`
+	EventTypes  []string  `json:"eventTypes"`
+	Enabled     bool      `json:"enabled"`
+	CreatedBy   string    `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// API Response DTO
+type WebhookDeliveryResponse struct {
+	ID             string     `json:"id"`
+	EventID        string     `json:"eventId"`
+	EventType      string     `json:"eventType"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int       `json:"lastStatusCode,omitempty"`
+	LastError      *string    `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
@@ -34,6 +34,7 @@ type AgentRepository interface {
 	SoftDeleteAgentByName(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
 	HardDeleteAgentByName(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
 	UpdateAgentTimestamp(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
+	UpdateAgentDetails(ctx context.Context, agentId uuid.UUID, displayName string, description string) error
 	RollbackSoftDeleteAgent(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error
 }
 
@@ -97,6 +98,19 @@ func (r *agentRepository) UpdateAgentTimestamp(ctx context.Context, orgId uuid.U
 	return nil
 }
 
+func (r *agentRepository) UpdateAgentDetails(ctx context.Context, agentId uuid.UUID, displayName string, description string) error {
+	if err := db.DB(ctx).Model(&models.Agent{}).
+		Where("id = ?", agentId).
+		Updates(map[string]interface{}{
+			"display_name": displayName,
+			"description":  description,
+			"updated_at":   gorm.Expr("NOW()"),
+		}).Error; err != nil {
+		return fmt.Errorf("agentRepository.UpdateAgentDetails: %w", err)
+	}
+	return nil
+}
+
 func (r *agentRepository) RollbackSoftDeleteAgent(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, agentName string) error {
 	if err := db.DB(ctx).Unscoped().Model(&models.Agent{}).
 		Where("org_id = ? AND project_id = ? AND name = ?", orgId, projectId, agentName).
@@ -0,0 +1,58 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type AgentFeedbackKeyRepository interface {
+	// UpsertAgentFeedbackKey stores the key, replacing the existing key of the agent
+	UpsertAgentFeedbackKey(ctx context.Context, key *models.AgentFeedbackKey) error
+	GetAgentFeedbackKey(ctx context.Context, projectId uuid.UUID, agentName string) (*models.AgentFeedbackKey, error)
+}
+
+type agentFeedbackKeyRepository struct{}
+
+func NewAgentFeedbackKeyRepository() AgentFeedbackKeyRepository {
+	return &agentFeedbackKeyRepository{}
+}
+
+func (r *agentFeedbackKeyRepository) UpsertAgentFeedbackKey(ctx context.Context, key *models.AgentFeedbackKey) error {
+	if err := db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "agent_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"id", "key_hash", "created_by", "created_at"}),
+	}).Create(key).Error; err != nil {
+		return fmt.Errorf("agentFeedbackKeyRepository.UpsertAgentFeedbackKey: %w", err)
+	}
+	return nil
+}
+
+func (r *agentFeedbackKeyRepository) GetAgentFeedbackKey(ctx context.Context, projectId uuid.UUID, agentName string) (*models.AgentFeedbackKey, error) {
+	var key models.AgentFeedbackKey
+	if err := db.DB(ctx).Where("project_id = ? AND agent_name = ?", projectId, agentName).First(&key).Error; err != nil {
+		return nil, fmt.Errorf("agentFeedbackKeyRepository.GetAgentFeedbackKey: %w", err)
+	}
+	return &key, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type AgentSecretRepository interface {
+	UpsertAgentSecret(ctx context.Context, secret *models.AgentSecret) error
+	ListAgentSecrets(ctx context.Context, agentId uuid.UUID) ([]*models.AgentSecret, error)
+	// DeleteAgentSecrets deletes the secrets of the agent whose keys are not in keepKeys
+	DeleteAgentSecrets(ctx context.Context, agentId uuid.UUID, keepKeys []string) error
+}
+
+type agentSecretRepository struct{}
+
+func NewAgentSecretRepository() AgentSecretRepository {
+	return &agentSecretRepository{}
+}
+
+// UpsertAgentSecret stores the secret, replacing the encrypted value of an existing secret with the same key.
+func (r *agentSecretRepository) UpsertAgentSecret(ctx context.Context, secret *models.AgentSecret) error {
+	if err := db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "agent_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"key_id", "encrypted_data_key", "ciphertext", "updated_at"}),
+	}).Create(secret).Error; err != nil {
+		return fmt.Errorf("agentSecretRepository.UpsertAgentSecret: %w", err)
+	}
+	return nil
+}
+
+func (r *agentSecretRepository) ListAgentSecrets(ctx context.Context, agentId uuid.UUID) ([]*models.AgentSecret, error) {
+	var secrets []*models.AgentSecret
+	if err := db.DB(ctx).Where("agent_id = ?", agentId).Order("key").Find(&secrets).Error; err != nil {
+		return nil, fmt.Errorf("agentSecretRepository.ListAgentSecrets: %w", err)
+	}
+	return secrets, nil
+}
+
+func (r *agentSecretRepository) DeleteAgentSecrets(ctx context.Context, agentId uuid.UUID, keepKeys []string) error {
+	query := db.DB(ctx).Where("agent_id = ?", agentId)
+	if len(keepKeys) > 0 {
+		query = query.Where("key NOT IN ?", keepKeys)
+	}
+	if err := query.Delete(&models.AgentSecret{}).Error; err != nil {
+		return fmt.Errorf("agentSecretRepository.DeleteAgentSecrets: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type AuditEventRepository interface {
+	CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error
+	// ListAuditEvents returns a page of the organization's events, newest first, along with the total number of matching events
+	ListAuditEvents(ctx context.Context, orgName string, filter models.AuditEventFilter, limit int, offset int) ([]*models.AuditEvent, int64, error)
+}
+
+type auditEventRepository struct{}
+
+func NewAuditEventRepository() AuditEventRepository {
+	return &auditEventRepository{}
+}
+
+func (r *auditEventRepository) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	if err := db.DB(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("auditEventRepository.CreateAuditEvent: %w", err)
+	}
+	return nil
+}
+
+func (r *auditEventRepository) ListAuditEvents(ctx context.Context, orgName string, filter models.AuditEventFilter, limit int, offset int) ([]*models.AuditEvent, int64, error) {
+	query := db.DB(ctx).Model(&models.AuditEvent{}).Where("org_name = ?", orgName)
+	if filter.Actor != nil {
+		query = query.Where("actor = ?", *filter.Actor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.ProjectName != "" {
+		query = query.Where("project_name = ?", filter.ProjectName)
+	}
+	if filter.AgentName != "" {
+		query = query.Where("agent_name = ?", filter.AgentName)
+	}
+	if filter.Result != "" {
+		query = query.Where("result = ?", filter.Result)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("auditEventRepository.ListAuditEvents: %w", err)
+	}
+	var events []*models.AuditEvent
+	if err := query.Order("created_at DESC").Order("id").Limit(limit).Offset(offset).Find(&events).Error; err != nil {
+		return nil, 0, fmt.Errorf("auditEventRepository.ListAuditEvents: %w", err)
+	}
+	return events, total, nil
+}
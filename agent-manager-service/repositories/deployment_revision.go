@@ -0,0 +1,94 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type DeploymentRevisionRepository interface {
+	CreateDeploymentRevision(ctx context.Context, revision *models.DeploymentRevision) error
+	ListDeploymentRevisions(ctx context.Context, agentId uuid.UUID, environment string) ([]*models.DeploymentRevision, error)
+	GetDeploymentRevision(ctx context.Context, agentId uuid.UUID, environment string, revision int) (*models.DeploymentRevision, error)
+	GetLatestDeploymentRevision(ctx context.Context, agentId uuid.UUID, environment string) (*models.DeploymentRevision, error)
+}
+
+type deploymentRevisionRepository struct{}
+
+func NewDeploymentRevisionRepository() DeploymentRevisionRepository {
+	return &deploymentRevisionRepository{}
+}
+
+// CreateDeploymentRevision inserts the revision with the next revision number for the agent and environment.
+// The agent row is locked for the duration of the transaction so that concurrent deployments do not
+// allocate the same revision number.
+func (r *deploymentRevisionRepository) CreateDeploymentRevision(ctx context.Context, revision *models.DeploymentRevision) error {
+	conn := db.DB(ctx)
+	if err := conn.Exec("SELECT id FROM agents WHERE id = ? FOR UPDATE", revision.AgentID).Error; err != nil {
+		return fmt.Errorf("deploymentRevisionRepository.CreateDeploymentRevision: %w", err)
+	}
+	var latest int
+	if err := conn.Model(&models.DeploymentRevision{}).
+		Where("agent_id = ? AND environment = ?", revision.AgentID, revision.Environment).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error; err != nil {
+		return fmt.Errorf("deploymentRevisionRepository.CreateDeploymentRevision: %w", err)
+	}
+	revision.Revision = latest + 1
+	if err := conn.Create(revision).Error; err != nil {
+		return fmt.Errorf("deploymentRevisionRepository.CreateDeploymentRevision: %w", err)
+	}
+	return nil
+}
+
+func (r *deploymentRevisionRepository) ListDeploymentRevisions(ctx context.Context, agentId uuid.UUID, environment string) ([]*models.DeploymentRevision, error) {
+	var revisions []*models.DeploymentRevision
+	if err := db.DB(ctx).
+		Where("agent_id = ? AND environment = ?", agentId, environment).
+		Order("revision DESC").
+		Find(&revisions).Error; err != nil {
+		return nil, fmt.Errorf("deploymentRevisionRepository.ListDeploymentRevisions: %w", err)
+	}
+	return revisions, nil
+}
+
+func (r *deploymentRevisionRepository) GetDeploymentRevision(ctx context.Context, agentId uuid.UUID, environment string, revision int) (*models.DeploymentRevision, error) {
+	var deploymentRevision models.DeploymentRevision
+	if err := db.DB(ctx).
+		Where("agent_id = ? AND environment = ? AND revision = ?", agentId, environment, revision).
+		First(&deploymentRevision).Error; err != nil {
+		return nil, fmt.Errorf("deploymentRevisionRepository.GetDeploymentRevision: %w", err)
+	}
+	return &deploymentRevision, nil
+}
+
+func (r *deploymentRevisionRepository) GetLatestDeploymentRevision(ctx context.Context, agentId uuid.UUID, environment string) (*models.DeploymentRevision, error) {
+	var deploymentRevision models.DeploymentRevision
+	if err := db.DB(ctx).
+		Where("agent_id = ? AND environment = ?", agentId, environment).
+		Order("revision DESC").
+		First(&deploymentRevision).Error; err != nil {
+		return nil, fmt.Errorf("deploymentRevisionRepository.GetLatestDeploymentRevision: %w", err)
+	}
+	return &deploymentRevision, nil
+}
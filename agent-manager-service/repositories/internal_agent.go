@@ -29,6 +29,7 @@ import (
 type InternalAgentRepository interface {
 	GetAgentById(ctx context.Context, agentId uuid.UUID) (*models.InternalAgent, error)
 	CreateInternalAgent(ctx context.Context, agent *models.InternalAgent) error
+	UpdateWorkloadSpec(ctx context.Context, agentId uuid.UUID, workloadSpec map[string]interface{}) error
 }
 
 type internalAgentRepository struct{}
@@ -51,3 +52,11 @@ func (r *internalAgentRepository) CreateInternalAgent(ctx context.Context, agent
 	}
 	return nil
 }
+
+func (r *internalAgentRepository) UpdateWorkloadSpec(ctx context.Context, agentId uuid.UUID, workloadSpec map[string]interface{}) error {
+	internalAgent := &models.InternalAgent{ID: agentId, WorkloadSpec: workloadSpec}
+	if err := db.DB(ctx).Save(internalAgent).Error; err != nil {
+		return fmt.Errorf("internalAgentRepository.UpdateWorkloadSpec: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type MembershipRepository interface {
+	ListOrganizationMembers(ctx context.Context, orgId uuid.UUID) ([]models.OrganizationMember, error)
+	GetOrganizationMember(ctx context.Context, orgId uuid.UUID, userIdpId uuid.UUID) (*models.OrganizationMember, error)
+	UpsertOrganizationMember(ctx context.Context, member *models.OrganizationMember) error
+	DeleteOrganizationMember(ctx context.Context, orgId uuid.UUID, userIdpId uuid.UUID) error
+	ListProjectMembers(ctx context.Context, projectId uuid.UUID) ([]models.ProjectMember, error)
+	GetProjectMember(ctx context.Context, projectId uuid.UUID, userIdpId uuid.UUID) (*models.ProjectMember, error)
+	UpsertProjectMember(ctx context.Context, member *models.ProjectMember) error
+	DeleteProjectMember(ctx context.Context, projectId uuid.UUID, userIdpId uuid.UUID) error
+	// HasProjectMembershipInOrganization reports whether the user is a member of any live project of the organization
+	HasProjectMembershipInOrganization(ctx context.Context, orgId uuid.UUID, userIdpId uuid.UUID) (bool, error)
+}
+
+type membershipRepository struct{}
+
+func NewMembershipRepository() MembershipRepository {
+	return &membershipRepository{}
+}
+
+func (r *membershipRepository) ListOrganizationMembers(ctx context.Context, orgId uuid.UUID) ([]models.OrganizationMember, error) {
+	var members []models.OrganizationMember
+	if err := db.DB(ctx).Where("org_id = ?", orgId).Order("created_at").Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("membershipRepository.ListOrganizationMembers: %w", err)
+	}
+	return members, nil
+}
+
+func (r *membershipRepository) GetOrganizationMember(ctx context.Context, orgId uuid.UUID, userIdpId uuid.UUID) (*models.OrganizationMember, error) {
+	var member models.OrganizationMember
+	if err := db.DB(ctx).Where("org_id = ? AND user_idp_id = ?", orgId, userIdpId).First(&member).Error; err != nil {
+		return nil, fmt.Errorf("membershipRepository.GetOrganizationMember: %w", err)
+	}
+	return &member, nil
+}
+
+// UpsertOrganizationMember adds the member, replacing the role of an existing membership of the same user.
+func (r *membershipRepository) UpsertOrganizationMember(ctx context.Context, member *models.OrganizationMember) error {
+	if err := db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}, {Name: "user_idp_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(member).Error; err != nil {
+		return fmt.Errorf("membershipRepository.UpsertOrganizationMember: %w", err)
+	}
+	return nil
+}
+
+func (r *membershipRepository) DeleteOrganizationMember(ctx context.Context, orgId uuid.UUID, userIdpId uuid.UUID) error {
+	if err := db.DB(ctx).Where("org_id = ? AND user_idp_id = ?", orgId, userIdpId).Delete(&models.OrganizationMember{}).Error; err != nil {
+		return fmt.Errorf("membershipRepository.DeleteOrganizationMember: %w", err)
+	}
+	return nil
+}
+
+func (r *membershipRepository) ListProjectMembers(ctx context.Context, projectId uuid.UUID) ([]models.ProjectMember, error) {
+	var members []models.ProjectMember
+	if err := db.DB(ctx).Where("project_id = ?", projectId).Order("created_at").Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("membershipRepository.ListProjectMembers: %w", err)
+	}
+	return members, nil
+}
+
+func (r *membershipRepository) GetProjectMember(ctx context.Context, projectId uuid.UUID, userIdpId uuid.UUID) (*models.ProjectMember, error) {
+	var member models.ProjectMember
+	if err := db.DB(ctx).Where("project_id = ? AND user_idp_id = ?", projectId, userIdpId).First(&member).Error; err != nil {
+		return nil, fmt.Errorf("membershipRepository.GetProjectMember: %w", err)
+	}
+	return &member, nil
+}
+
+// UpsertProjectMember adds the member, replacing the role of an existing membership of the same user.
+func (r *membershipRepository) UpsertProjectMember(ctx context.Context, member *models.ProjectMember) error {
+	if err := db.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_idp_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(member).Error; err != nil {
+		return fmt.Errorf("membershipRepository.UpsertProjectMember: %w", err)
+	}
+	return nil
+}
+
+func (r *membershipRepository) DeleteProjectMember(ctx context.Context, projectId uuid.UUID, userIdpId uuid.UUID) error {
+	if err := db.DB(ctx).Where("project_id = ? AND user_idp_id = ?", projectId, userIdpId).Delete(&models.ProjectMember{}).Error; err != nil {
+		return fmt.Errorf("membershipRepository.DeleteProjectMember: %w", err)
+	}
+	return nil
+}
+
+func (r *membershipRepository) HasProjectMembershipInOrganization(ctx context.Context, orgId uuid.UUID, userIdpId uuid.UUID) (bool, error) {
+	var count int64
+	if err := db.DB(ctx).Table("project_members").
+		Joins("JOIN projects ON projects.id = project_members.project_id").
+		Where("projects.org_id = ? AND projects.deleted_at IS NULL AND project_members.user_idp_id = ?", orgId, userIdpId).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("membershipRepository.HasProjectMembershipInOrganization: %w", err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type ModelPriceRepository interface {
+	CreateModelPrice(ctx context.Context, price *models.ModelPrice) error
+	// ListModelPrices returns the organization's model prices ordered by vendor, model and effective date
+	ListModelPrices(ctx context.Context, orgId uuid.UUID) ([]*models.ModelPrice, error)
+	GetModelPrice(ctx context.Context, orgId uuid.UUID, priceId uuid.UUID) (*models.ModelPrice, error)
+	UpdateModelPrice(ctx context.Context, price *models.ModelPrice) error
+	DeleteModelPrice(ctx context.Context, orgId uuid.UUID, priceId uuid.UUID) error
+	// LockModelPrices blocks other writers of the organization's model prices until the transaction ends
+	LockModelPrices(ctx context.Context, orgId uuid.UUID) error
+}
+
+type modelPriceRepository struct{}
+
+func NewModelPriceRepository() ModelPriceRepository {
+	return &modelPriceRepository{}
+}
+
+func (r *modelPriceRepository) CreateModelPrice(ctx context.Context, price *models.ModelPrice) error {
+	if err := db.DB(ctx).Create(price).Error; err != nil {
+		return fmt.Errorf("modelPriceRepository.CreateModelPrice: %w", err)
+	}
+	return nil
+}
+
+func (r *modelPriceRepository) ListModelPrices(ctx context.Context, orgId uuid.UUID) ([]*models.ModelPrice, error) {
+	var prices []*models.ModelPrice
+	if err := db.DB(ctx).Where("org_id = ?", orgId).
+		Order("vendor, model, effective_from").Find(&prices).Error; err != nil {
+		return nil, fmt.Errorf("modelPriceRepository.ListModelPrices: %w", err)
+	}
+	return prices, nil
+}
+
+func (r *modelPriceRepository) GetModelPrice(ctx context.Context, orgId uuid.UUID, priceId uuid.UUID) (*models.ModelPrice, error) {
+	var price models.ModelPrice
+	if err := db.DB(ctx).Where("org_id = ? AND id = ?", orgId, priceId).First(&price).Error; err != nil {
+		return nil, fmt.Errorf("modelPriceRepository.GetModelPrice: %w", err)
+	}
+	return &price, nil
+}
+
+func (r *modelPriceRepository) UpdateModelPrice(ctx context.Context, price *models.ModelPrice) error {
+	price.UpdatedAt = time.Now()
+	if err := db.DB(ctx).Model(price).Select("vendor", "model", "input_price_per_1k", "output_price_per_1k",
+		"effective_from", "effective_to", "updated_at").Updates(price).Error; err != nil {
+		return fmt.Errorf("modelPriceRepository.UpdateModelPrice: %w", err)
+	}
+	return nil
+}
+
+func (r *modelPriceRepository) DeleteModelPrice(ctx context.Context, orgId uuid.UUID, priceId uuid.UUID) error {
+	if err := db.DB(ctx).Where("org_id = ? AND id = ?", orgId, priceId).Delete(&models.ModelPrice{}).Error; err != nil {
+		return fmt.Errorf("modelPriceRepository.DeleteModelPrice: %w", err)
+	}
+	return nil
+}
+
+// LockModelPrices locks the organization row, so that concurrent writers cannot both pass the overlap check
+// for the same model before either of them writes. It must be called within a transaction.
+func (r *modelPriceRepository) LockModelPrices(ctx context.Context, orgId uuid.UUID) error {
+	if err := db.DB(ctx).Exec("SELECT id FROM organizations WHERE id = ? FOR UPDATE", orgId).Error; err != nil {
+		return fmt.Errorf("modelPriceRepository.LockModelPrices: %w", err)
+	}
+	return nil
+}
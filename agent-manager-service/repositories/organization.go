@@ -18,6 +18,7 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -31,6 +32,8 @@ type OrganizationRepository interface {
 	CreateOrganization(ctx context.Context, organization *models.Organization) error
 	GetOrganizationByOrgName(ctx context.Context, userIdpID uuid.UUID, orgName string) (*models.Organization, error)
 	GetOrganizationById(ctx context.Context, orgId uuid.UUID) (*models.Organization, error)
+	// This is used only for authorization, which resolves the caller's role separately
+	GetOrganizationByName(ctx context.Context, orgName string) (*models.Organization, error)
 	// This is used only for internal build callback handling
 	GetOrganizationByOcName(ctx context.Context, orgName string) (*models.Organization, error)
 }
@@ -41,9 +44,14 @@ func NewOrganizationRepository() OrganizationRepository {
 	return &organizationRepository{}
 }
 
+// accessibleByUser matches organizations that the user owns, is a member of, or has a project membership in.
+const accessibleByUser = `(user_idp_id = @user OR id IN (SELECT org_id FROM organization_members WHERE user_idp_id = @user)
+	OR id IN (SELECT projects.org_id FROM project_members JOIN projects ON projects.id = project_members.project_id
+		WHERE project_members.user_idp_id = @user AND projects.deleted_at IS NULL))`
+
 func (r *organizationRepository) GetOrganizationsByUserIdpID(ctx context.Context, userIdpID uuid.UUID) ([]models.Organization, error) {
 	var orgs []models.Organization
-	if err := db.DB(ctx).Where("user_idp_id = ?", userIdpID).Order("created_at DESC").Find(&orgs).Error; err != nil {
+	if err := db.DB(ctx).Where(accessibleByUser, sql.Named("user", userIdpID)).Order("created_at DESC").Find(&orgs).Error; err != nil {
 		return nil, fmt.Errorf("organizationRepository.GetOrganizationsByUserIdpID: %w", err)
 	}
 	return orgs, nil
@@ -58,7 +66,7 @@ func (r *organizationRepository) CreateOrganization(ctx context.Context, organiz
 
 func (r *organizationRepository) GetOrganizationByOrgName(ctx context.Context, userIdpID uuid.UUID, orgName string) (*models.Organization, error) {
 	var org models.Organization
-	if err := db.DB(ctx).Where("user_idp_id = ? AND org_name = ?", userIdpID, orgName).First(&org).Error; err != nil {
+	if err := db.DB(ctx).Where(accessibleByUser, sql.Named("user", userIdpID)).Where("org_name = ?", orgName).First(&org).Error; err != nil {
 		return nil, fmt.Errorf("organizationRepository.GetOrganizationByOrgName: %w", err)
 	}
 	return &org, nil
@@ -72,6 +80,14 @@ func (r *organizationRepository) GetOrganizationById(ctx context.Context, orgId
 	return &org, nil
 }
 
+func (r *organizationRepository) GetOrganizationByName(ctx context.Context, orgName string) (*models.Organization, error) {
+	var org models.Organization
+	if err := db.DB(ctx).Where("org_name = ?", orgName).First(&org).Error; err != nil {
+		return nil, fmt.Errorf("organizationRepository.GetOrganizationByName: %w", err)
+	}
+	return &org, nil
+}
+
 func (r *organizationRepository) GetOrganizationByOcName(ctx context.Context, orgName string) (*models.Organization, error) {
 	var org models.Organization
 	if err := db.DB(ctx).Where("open_choreo_org_name = ?", orgName).First(&org).Error; err != nil {
@@ -0,0 +1,127 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+type TraceAnnotationRepository interface {
+	CreateTraceAnnotation(ctx context.Context, annotation *models.TraceAnnotation) error
+	// ListTraceAnnotations returns the annotations of a trace of an agent, oldest first
+	ListTraceAnnotations(ctx context.Context, projectId uuid.UUID, agentName string, traceId string) ([]*models.TraceAnnotation, error)
+	GetTraceAnnotation(ctx context.Context, projectId uuid.UUID, agentName string, traceId string, annotationId uuid.UUID) (*models.TraceAnnotation, error)
+	DeleteTraceAnnotation(ctx context.Context, projectId uuid.UUID, annotationId uuid.UUID) error
+	// SummarizeTraceAnnotations counts the annotations of the given traces of a project, keyed by trace ID.
+	// Traces without annotations are omitted.
+	SummarizeTraceAnnotations(ctx context.Context, projectId uuid.UUID, traceIds []string) (map[string]*models.AnnotationSummary, error)
+	// ListRatedTraceIDs returns the IDs of the traces with at least one annotation of the rating, most recently
+	// rated first. All agents of the project are included when agentName is empty.
+	ListRatedTraceIDs(ctx context.Context, projectId uuid.UUID, agentName string, rating string, limit int) ([]string, error)
+}
+
+type traceAnnotationRepository struct{}
+
+func NewTraceAnnotationRepository() TraceAnnotationRepository {
+	return &traceAnnotationRepository{}
+}
+
+// traceAnnotationSummaryRow is a row of the per-trace annotation counts
+type traceAnnotationSummaryRow struct {
+	TraceID       string   `gorm:"column:trace_id"`
+	Count         int      `gorm:"column:count"`
+	PositiveCount int      `gorm:"column:positive_count"`
+	NegativeCount int      `gorm:"column:negative_count"`
+	AverageScore  *float64 `gorm:"column:average_score"`
+}
+
+func (r *traceAnnotationRepository) CreateTraceAnnotation(ctx context.Context, annotation *models.TraceAnnotation) error {
+	if err := db.DB(ctx).Create(annotation).Error; err != nil {
+		return fmt.Errorf("traceAnnotationRepository.CreateTraceAnnotation: %w", err)
+	}
+	return nil
+}
+
+func (r *traceAnnotationRepository) ListTraceAnnotations(ctx context.Context, projectId uuid.UUID, agentName string, traceId string) ([]*models.TraceAnnotation, error) {
+	var annotations []*models.TraceAnnotation
+	if err := db.DB(ctx).Where("project_id = ? AND agent_name = ? AND trace_id = ?", projectId, agentName, traceId).
+		Order("created_at, id").Find(&annotations).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.ListTraceAnnotations: %w", err)
+	}
+	return annotations, nil
+}
+
+func (r *traceAnnotationRepository) GetTraceAnnotation(ctx context.Context, projectId uuid.UUID, agentName string, traceId string, annotationId uuid.UUID) (*models.TraceAnnotation, error) {
+	var annotation models.TraceAnnotation
+	if err := db.DB(ctx).Where("project_id = ? AND agent_name = ? AND trace_id = ? AND id = ?", projectId, agentName, traceId, annotationId).
+		First(&annotation).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.GetTraceAnnotation: %w", err)
+	}
+	return &annotation, nil
+}
+
+func (r *traceAnnotationRepository) DeleteTraceAnnotation(ctx context.Context, projectId uuid.UUID, annotationId uuid.UUID) error {
+	if err := db.DB(ctx).Where("project_id = ? AND id = ?", projectId, annotationId).Delete(&models.TraceAnnotation{}).Error; err != nil {
+		return fmt.Errorf("traceAnnotationRepository.DeleteTraceAnnotation: %w", err)
+	}
+	return nil
+}
+
+func (r *traceAnnotationRepository) SummarizeTraceAnnotations(ctx context.Context, projectId uuid.UUID, traceIds []string) (map[string]*models.AnnotationSummary, error) {
+	summaries := make(map[string]*models.AnnotationSummary)
+	if len(traceIds) == 0 {
+		return summaries, nil
+	}
+	var rows []traceAnnotationSummaryRow
+	if err := db.DB(ctx).Model(&models.TraceAnnotation{}).
+		Select("trace_id, COUNT(*) AS count, "+
+			"COUNT(*) FILTER (WHERE rating = 'positive') AS positive_count, "+
+			"COUNT(*) FILTER (WHERE rating = 'negative') AS negative_count, "+
+			"AVG(score) AS average_score").
+		Where("project_id = ? AND trace_id IN ?", projectId, traceIds).
+		Group("trace_id").Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.SummarizeTraceAnnotations: %w", err)
+	}
+	for _, row := range rows {
+		summaries[row.TraceID] = &models.AnnotationSummary{
+			Count:         row.Count,
+			PositiveCount: row.PositiveCount,
+			NegativeCount: row.NegativeCount,
+			AverageScore:  row.AverageScore,
+		}
+	}
+	return summaries, nil
+}
+
+func (r *traceAnnotationRepository) ListRatedTraceIDs(ctx context.Context, projectId uuid.UUID, agentName string, rating string, limit int) ([]string, error) {
+	query := db.DB(ctx).Model(&models.TraceAnnotation{}).Where("project_id = ? AND rating = ?", projectId, rating)
+	if agentName != "" {
+		query = query.Where("agent_name = ?", agentName)
+	}
+	var traceIds []string
+	if err := query.Group("trace_id").Order("MAX(created_at) DESC").Limit(limit).
+		Pluck("trace_id", &traceIds).Error; err != nil {
+		return nil, fmt.Errorf("traceAnnotationRepository.ListRatedTraceIDs: %w", err)
+	}
+	return traceIds, nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	ListWebhooks(ctx context.Context, orgId uuid.UUID) ([]*models.Webhook, error)
+	GetWebhook(ctx context.Context, orgId uuid.UUID, webhookId uuid.UUID) (*models.Webhook, error)
+	GetWebhookByID(ctx context.Context, webhookId uuid.UUID) (*models.Webhook, error)
+	DeleteWebhook(ctx context.Context, orgId uuid.UUID, webhookId uuid.UUID) error
+	// ListOrganizationsWithWebhooks returns the organizations that have at least one enabled webhook
+	ListOrganizationsWithWebhooks(ctx context.Context) ([]models.Organization, error)
+	// RecordEventState stores the latest phase of a resource. It reports whether the phase changed,
+	// and whether this is the first time the resource has been seen.
+	RecordEventState(ctx context.Context, resourceKey string, phase string) (changed bool, firstSeen bool, err error)
+	// ListEventStateKeys returns the keys of the recorded resources whose key starts with the prefix
+	ListEventStateKeys(ctx context.Context, keyPrefix string) ([]string, error)
+	DeleteEventStates(ctx context.Context, resourceKeys []string) error
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	// ListWebhookDeliveries returns a page of the webhook's deliveries, newest first, along with the total number of matching deliveries
+	ListWebhookDeliveries(ctx context.Context, webhookId uuid.UUID, status string, limit int, offset int) ([]*models.WebhookDelivery, int64, error)
+	// ClaimDueWebhookDeliveries leases pending deliveries that are due so that no other worker picks them up before leaseUntil
+	ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*models.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// webhookEventStateDeleteBatchSize bounds the number of keys deleted by a single statement
+const webhookEventStateDeleteBatchSize = 1000
+
+type webhookRepository struct{}
+
+func NewWebhookRepository() WebhookRepository {
+	return &webhookRepository{}
+}
+
+// webhooksWithProjectName selects webhooks along with the name of the project they are scoped to
+func webhooksWithProjectName(ctx context.Context) *gorm.DB {
+	return db.DB(ctx).Model(&models.Webhook{}).
+		Select("webhooks.*, projects.name AS project_name").
+		Joins("LEFT JOIN projects ON projects.id = webhooks.project_id")
+}
+
+func (r *webhookRepository) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	if err := db.DB(ctx).Create(webhook).Error; err != nil {
+		return fmt.Errorf("webhookRepository.CreateWebhook: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) ListWebhooks(ctx context.Context, orgId uuid.UUID) ([]*models.Webhook, error) {
+	var webhooks []*models.Webhook
+	if err := webhooksWithProjectName(ctx).Where("webhooks.org_id = ?", orgId).
+		Order("webhooks.created_at DESC").Find(&webhooks).Error; err != nil {
+		return nil, fmt.Errorf("webhookRepository.ListWebhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func (r *webhookRepository) GetWebhook(ctx context.Context, orgId uuid.UUID, webhookId uuid.UUID) (*models.Webhook, error) {
+	var webhook models.Webhook
+	if err := webhooksWithProjectName(ctx).Where("webhooks.org_id = ? AND webhooks.id = ?", orgId, webhookId).
+		First(&webhook).Error; err != nil {
+		return nil, fmt.Errorf("webhookRepository.GetWebhook: %w", err)
+	}
+	return &webhook, nil
+}
+
+func (r *webhookRepository) GetWebhookByID(ctx context.Context, webhookId uuid.UUID) (*models.Webhook, error) {
+	var webhook models.Webhook
+	if err := webhooksWithProjectName(ctx).Where("webhooks.id = ?", webhookId).First(&webhook).Error; err != nil {
+		return nil, fmt.Errorf("webhookRepository.GetWebhookByID: %w", err)
+	}
+	return &webhook, nil
+}
+
+func (r *webhookRepository) DeleteWebhook(ctx context.Context, orgId uuid.UUID, webhookId uuid.UUID) error {
+	if err := db.DB(ctx).Where("org_id = ? AND id = ?", orgId, webhookId).Delete(&models.Webhook{}).Error; err != nil {
+		return fmt.Errorf("webhookRepository.DeleteWebhook: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) ListOrganizationsWithWebhooks(ctx context.Context) ([]models.Organization, error) {
+	var orgs []models.Organization
+	if err := db.DB(ctx).
+		Where("id IN (?)", db.DB(ctx).Model(&models.Webhook{}).Select("org_id").Where("enabled")).
+		Find(&orgs).Error; err != nil {
+		return nil, fmt.Errorf("webhookRepository.ListOrganizationsWithWebhooks: %w", err)
+	}
+	return orgs, nil
+}
+
+func (r *webhookRepository) RecordEventState(ctx context.Context, resourceKey string, phase string) (bool, bool, error) {
+	now := time.Now()
+	result := db.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&models.WebhookEventState{
+		ResourceKey: resourceKey,
+		Phase:       phase,
+		UpdatedAt:   now,
+	})
+	if result.Error != nil {
+		return false, false, fmt.Errorf("webhookRepository.RecordEventState: %w", result.Error)
+	}
+	if result.RowsAffected == 1 {
+		return true, true, nil
+	}
+
+	result = db.DB(ctx).Model(&models.WebhookEventState{}).
+		Where("resource_key = ? AND phase <> ?", resourceKey, phase).
+		Updates(map[string]interface{}{"phase": phase, "updated_at": now})
+	if result.Error != nil {
+		return false, false, fmt.Errorf("webhookRepository.RecordEventState: %w", result.Error)
+	}
+	return result.RowsAffected == 1, false, nil
+}
+
+func (r *webhookRepository) ListEventStateKeys(ctx context.Context, keyPrefix string) ([]string, error) {
+	var keys []string
+	if err := db.DB(ctx).Model(&models.WebhookEventState{}).
+		Where("resource_key LIKE ?", keyPrefix+"%").
+		Pluck("resource_key", &keys).Error; err != nil {
+		return nil, fmt.Errorf("webhookRepository.ListEventStateKeys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *webhookRepository) DeleteEventStates(ctx context.Context, resourceKeys []string) error {
+	for batch := range slices.Chunk(resourceKeys, webhookEventStateDeleteBatchSize) {
+		if err := db.DB(ctx).Where("resource_key IN ?", batch).Delete(&models.WebhookEventState{}).Error; err != nil {
+			return fmt.Errorf("webhookRepository.DeleteEventStates: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *webhookRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := db.DB(ctx).Create(deliveries).Error; err != nil {
+		return fmt.Errorf("webhookRepository.CreateWebhookDeliveries: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) ListWebhookDeliveries(ctx context.Context, webhookId uuid.UUID, status string, limit int, offset int) ([]*models.WebhookDelivery, int64, error) {
+	query := db.DB(ctx).Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhookId)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("webhookRepository.ListWebhookDeliveries: %w", err)
+	}
+	var deliveries []*models.WebhookDelivery
+	if err := query.Order("created_at DESC").Order("id").Limit(limit).Offset(offset).Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("webhookRepository.ListWebhookDeliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+func (r *webhookRepository) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", utils.WebhookDeliveryStatusPending, now).
+			Order("next_attempt_at").Limit(limit).Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		ids := make([]uuid.UUID, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+			delivery.NextAttemptAt = leaseUntil
+		}
+		return tx.Model(&models.WebhookDelivery{}).Where("id IN ?", ids).
+			Update("next_attempt_at", leaseUntil).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhookRepository.ClaimDueWebhookDeliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (r *webhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	delivery.UpdatedAt = time.Now()
+	if err := db.DB(ctx).Model(delivery).Select("status", "attempts", "next_attempt_at", "last_status_code",
+		"last_error", "delivered_at", "updated_at").Updates(delivery).Error; err != nil {
+		return fmt.Errorf("webhookRepository.UpdateWebhookDelivery: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const keySize = 32
+
+// EncryptedValue is the result of envelope encrypting a single value
+type EncryptedValue struct {
+	// KeyID identifies the key encryption key used to wrap the data key
+	KeyID string
+	// EncryptedDataKey is the per-value data key encrypted with the key encryption key
+	EncryptedDataKey []byte
+	// Ciphertext is the value encrypted with the data key
+	Ciphertext []byte
+}
+
+// Encryptor encrypts and decrypts secret values using envelope encryption
+type Encryptor interface {
+	Encrypt(plaintext string) (*EncryptedValue, error)
+	Decrypt(value *EncryptedValue) (string, error)
+}
+
+type envelopeEncryptor struct {
+	keyID string
+	kek   cipher.AEAD
+	// keyring holds the key encryption keys that can decrypt, by key ID, including the current key
+	keyring map[string]cipher.AEAD
+}
+
+// NewEnvelopeEncryptor creates an Encryptor from the configured key encryption key. Values are encrypted with
+// the current key and decrypted with the key they were encrypted with, which may be a previous key after a
+// rotation. When no key is configured the returned Encryptor rejects every operation with utils.ErrSecretsNotConfigured.
+func NewEnvelopeEncryptor() (Encryptor, error) {
+	cfg := config.GetConfig().Secrets
+	if cfg.EncryptionKey == "" {
+		return &disabledEncryptor{}, nil
+	}
+	kek, err := parseKey(cfg.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secrets encryption key: %w", err)
+	}
+	keyring := map[string]cipher.AEAD{cfg.EncryptionKeyID: kek}
+
+	for _, entry := range strings.Split(cfg.PreviousEncryptionKeys, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		keyID, encodedKey, found := strings.Cut(entry, "=")
+		if !found || keyID == "" {
+			return nil, fmt.Errorf("previous secrets encryption keys must be keyId=key pairs")
+		}
+		if _, exists := keyring[keyID]; exists {
+			return nil, fmt.Errorf("secrets encryption key ID %q is configured more than once", keyID)
+		}
+		previousKek, err := parseKey(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous secrets encryption key %q: %w", keyID, err)
+		}
+		keyring[keyID] = previousKek
+	}
+	return &envelopeEncryptor{keyID: cfg.EncryptionKeyID, kek: kek, keyring: keyring}, nil
+}
+
+// parseKey decodes a base64 encoded key encryption key
+func parseKey(encodedKey string) (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", keySize, len(key))
+	}
+	return newAEAD(key)
+}
+
+func (e *envelopeEncryptor) Encrypt(plaintext string) (*EncryptedValue, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize data key: %w", err)
+	}
+	ciphertext, err := seal(dek, []byte(plaintext))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt value: %w", err)
+	}
+	encryptedDataKey, err := seal(e.kek, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data key: %w", err)
+	}
+	return &EncryptedValue{
+		KeyID:            e.keyID,
+		EncryptedDataKey: encryptedDataKey,
+		Ciphertext:       ciphertext,
+	}, nil
+}
+
+func (e *envelopeEncryptor) Decrypt(value *EncryptedValue) (string, error) {
+	kek, ok := e.keyring[value.KeyID]
+	if !ok {
+		return "", fmt.Errorf("secret was encrypted with unknown key %q", value.KeyID)
+	}
+	dataKey, err := open(kek, value.EncryptedDataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to initialize data key: %w", err)
+	}
+	plaintext, err := open(dek, value.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+type disabledEncryptor struct{}
+
+func (d *disabledEncryptor) Encrypt(plaintext string) (*EncryptedValue, error) {
+	return nil, utils.ErrSecretsNotConfigured
+}
+
+func (d *disabledEncryptor) Decrypt(value *EncryptedValue) (string, error) {
+	return "", utils.ErrSecretsNotConfigured
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext and prepends the random nonce to the result
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
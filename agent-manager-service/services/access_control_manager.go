@@ -0,0 +1,331 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type AccessControlManager interface {
+	// Authorize returns nil when the user may perform the action in the organization, and in the project when a
+	// project name is given. It returns utils.ErrOrganizationNotFound when the user has no access to the organization
+	// at all, and utils.ErrPermissionDenied when the user's role does not grant the permission.
+	Authorize(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, permission utils.Permission) error
+	ListOrganizationMembers(ctx context.Context, userIdpId uuid.UUID, orgName string) ([]*models.MemberResponse, error)
+	SetOrganizationMemberRole(ctx context.Context, userIdpId uuid.UUID, orgName string, memberIdpId uuid.UUID, role utils.Role) (*models.MemberResponse, error)
+	RemoveOrganizationMember(ctx context.Context, userIdpId uuid.UUID, orgName string, memberIdpId uuid.UUID) error
+	ListProjectMembers(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string) ([]*models.MemberResponse, error)
+	SetProjectMemberRole(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, memberIdpId uuid.UUID, role utils.Role) (*models.MemberResponse, error)
+	RemoveProjectMember(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, memberIdpId uuid.UUID) error
+}
+
+type accessControlManager struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ProjectRepository      repositories.ProjectRepository
+	MembershipRepository   repositories.MembershipRepository
+	logger                 *slog.Logger
+}
+
+func NewAccessControlManager(
+	orgRepo repositories.OrganizationRepository,
+	projectRepo repositories.ProjectRepository,
+	membershipRepo repositories.MembershipRepository,
+	logger *slog.Logger,
+) AccessControlManager {
+	return &accessControlManager{
+		OrganizationRepository: orgRepo,
+		ProjectRepository:      projectRepo,
+		MembershipRepository:   membershipRepo,
+		logger:                 logger,
+	}
+}
+
+func (s *accessControlManager) Authorize(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, permission utils.Permission) error {
+	org, err := s.OrganizationRepository.GetOrganizationByName(ctx, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return utils.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+
+	role, err := s.resolveRole(ctx, userIdpId, org, projectName)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		hasProjectMembership, err := s.MembershipRepository.HasProjectMembershipInOrganization(ctx, org.ID, userIdpId)
+		if err != nil {
+			return fmt.Errorf("failed to check project memberships of user %s: %w", userIdpId, err)
+		}
+		if !hasProjectMembership {
+			// Do not reveal organizations that the user has no access to
+			return utils.ErrOrganizationNotFound
+		}
+		// Project members can see the organization they belong to, but nothing outside their projects
+		if projectName == "" && permission == utils.PermissionOrgRead {
+			return nil
+		}
+		s.logger.Debug("Permission denied", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName, "permission", permission)
+		return utils.ErrPermissionDenied
+	}
+	if !role.HasPermission(permission) {
+		s.logger.Debug("Permission denied", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName, "role", role, "permission", permission)
+		return utils.ErrPermissionDenied
+	}
+	return nil
+}
+
+// resolveRole returns the most privileged role the user holds on the organization and, when given, the project.
+// The organization owner is always an admin. An empty role means the user holds no role on either.
+func (s *accessControlManager) resolveRole(ctx context.Context, userIdpId uuid.UUID, org *models.Organization, projectName string) (utils.Role, error) {
+	if org.UserIdpId == userIdpId {
+		return utils.RoleAdmin, nil
+	}
+
+	var role utils.Role
+	orgMember, err := s.MembershipRepository.GetOrganizationMember(ctx, org.ID, userIdpId)
+	if err != nil && !db.IsRecordNotFoundError(err) {
+		return "", fmt.Errorf("failed to get organization membership of user %s: %w", userIdpId, err)
+	}
+	if orgMember != nil {
+		role = utils.Role(orgMember.Role)
+	}
+	if projectName == "" {
+		return role, nil
+	}
+
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			// Let the handler report the missing project to users who may see the organization
+			return role, nil
+		}
+		return "", fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	projectMember, err := s.MembershipRepository.GetProjectMember(ctx, project.ID, userIdpId)
+	if err != nil && !db.IsRecordNotFoundError(err) {
+		return "", fmt.Errorf("failed to get project membership of user %s: %w", userIdpId, err)
+	}
+	if projectMember != nil {
+		role = utils.HigherRole(role, utils.Role(projectMember.Role))
+	}
+	return role, nil
+}
+
+func (s *accessControlManager) ListOrganizationMembers(ctx context.Context, userIdpId uuid.UUID, orgName string) ([]*models.MemberResponse, error) {
+	s.logger.Debug("ListOrganizationMembers called", "userIdpId", userIdpId, "orgName", orgName)
+
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.MembershipRepository.ListOrganizationMembers(ctx, org.ID)
+	if err != nil {
+		s.logger.Error("Failed to list organization members", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to list members of organization %s: %w", orgName, err)
+	}
+
+	responses := []*models.MemberResponse{{
+		UserIdpId: org.UserIdpId.String(),
+		Role:      string(utils.RoleAdmin),
+		IsOwner:   true,
+		CreatedAt: org.CreatedAt,
+	}}
+	for _, member := range members {
+		responses = append(responses, &models.MemberResponse{
+			UserIdpId: member.UserIdpId.String(),
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+func (s *accessControlManager) SetOrganizationMemberRole(ctx context.Context, userIdpId uuid.UUID, orgName string, memberIdpId uuid.UUID, role utils.Role) (*models.MemberResponse, error) {
+	s.logger.Debug("SetOrganizationMemberRole called", "userIdpId", userIdpId, "orgName", orgName, "memberIdpId", memberIdpId, "role", role)
+
+	if !utils.IsValidOrgRole(role) {
+		return nil, fmt.Errorf("%w: %s is not a valid organization role", utils.ErrInvalidRole, role)
+	}
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	if org.UserIdpId == memberIdpId {
+		return nil, utils.ErrCannotModifyOrgOwner
+	}
+
+	now := time.Now()
+	if err := s.MembershipRepository.UpsertOrganizationMember(ctx, &models.OrganizationMember{
+		ID:        uuid.New(),
+		OrgID:     org.ID,
+		UserIdpId: memberIdpId,
+		Role:      string(role),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		s.logger.Error("Failed to save organization member", "orgName", orgName, "memberIdpId", memberIdpId, "error", err)
+		return nil, fmt.Errorf("failed to save member of organization %s: %w", orgName, err)
+	}
+	member, err := s.MembershipRepository.GetOrganizationMember(ctx, org.ID, memberIdpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member of organization %s: %w", orgName, err)
+	}
+
+	s.logger.Info("Organization member role set", "orgName", orgName, "memberIdpId", memberIdpId, "role", role)
+	return &models.MemberResponse{
+		UserIdpId: member.UserIdpId.String(),
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
+
+func (s *accessControlManager) RemoveOrganizationMember(ctx context.Context, userIdpId uuid.UUID, orgName string, memberIdpId uuid.UUID) error {
+	s.logger.Debug("RemoveOrganizationMember called", "userIdpId", userIdpId, "orgName", orgName, "memberIdpId", memberIdpId)
+
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return err
+	}
+	if org.UserIdpId == memberIdpId {
+		return utils.ErrCannotModifyOrgOwner
+	}
+	if err := s.MembershipRepository.DeleteOrganizationMember(ctx, org.ID, memberIdpId); err != nil {
+		s.logger.Error("Failed to remove organization member", "orgName", orgName, "memberIdpId", memberIdpId, "error", err)
+		return fmt.Errorf("failed to remove member of organization %s: %w", orgName, err)
+	}
+
+	s.logger.Info("Organization member removed", "orgName", orgName, "memberIdpId", memberIdpId)
+	return nil
+}
+
+func (s *accessControlManager) ListProjectMembers(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string) ([]*models.MemberResponse, error) {
+	s.logger.Debug("ListProjectMembers called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName)
+
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.MembershipRepository.ListProjectMembers(ctx, project.ID)
+	if err != nil {
+		s.logger.Error("Failed to list project members", "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, fmt.Errorf("failed to list members of project %s: %w", projectName, err)
+	}
+
+	responses := make([]*models.MemberResponse, 0, len(members))
+	for _, member := range members {
+		responses = append(responses, &models.MemberResponse{
+			UserIdpId: member.UserIdpId.String(),
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+func (s *accessControlManager) SetProjectMemberRole(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, memberIdpId uuid.UUID, role utils.Role) (*models.MemberResponse, error) {
+	s.logger.Debug("SetProjectMemberRole called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName, "memberIdpId", memberIdpId, "role", role)
+
+	if !utils.IsValidProjectRole(role) {
+		return nil, fmt.Errorf("%w: %s is not a valid project role", utils.ErrInvalidRole, role)
+	}
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.MembershipRepository.UpsertProjectMember(ctx, &models.ProjectMember{
+		ID:        uuid.New(),
+		ProjectID: project.ID,
+		UserIdpId: memberIdpId,
+		Role:      string(role),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}); err != nil {
+		s.logger.Error("Failed to save project member", "orgName", orgName, "projectName", projectName, "memberIdpId", memberIdpId, "error", err)
+		return nil, fmt.Errorf("failed to save member of project %s: %w", projectName, err)
+	}
+	member, err := s.MembershipRepository.GetProjectMember(ctx, project.ID, memberIdpId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member of project %s: %w", projectName, err)
+	}
+
+	s.logger.Info("Project member role set", "orgName", orgName, "projectName", projectName, "memberIdpId", memberIdpId, "role", role)
+	return &models.MemberResponse{
+		UserIdpId: member.UserIdpId.String(),
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
+
+func (s *accessControlManager) RemoveProjectMember(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, memberIdpId uuid.UUID) error {
+	s.logger.Debug("RemoveProjectMember called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName, "memberIdpId", memberIdpId)
+
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return err
+	}
+	if err := s.MembershipRepository.DeleteProjectMember(ctx, project.ID, memberIdpId); err != nil {
+		s.logger.Error("Failed to remove project member", "orgName", orgName, "projectName", projectName, "memberIdpId", memberIdpId, "error", err)
+		return fmt.Errorf("failed to remove member of project %s: %w", projectName, err)
+	}
+
+	s.logger.Info("Project member removed", "orgName", orgName, "projectName", projectName, "memberIdpId", memberIdpId)
+	return nil
+}
+
+func (s *accessControlManager) getOrganization(ctx context.Context, userIdpId uuid.UUID, orgName string) (*models.Organization, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Organization not found", "userIdpId", userIdpId, "orgName", orgName)
+			return nil, utils.ErrOrganizationNotFound
+		}
+		s.logger.Error("Failed to get organization from repository", "userIdpId", userIdpId, "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	return org, nil
+}
+
+func (s *accessControlManager) getProject(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string) (*models.Project, error) {
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Project not found", "orgName", orgName, "projectName", projectName)
+			return nil, utils.ErrProjectNotFound
+		}
+		s.logger.Error("Failed to get project from repository", "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	return project, nil
+}
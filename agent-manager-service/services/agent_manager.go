@@ -32,6 +32,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
@@ -39,27 +40,36 @@ import (
 type AgentManagerService interface {
 	ListAgents(ctx context.Context, userIdpId uuid.UUID, orgName string, projName string, limit int32, offset int32) ([]*models.AgentResponse, int32, error)
 	CreateAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, req *spec.CreateAgentRequest) error
+	UpdateAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, req *spec.UpdateAgentRequest) (*models.AgentResponse, error)
 	BuildAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, commitId string) (*models.BuildResponse, error)
 	DeleteAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) error
 	DeployAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, req *spec.DeployAgentRequest) (string, error)
+	PromoteAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, sourceEnv string, req *spec.PromoteAgentRequest) (*models.PromotionResponse, error)
+	GetDeploymentHistory(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) ([]*models.DeploymentRevisionResponse, error)
+	RollbackAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string, req *spec.RollbackAgentRequest) (*models.DeploymentRevisionResponse, error)
 	GetAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) (*models.AgentResponse, error)
 	ListAgentBuilds(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, limit int32, offset int32) ([]*models.BuildResponse, int32, error)
 	GetBuild(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string) (*models.BuildDetailsResponse, error)
 	GetAgentDeployments(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) ([]*models.DeploymentResponse, error)
 	GetAgentEndpoints(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environmentName string) (map[string]models.EndpointsResponse, error)
 	GetAgentConfigurations(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) ([]models.EnvVars, error)
-	GetBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string) (*models.BuildLogsResponse, error)
+	GetBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string, limit int, cursor string) (*models.BuildLogsResponse, error)
+	// StreamBuildLogs emits the build's logs after the cursor as they arrive, until the build finishes or the context is cancelled
+	StreamBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string, cursor string, emit func(event models.LogStreamEvent) error) error
 	GenerateName(ctx context.Context, userIdpId uuid.UUID, orgName string, payload spec.ResourceNameRequest) (string, error)
 }
 
 type agentManagerService struct {
-	OrganizationRepository  repositories.OrganizationRepository
-	ProjectRepository       repositories.ProjectRepository
-	AgentRepository         repositories.AgentRepository
-	InternalAgentRepository repositories.InternalAgentRepository
-	OpenChoreoSvcClient     clients.OpenChoreoSvcClient
-	ObservabilitySvcClient  observabilitysvc.ObservabilitySvcClient
-	logger                  *slog.Logger
+	OrganizationRepository       repositories.OrganizationRepository
+	ProjectRepository            repositories.ProjectRepository
+	AgentRepository              repositories.AgentRepository
+	InternalAgentRepository      repositories.InternalAgentRepository
+	DeploymentRevisionRepository repositories.DeploymentRevisionRepository
+	AgentSecretRepository        repositories.AgentSecretRepository
+	OpenChoreoSvcClient          clients.OpenChoreoSvcClient
+	ObservabilitySvcClient       observabilitysvc.ObservabilitySvcClient
+	Encryptor                    secrets.Encryptor
+	logger                       *slog.Logger
 }
 
 func NewAgentManagerService(
@@ -67,18 +77,24 @@ func NewAgentManagerService(
 	projRepo repositories.ProjectRepository,
 	agentRepo repositories.AgentRepository,
 	internalAgentRepo repositories.InternalAgentRepository,
+	deploymentRevisionRepo repositories.DeploymentRevisionRepository,
+	agentSecretRepo repositories.AgentSecretRepository,
 	openChoreoSvcClient clients.OpenChoreoSvcClient,
 	observabilitySvcClient observabilitysvc.ObservabilitySvcClient,
+	encryptor secrets.Encryptor,
 	logger *slog.Logger,
 ) AgentManagerService {
 	return &agentManagerService{
-		OrganizationRepository:  orgRepo,
-		ProjectRepository:       projRepo,
-		AgentRepository:         agentRepo,
-		InternalAgentRepository: internalAgentRepo,
-		OpenChoreoSvcClient:     openChoreoSvcClient,
-		ObservabilitySvcClient:  observabilitySvcClient,
-		logger:                  logger,
+		OrganizationRepository:       orgRepo,
+		ProjectRepository:            projRepo,
+		AgentRepository:              agentRepo,
+		InternalAgentRepository:      internalAgentRepo,
+		DeploymentRevisionRepository: deploymentRevisionRepo,
+		AgentSecretRepository:        agentSecretRepo,
+		OpenChoreoSvcClient:          openChoreoSvcClient,
+		ObservabilitySvcClient:       observabilitySvcClient,
+		Encryptor:                    encryptor,
+		logger:                       logger,
 	}
 }
 
@@ -193,12 +209,12 @@ func (s *agentManagerService) CreateAgent(ctx context.Context, userIdpId uuid.UU
 		return err
 	}
 	// Save agent record in database first
-	err = s.saveAgentRecord(ctx, org.ID, project.ID, req)
+	agentId, err := s.saveAgentRecord(ctx, org.ID, project.ID, req)
 	if err != nil {
 		s.logger.Error("Failed to save agent record", "agentName", req.Name, "error", err)
 		return err
 	}
-	err = s.createOpenChoreoAgentComponent(ctx, orgName, projectName, req)
+	err = s.createOpenChoreoAgentComponent(ctx, orgName, projectName, agentId, req)
 	if err != nil {
 		s.logger.Error("OpenChoreo creation failed, initiating rollback", "agentName", req.Name, "error", err)
 		// OpenChoreo creation failed, rollback database record
@@ -213,6 +229,164 @@ func (s *agentManagerService) CreateAgent(ctx context.Context, userIdpId uuid.UU
 	return nil
 }
 
+// UpdateAgent updates agent metadata and runtime configuration.
+// The database changes and the OpenChoreo component update are applied in a single transaction, so a failed
+// Kubernetes update leaves the agent unchanged. The component is restored if the transaction fails to commit.
+func (s *agentManagerService) UpdateAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, req *spec.UpdateAgentRequest) (*models.AgentResponse, error) {
+	s.logger.Info("Updating agent", "agentName", agentName, "orgName", orgName, "projectName", projectName, "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from repository", "agentName", agentName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	ocAgentComponent, err := s.OpenChoreoSvcClient.GetAgentComponent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, err
+	}
+	if err := validateAgentUpdate(agent, ocAgentComponent, req); err != nil {
+		s.logger.Warn("Invalid agent update", "agentName", agentName, "error", err)
+		return nil, err
+	}
+
+	// Keep the current secrets so that the agent's Kubernetes Secret can be restored if the update fails after syncing it
+	var previousSecrets map[string]string
+	if agent.ProvisioningType == string(utils.InternalAgent) && req.RuntimeConfigs != nil && req.RuntimeConfigs.Env != nil {
+		previousSecrets, err = s.agentSecretData(ctx, agent.ID)
+		if err != nil {
+			s.logger.Error("Failed to read agent secrets", "agentName", agentName, "agentId", agent.ID, "error", err)
+			return nil, err
+		}
+	}
+
+	displayName := utils.StrPointerAsStr(req.DisplayName, agent.DisplayName)
+	description := utils.StrPointerAsStr(req.Description, agent.Description)
+	componentUpdated := false
+	syncSecrets := false
+	secretsSynced := false
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := db.CtxWithTx(ctx, tx)
+
+		if err := s.AgentRepository.UpdateAgentDetails(txCtx, agent.ID, displayName, description); err != nil {
+			s.logger.Error("Failed to update agent record", "agentName", agentName, "agentId", agent.ID, "error", err)
+			return fmt.Errorf("failed to update agent record: %w", err)
+		}
+		if agent.ProvisioningType == string(utils.InternalAgent) && (req.RuntimeConfigs != nil || req.InputInterface != nil) {
+			var workloadSpec map[string]interface{}
+			if agent.AgentDetails != nil {
+				workloadSpec = agent.AgentDetails.WorkloadSpec
+			}
+			var envVars []spec.EnvironmentVariable
+			if req.RuntimeConfigs != nil && req.RuntimeConfigs.Env != nil {
+				var secretsRemoved bool
+				envVars, secretsRemoved, err = s.storeSecretEnvVars(txCtx, agent.ID, req.RuntimeConfigs.Env)
+				if err != nil {
+					s.logger.Error("Failed to store secret environment variables", "agentName", agentName, "agentId", agent.ID, "error", err)
+					return fmt.Errorf("failed to store secret environment variables: %w", err)
+				}
+				syncSecrets = secretsRemoved || hasSecretEnvVars(envVars)
+			}
+			workloadSpec = applyWorkloadSpecUpdate(workloadSpec, agentName, envVars, req)
+			if err := s.InternalAgentRepository.UpdateWorkloadSpec(txCtx, agent.ID, workloadSpec); err != nil {
+				s.logger.Error("Failed to update internal agent workload spec", "agentName", agentName, "agentId", agent.ID, "error", err)
+				return fmt.Errorf("failed to update internal agent record: %w", err)
+			}
+		}
+
+		// Update the OpenChoreo component last so that a failure rolls back the database changes
+		if err := s.OpenChoreoSvcClient.UpdateAgentComponent(ctx, orgName, projectName, agentName, req); err != nil {
+			s.logger.Error("Failed to update agent component in OpenChoreo, rolling back database changes", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			return fmt.Errorf("failed to update agent component: agentName %s, error: %w", agentName, err)
+		}
+		componentUpdated = true
+
+		// Sync the secrets once the component is updated, so that only a failing commit leaves them to be restored
+		if syncSecrets {
+			if err := s.syncAgentSecrets(txCtx, agent.ID, orgName, projectName, agentName); err != nil {
+				s.logger.Error("Failed to sync agent secrets, rolling back changes", "agentName", agentName, "error", err)
+				return fmt.Errorf("failed to sync agent secrets: %w", err)
+			}
+			secretsSynced = true
+		}
+		return nil
+	})
+	if err != nil {
+		if secretsSynced {
+			// The transaction failed to commit after the secrets were synced, so restore the secrets of the database
+			if restoreErr := s.OpenChoreoSvcClient.UpsertAgentSecrets(ctx, orgName, projectName, agentName, previousSecrets); restoreErr != nil {
+				s.logger.Error("Critical: Agent secrets updated in OpenChoreo but not in database, manual reconciliation required",
+					"agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err, "restoreError", restoreErr)
+			} else {
+				s.logger.Warn("Restored agent secrets after failing to update the database", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			}
+		}
+		if componentUpdated {
+			// The transaction failed to commit after the component was updated, so revert the component to match the database
+			if restoreErr := s.OpenChoreoSvcClient.RestoreAgentComponent(ctx, orgName, projectName, agentName, ocAgentComponent); restoreErr != nil {
+				s.logger.Error("Critical: Agent updated in OpenChoreo but not in database, manual reconciliation required",
+					"agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err, "restoreError", restoreErr)
+			} else {
+				s.logger.Warn("Restored agent component after failing to update the database", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			}
+		}
+		return nil, err
+	}
+
+	s.logger.Info("Agent updated successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName)
+	return s.GetAgent(ctx, userIdpId, orgName, projectName, agentName)
+}
+
+// validateAgentUpdate checks the update against the existing agent's provisioning type, subtype and language
+func validateAgentUpdate(agent *models.Agent, ocAgentComponent *clients.AgentComponent, req *spec.UpdateAgentRequest) error {
+	if agent.ProvisioningType != string(utils.InternalAgent) && (req.RuntimeConfigs != nil || req.InputInterface != nil) {
+		return fmt.Errorf("%w: runtimeConfigs and inputInterface can only be updated for internal agents", utils.ErrInvalidAgentUpdate)
+	}
+	if req.InputInterface != nil && ocAgentComponent.Type.SubType != string(utils.AgentSubTypeCustomAPI) {
+		return fmt.Errorf("%w: inputInterface can only be updated for %s agents", utils.ErrInvalidAgentUpdate, utils.AgentSubTypeCustomAPI)
+	}
+	if req.RuntimeConfigs != nil && req.RuntimeConfigs.LanguageVersion != nil {
+		if err := utils.ValidateLanguageVersion(ocAgentComponent.Language, *req.RuntimeConfigs.LanguageVersion); err != nil {
+			return fmt.Errorf("%w: %w", utils.ErrInvalidAgentUpdate, err)
+		}
+	}
+	return nil
+}
+
+// applyWorkloadSpecUpdate returns a copy of the workload spec with the updated env vars and endpoints.
+// envVars must already have secret values removed.
+func applyWorkloadSpecUpdate(workloadSpec map[string]interface{}, agentName string, envVars []spec.EnvironmentVariable, req *spec.UpdateAgentRequest) map[string]interface{} {
+	updated := make(map[string]interface{}, len(workloadSpec))
+	for k, v := range workloadSpec {
+		updated[k] = v
+	}
+	if envVars != nil {
+		updated["envVars"] = envVars
+	}
+	if req.InputInterface != nil {
+		updated["endpoints"] = buildCustomAPIEndpoints(agentName, req.InputInterface)
+	}
+	return updated
+}
+
 func (s *agentManagerService) GenerateName(ctx context.Context, userIdpId uuid.UUID, orgName string, payload spec.ResourceNameRequest) (string, error) {
 	s.logger.Info("Generating resource name", "resourceType", payload.ResourceType, "displayName", payload.DisplayName, "orgName", orgName, "userIdpId", userIdpId)
 	// Validate organization exists
@@ -339,11 +513,11 @@ func (s *agentManagerService) generateUniqueAgentName(ctx context.Context, orgId
 	return uniqueName, nil
 }
 
-func (s *agentManagerService) saveAgentRecord(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, req *spec.CreateAgentRequest) error {
+func (s *agentManagerService) saveAgentRecord(ctx context.Context, orgId uuid.UUID, projectId uuid.UUID, req *spec.CreateAgentRequest) (uuid.UUID, error) {
 	agentId := uuid.New()
 
 	// Execute database operations in a transaction
-	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
 		txCtx := db.CtxWithTx(ctx, tx)
 
 		// Create agent record in the database
@@ -365,8 +539,14 @@ func (s *agentManagerService) saveAgentRecord(ctx context.Context, orgId uuid.UU
 
 		// If agent type is internal, also create internal agent record
 		if req.Provisioning.Type == string(utils.InternalAgent) {
+			// Store secret values encrypted so that the workload spec only references them
+			envVars, _, err := s.storeSecretEnvVars(txCtx, agentId, req.RuntimeConfigs.Env)
+			if err != nil {
+				s.logger.Error("Failed to store secret environment variables", "agentName", req.Name, "agentId", agentId, "error", err)
+				return fmt.Errorf("failed to store secret environment variables: %w", err)
+			}
 			// Build workload spec from request
-			workloadSpec, err := buildWorkloadSpec(req)
+			workloadSpec, err := buildWorkloadSpec(req, envVars)
 			if err != nil {
 				s.logger.Error("Failed to build workload spec", "agentName", req.Name, "error", err)
 				return fmt.Errorf("failed to build workload spec: %w", err)
@@ -385,10 +565,14 @@ func (s *agentManagerService) saveAgentRecord(ctx context.Context, orgId uuid.UU
 
 		return nil
 	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return agentId, nil
 }
 
 // createOpenChoreoAgentComponent handles the creation of a managed agent
-func (s *agentManagerService) createOpenChoreoAgentComponent(ctx context.Context, orgName, projectName string, req *spec.CreateAgentRequest) error {
+func (s *agentManagerService) createOpenChoreoAgentComponent(ctx context.Context, orgName, projectName string, agentId uuid.UUID, req *spec.CreateAgentRequest) error {
 	// Create agent component in Open Choreo
 	s.logger.Debug("Creating agent component in OpenChoreo", "agentName", req.Name, "orgName", orgName, "projectName", projectName)
 	if err := s.OpenChoreoSvcClient.CreateAgentComponent(ctx, orgName, projectName, req); err != nil {
@@ -399,6 +583,16 @@ func (s *agentManagerService) createOpenChoreoAgentComponent(ctx context.Context
 		s.logger.Info("External agent component created successfully in OpenChoreo", "agentName", req.Name, "orgName", orgName, "projectName", projectName)
 		return nil
 	}
+	// Secrets are referenced by the workload, so the Kubernetes Secret must exist before the agent is deployed
+	if hasSecretEnvVars(req.RuntimeConfigs.Env) {
+		if err := s.syncAgentSecrets(ctx, agentId, orgName, projectName, req.Name); err != nil {
+			s.logger.Info("Cleaning up component after secret sync failure", "agentName", req.Name)
+			if deleteErr := s.OpenChoreoSvcClient.DeleteAgentComponent(ctx, orgName, projectName, req.Name); deleteErr != nil {
+				s.logger.Error("Failed to clean up component after secret sync failure", "agentName", req.Name, "deleteError", deleteErr)
+			}
+			return fmt.Errorf("failed to sync agent secrets: agentName %s, error: %w", req.Name, err)
+		}
+	}
 	// For internal agents, trigger build after creation
 	s.logger.Debug("Agent component created, triggering build", "agentName", req.Name, "orgName", orgName, "projectName", projectName)
 	// Trigger build in Open Choreo with the latest commit
@@ -577,10 +771,22 @@ func (s *agentManagerService) DeployAgent(ctx context.Context, userIdpId uuid.UU
 		return "", fmt.Errorf("deploy operation is not supported for agent type: '%s'", agent.ProvisioningType)
 	}
 
+	envVars, secretsRemoved, err := s.storeSecretEnvVars(ctx, agent.ID, req.Env)
+	if err != nil {
+		s.logger.Error("Failed to store secret environment variables", "agentName", agentName, "agentId", agent.ID, "error", err)
+		return "", fmt.Errorf("failed to store secret environment variables: %w", err)
+	}
+	if secretsRemoved || hasSecretEnvVars(envVars) {
+		if err := s.syncAgentSecrets(ctx, agent.ID, orgName, projectName, agentName); err != nil {
+			s.logger.Error("Failed to sync agent secrets", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			return "", fmt.Errorf("failed to sync agent secrets: %w", err)
+		}
+	}
+
 	// Create a new request with the combined environment variables
 	deployReq := &spec.DeployAgentRequest{
 		ImageId: req.ImageId,
-		Env:     req.Env,
+		Env:     envVars,
 	}
 
 	// Deploy agent component in Open Choreo
@@ -610,10 +816,452 @@ func (s *agentManagerService) DeployAgent(ctx context.Context, userIdpId uuid.UU
 		s.logger.Error("Failed to update agent timestamp after successful deployment", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
 	}
 	lowestEnv := findLowestEnvironment(pipeline.PromotionPaths)
+	deploymentRevision := &models.DeploymentRevision{
+		AgentID:     agent.ID,
+		Environment: lowestEnv,
+		ImageId:     req.ImageId,
+		Env:         toEnvVars(envVars),
+		Action:      string(utils.DeploymentActionDeploy),
+		DeployedBy:  userIdpId.String(),
+	}
+	if err := s.recordDeploymentRevision(ctx, deploymentRevision); err != nil {
+		s.logger.Error("Failed to record deployment revision after successful deployment", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", lowestEnv, "error", err)
+	}
 	s.logger.Info("Agent deployed successfully to "+lowestEnv, "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", lowestEnv)
 	return lowestEnv, nil
 }
 
+func (s *agentManagerService) PromoteAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, sourceEnv string, req *spec.PromoteAgentRequest) (*models.PromotionResponse, error) {
+	s.logger.Info("Promoting agent", "agentName", agentName, "orgName", orgName, "projectName", projectName, "sourceEnvironment", sourceEnv, "targetEnvironment", req.GetTargetEnvironment(), "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from repository", "agentName", agentName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if agent.ProvisioningType != string(utils.InternalAgent) {
+		return nil, fmt.Errorf("promote operation is not supported for agent type: '%s'", agent.ProvisioningType)
+	}
+
+	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
+	if err != nil {
+		s.logger.Error("Failed to fetch OpenChoreo project", "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, fmt.Errorf("failed to fetch openchoreo project: %w", err)
+	}
+	pipelineName := openChoreoProject.DeploymentPipeline
+	if pipelineName == "" {
+		s.logger.Error("Project has no deployment pipeline configured", "orgName", orgName, "projectName", projectName)
+		return nil, fmt.Errorf("project has no deployment pipeline configured")
+	}
+	pipeline, err := s.OpenChoreoSvcClient.GetDeploymentPipeline(ctx, orgName, pipelineName)
+	if err != nil {
+		s.logger.Error("Failed to fetch deployment pipeline", "orgName", orgName, "pipelineName", pipelineName, "error", err)
+		return nil, fmt.Errorf("failed to fetch deployment pipeline: %w", err)
+	}
+
+	targetEnv, err := resolvePromotionTarget(sourceEnv, req.GetTargetEnvironment(), pipeline.PromotionPaths)
+	if err != nil {
+		s.logger.Warn("Promotion path not allowed by deployment pipeline", "pipelineName", pipelineName, "sourceEnvironment", sourceEnv, "targetEnvironment", req.GetTargetEnvironment())
+		return nil, err
+	}
+	if _, err := s.OpenChoreoSvcClient.GetEnvironment(ctx, orgName, targetEnv); err != nil {
+		s.logger.Error("Failed to validate target environment", "environment", targetEnv, "orgName", orgName, "error", err)
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			return nil, utils.ErrEnvironmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get environment %s: %w", targetEnv, err)
+	}
+
+	promotion, err := s.OpenChoreoSvcClient.PromoteAgentComponent(ctx, orgName, projectName, agentName, sourceEnv, targetEnv, userIdpId.String())
+	if err != nil {
+		s.logger.Error("Failed to promote agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "sourceEnvironment", sourceEnv, "targetEnvironment", targetEnv, "error", err)
+		if errors.Is(err, utils.ErrDeploymentNotFound) {
+			return nil, utils.ErrDeploymentNotFound
+		}
+		return nil, fmt.Errorf("failed to promote agent component: agentName %s, error: %w", agentName, err)
+	}
+	s.recordPromotedRevision(ctx, agent.ID, sourceEnv, targetEnv, promotion.ReleaseName, userIdpId.String())
+	err = s.AgentRepository.UpdateAgentTimestamp(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to update agent timestamp after successful promotion", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+	}
+	s.logger.Info("Agent promoted successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "sourceEnvironment", sourceEnv, "targetEnvironment", targetEnv, "releaseName", promotion.ReleaseName)
+	return promotion, nil
+}
+
+func (s *agentManagerService) GetDeploymentHistory(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string) ([]*models.DeploymentRevisionResponse, error) {
+	s.logger.Info("Getting deployment history", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from repository", "agentName", agentName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+
+	revisions, err := s.DeploymentRevisionRepository.ListDeploymentRevisions(ctx, agent.ID, environment)
+	if err != nil {
+		s.logger.Error("Failed to list deployment revisions", "agentName", agentName, "environment", environment, "error", err)
+		return nil, fmt.Errorf("failed to list deployment revisions: %w", err)
+	}
+	revisionResponses := make([]*models.DeploymentRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, toDeploymentRevisionResponse(revision))
+	}
+	s.logger.Info("Fetched deployment history successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "revisionCount", len(revisionResponses))
+	return revisionResponses, nil
+}
+
+// RollbackAgent restores a previous revision of an environment. Workload changes are only auto-deployed
+// to the first environment of the pipeline, so that environment is rolled back by redeploying the image
+// and environment variables of the revision; higher environments are rolled back by binding the release
+// that was promoted to them in the revision.
+func (s *agentManagerService) RollbackAgent(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, environment string, req *spec.RollbackAgentRequest) (*models.DeploymentRevisionResponse, error) {
+	s.logger.Info("Rolling back agent", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "revision", req.Revision, "userIdpId", userIdpId)
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		s.logger.Error("Failed to find organization", "orgName", orgName, "userIdpId", userIdpId, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		s.logger.Error("Failed to find project", "projectName", projectName, "orgId", org.ID, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	agent, err := s.AgentRepository.GetAgentByName(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent from repository", "agentName", agentName, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrAgentNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch agent: %w", err)
+	}
+	if agent.ProvisioningType != string(utils.InternalAgent) {
+		return nil, fmt.Errorf("rollback operation is not supported for agent type: '%s'", agent.ProvisioningType)
+	}
+
+	targetRevision, err := s.DeploymentRevisionRepository.GetDeploymentRevision(ctx, agent.ID, environment, int(req.Revision))
+	if err != nil {
+		s.logger.Error("Failed to fetch deployment revision", "agentName", agentName, "environment", environment, "revision", req.Revision, "error", err)
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrDeploymentRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch deployment revision: %w", err)
+	}
+
+	openChoreoProject, err := s.OpenChoreoSvcClient.GetProject(ctx, projectName, orgName)
+	if err != nil {
+		s.logger.Error("Failed to fetch OpenChoreo project", "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, fmt.Errorf("failed to fetch openchoreo project: %w", err)
+	}
+	pipelineName := openChoreoProject.DeploymentPipeline
+	if pipelineName == "" {
+		s.logger.Error("Project has no deployment pipeline configured", "orgName", orgName, "projectName", projectName)
+		return nil, fmt.Errorf("project has no deployment pipeline configured")
+	}
+	pipeline, err := s.OpenChoreoSvcClient.GetDeploymentPipeline(ctx, orgName, pipelineName)
+	if err != nil {
+		s.logger.Error("Failed to fetch deployment pipeline", "orgName", orgName, "pipelineName", pipelineName, "error", err)
+		return nil, fmt.Errorf("failed to fetch deployment pipeline: %w", err)
+	}
+	if lowestEnv := findLowestEnvironment(pipeline.PromotionPaths); environment == lowestEnv {
+		deployReq := &spec.DeployAgentRequest{
+			ImageId: targetRevision.ImageId,
+			Env:     toSpecEnvironmentVariables(targetRevision.Env),
+		}
+		s.logger.Debug("Redeploying agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "imageId", targetRevision.ImageId)
+		if err := s.OpenChoreoSvcClient.DeployAgentComponent(ctx, orgName, projectName, agentName, deployReq); err != nil {
+			s.logger.Error("Failed to redeploy agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			return nil, fmt.Errorf("failed to redeploy agent component: agentName %s, error: %w", agentName, err)
+		}
+	} else {
+		// Promoted environments are not built from images, so the release that was promoted is bound again
+		if targetRevision.ReleaseName == "" {
+			s.logger.Warn("Rollback requested to a revision without a recorded release", "environment", environment, "revision", req.Revision)
+			return nil, utils.ErrRollbackNotSupported
+		}
+		s.logger.Debug("Binding agent release in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "releaseName", targetRevision.ReleaseName)
+		if err := s.OpenChoreoSvcClient.BindAgentRelease(ctx, orgName, projectName, agentName, environment, targetRevision.ReleaseName); err != nil {
+			s.logger.Error("Failed to bind agent release in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "error", err)
+			if errors.Is(err, utils.ErrDeploymentNotFound) {
+				return nil, utils.ErrDeploymentNotFound
+			}
+			return nil, fmt.Errorf("failed to bind agent release: agentName %s, error: %w", agentName, err)
+		}
+	}
+
+	sourceRevision := targetRevision.Revision
+	rollbackRevision := &models.DeploymentRevision{
+		AgentID:        agent.ID,
+		Environment:    environment,
+		ImageId:        targetRevision.ImageId,
+		ReleaseName:    targetRevision.ReleaseName,
+		Env:            targetRevision.Env,
+		Action:         string(utils.DeploymentActionRollback),
+		SourceRevision: &sourceRevision,
+		DeployedBy:     userIdpId.String(),
+	}
+	// The rollback has already been applied, so a failure to record it is logged rather than returned
+	if err := s.recordDeploymentRevision(ctx, rollbackRevision); err != nil {
+		s.logger.Error("Failed to record deployment revision after successful rollback", "agentName", agentName, "environment", environment, "error", err)
+	}
+	err = s.AgentRepository.UpdateAgentTimestamp(ctx, org.ID, project.ID, agentName)
+	if err != nil {
+		s.logger.Error("Failed to update agent timestamp after successful rollback", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+	}
+	s.logger.Info("Agent rolled back successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment, "sourceRevision", sourceRevision, "revision", rollbackRevision.Revision)
+	return toDeploymentRevisionResponse(rollbackRevision), nil
+}
+
+// recordDeploymentRevision appends a revision to the agent's deployment history for the environment.
+func (s *agentManagerService) recordDeploymentRevision(ctx context.Context, revision *models.DeploymentRevision) error {
+	revision.ID = uuid.New()
+	revision.CreatedAt = time.Now()
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := db.CtxWithTx(ctx, tx)
+		return s.DeploymentRevisionRepository.CreateDeploymentRevision(txCtx, revision)
+	})
+}
+
+// recordPromotedRevision copies the latest revision of the source environment into the target environment's history,
+// along with the promoted release so that the target environment can be rolled back to it. When the source
+// environment has no history, such as for agents deployed before revisions were recorded, only the release is recorded.
+// Failures are logged rather than returned because the promotion itself has already been applied.
+func (s *agentManagerService) recordPromotedRevision(ctx context.Context, agentId uuid.UUID, sourceEnv string, targetEnv string, releaseName string, promotedBy string) {
+	promotedRevision := &models.DeploymentRevision{
+		AgentID:     agentId,
+		Environment: targetEnv,
+		ReleaseName: releaseName,
+		Action:      string(utils.DeploymentActionPromote),
+		DeployedBy:  promotedBy,
+	}
+	sourceRevision, err := s.DeploymentRevisionRepository.GetLatestDeploymentRevision(ctx, agentId, sourceEnv)
+	switch {
+	case err == nil:
+		promotedRevision.ImageId = sourceRevision.ImageId
+		promotedRevision.Env = sourceRevision.Env
+		promotedRevision.SourceRevision = &sourceRevision.Revision
+	case db.IsRecordNotFoundError(err):
+		s.logger.Debug("No deployment history for source environment, recording promoted release only", "agentId", agentId, "sourceEnvironment", sourceEnv)
+	default:
+		s.logger.Error("Failed to fetch latest deployment revision, recording promoted release only", "agentId", agentId, "environment", sourceEnv, "error", err)
+	}
+	if err := s.recordDeploymentRevision(ctx, promotedRevision); err != nil {
+		s.logger.Error("Failed to record deployment revision after successful promotion", "agentId", agentId, "environment", targetEnv, "error", err)
+	}
+}
+
+func toDeploymentRevisionResponse(revision *models.DeploymentRevision) *models.DeploymentRevisionResponse {
+	return &models.DeploymentRevisionResponse{
+		Revision:       revision.Revision,
+		Environment:    revision.Environment,
+		ImageId:        revision.ImageId,
+		Env:            revision.Env,
+		Action:         revision.Action,
+		SourceRevision: revision.SourceRevision,
+		DeployedBy:     revision.DeployedBy,
+		DeployedAt:     revision.CreatedAt,
+	}
+}
+
+func toEnvVars(env []spec.EnvironmentVariable) []models.EnvVars {
+	envVars := make([]models.EnvVars, 0, len(env))
+	for _, e := range env {
+		envVars = append(envVars, models.EnvVars{Key: e.Key, Value: e.Value, IsSecret: e.GetIsSecret()})
+	}
+	return envVars
+}
+
+func toSpecEnvironmentVariables(envVars []models.EnvVars) []spec.EnvironmentVariable {
+	env := make([]spec.EnvironmentVariable, 0, len(envVars))
+	for _, e := range envVars {
+		envVar := spec.EnvironmentVariable{Key: e.Key, Value: e.Value}
+		if e.IsSecret {
+			envVar.IsSecret = spec.PtrBool(true)
+		}
+		env = append(env, envVar)
+	}
+	return env
+}
+
+func hasSecretEnvVars(env []spec.EnvironmentVariable) bool {
+	for _, e := range env {
+		if e.GetIsSecret() {
+			return true
+		}
+	}
+	return false
+}
+
+// storeSecretEnvVars encrypts the values of secret environment variables and stores them against the agent,
+// and deletes the stored secrets that are no longer secret environment variables of the agent.
+// A secret sent without a value, or with the masked value, keeps its stored value. The returned environment
+// variables carry no secret values and are safe to persist and to pass to OpenChoreo. It also reports whether
+// stored secrets were deleted, in which case the agent's Kubernetes Secret must be synced even without secrets.
+func (s *agentManagerService) storeSecretEnvVars(ctx context.Context, agentId uuid.UUID, env []spec.EnvironmentVariable) ([]spec.EnvironmentVariable, bool, error) {
+	storedSecrets, err := s.AgentSecretRepository.ListAgentSecrets(ctx, agentId)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list agent secrets: %w", err)
+	}
+	storedKeys := make(map[string]bool, len(storedSecrets))
+	for _, secret := range storedSecrets {
+		storedKeys[secret.Key] = true
+	}
+	secretKeys := []string{}
+	keep := make(map[string]bool)
+	for _, envVar := range env {
+		if envVar.GetIsSecret() {
+			secretKeys = append(secretKeys, envVar.Key)
+			keep[envVar.Key] = true
+		}
+	}
+	removed := false
+	for key := range storedKeys {
+		if !keep[key] {
+			removed = true
+			break
+		}
+	}
+	if !removed && len(secretKeys) == 0 {
+		return env, false, nil
+	}
+
+	result := make([]spec.EnvironmentVariable, 0, len(env))
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := db.CtxWithTx(ctx, tx)
+		if removed {
+			if err := s.AgentSecretRepository.DeleteAgentSecrets(txCtx, agentId, secretKeys); err != nil {
+				return err
+			}
+		}
+		for _, envVar := range env {
+			if !envVar.GetIsSecret() {
+				result = append(result, envVar)
+				continue
+			}
+			if envVar.Value == "" || envVar.Value == utils.MaskedSecretValue {
+				if !storedKeys[envVar.Key] {
+					return fmt.Errorf("%w: %s", utils.ErrSecretValueRequired, envVar.Key)
+				}
+			} else {
+				encrypted, err := s.Encryptor.Encrypt(envVar.Value)
+				if err != nil {
+					return fmt.Errorf("failed to encrypt secret %s: %w", envVar.Key, err)
+				}
+				now := time.Now()
+				if err := s.AgentSecretRepository.UpsertAgentSecret(txCtx, &models.AgentSecret{
+					ID:               uuid.New(),
+					AgentID:          agentId,
+					Key:              envVar.Key,
+					KeyID:            encrypted.KeyID,
+					EncryptedDataKey: encrypted.EncryptedDataKey,
+					Ciphertext:       encrypted.Ciphertext,
+					CreatedAt:        now,
+					UpdatedAt:        now,
+				}); err != nil {
+					return err
+				}
+			}
+			result = append(result, spec.EnvironmentVariable{Key: envVar.Key, IsSecret: spec.PtrBool(true)})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return result, removed, nil
+}
+
+// syncAgentSecrets replaces the data of the agent's Kubernetes Secret with all stored secrets of the agent.
+func (s *agentManagerService) syncAgentSecrets(ctx context.Context, agentId uuid.UUID, orgName string, projectName string, agentName string) error {
+	data, err := s.agentSecretData(ctx, agentId)
+	if err != nil {
+		return err
+	}
+	return s.OpenChoreoSvcClient.UpsertAgentSecrets(ctx, orgName, projectName, agentName, data)
+}
+
+// agentSecretData returns the decrypted values of the stored secrets of the agent, keyed by environment variable name
+func (s *agentManagerService) agentSecretData(ctx context.Context, agentId uuid.UUID) (map[string]string, error) {
+	storedSecrets, err := s.AgentSecretRepository.ListAgentSecrets(ctx, agentId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agent secrets: %w", err)
+	}
+	data := make(map[string]string, len(storedSecrets))
+	for _, secret := range storedSecrets {
+		value, err := s.Encryptor.Decrypt(&secrets.EncryptedValue{
+			KeyID:            secret.KeyID,
+			EncryptedDataKey: secret.EncryptedDataKey,
+			Ciphertext:       secret.Ciphertext,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt secret %s: %w", secret.Key, err)
+		}
+		data[secret.Key] = value
+	}
+	return data, nil
+}
+
+// resolvePromotionTarget validates the requested hop against the pipeline promotion paths.
+// When no target is requested, the first target of the source environment's promotion path is used.
+func resolvePromotionTarget(sourceEnv string, targetEnv string, promotionPaths []models.PromotionPath) (string, error) {
+	for _, path := range promotionPaths {
+		if path.SourceEnvironmentRef != sourceEnv {
+			continue
+		}
+		if targetEnv == "" && len(path.TargetEnvironmentRefs) > 0 {
+			return path.TargetEnvironmentRefs[0].Name, nil
+		}
+		for _, target := range path.TargetEnvironmentRefs {
+			if target.Name == targetEnv {
+				return targetEnv, nil
+			}
+		}
+	}
+	return "", utils.ErrInvalidPromotionPath
+}
+
 func findLowestEnvironment(promotionPaths []models.PromotionPath) string {
 	if len(promotionPaths) == 0 {
 		return ""
@@ -636,8 +1284,84 @@ func findLowestEnvironment(promotionPaths []models.PromotionPath) string {
 	return ""
 }
 
-func (s *agentManagerService) GetBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string) (*models.BuildLogsResponse, error) {
+func (s *agentManagerService) GetBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string, limit int, cursor string) (*models.BuildLogsResponse, error) {
 	s.logger.Info("Getting build logs", "agentName", agentName, "buildName", buildName, "orgName", orgName, "projectName", projectName, "userIdpId", userIdpId)
+	logCursor, err := decodeLogCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	build, err := s.getAgentBuild(ctx, userIdpId, orgName, projectName, agentName, buildName)
+	if err != nil {
+		return nil, err
+	}
+
+	buildLogs, nextCursor, hasMore, err := s.fetchBuildLogs(ctx, build, logCursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	if hasMore {
+		buildLogs.NextCursor = nextCursor.encode()
+	}
+	s.logger.Info("Fetched build logs successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "buildName", buildName, "logCount", len(buildLogs.Logs))
+	return buildLogs, nil
+}
+
+func (s *agentManagerService) StreamBuildLogs(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string, cursor string, emit func(event models.LogStreamEvent) error) error {
+	s.logger.Info("Streaming build logs", "agentName", agentName, "buildName", buildName, "orgName", orgName, "projectName", projectName, "userIdpId", userIdpId)
+	logCursor, err := decodeLogCursor(cursor)
+	if err != nil {
+		return err
+	}
+	build, err := s.getAgentBuild(ctx, userIdpId, orgName, projectName, agentName, buildName)
+	if err != nil {
+		return err
+	}
+
+	for {
+		// The status is read before draining the logs so that no logs written before the build finished are missed
+		finished := clients.IsTerminalBuildStatus(clients.BuildStatus(build.Status))
+		for {
+			buildLogs, nextCursor, hasMore, err := s.fetchBuildLogs(ctx, build, logCursor, logStreamBatchSize)
+			if err != nil {
+				return err
+			}
+			eventCursor := logCursor
+			for i := range buildLogs.Logs {
+				eventCursor = eventCursor.advance(buildLogs.Logs[i].Timestamp)
+				if err := emit(models.LogStreamEvent{Type: utils.LogStreamEventLog, Cursor: eventCursor.encode(), Log: &buildLogs.Logs[i]}); err != nil {
+					return err
+				}
+			}
+			logCursor = nextCursor
+			if !hasMore {
+				break
+			}
+		}
+
+		// Logs are shipped asynchronously, so keep tailing for a while after a build finishes
+		if finished && (build.EndedAt == nil || time.Since(*build.EndedAt) > logIngestionDelay) {
+			s.logger.Info("Build log stream completed", "buildName", buildName, "status", build.Status)
+			return emit(models.LogStreamEvent{Type: utils.LogStreamEventComplete, Cursor: logCursor.encode(), Status: build.Status})
+		}
+		if err := emit(models.LogStreamEvent{Type: utils.LogStreamEventHeartbeat}); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(logStreamPollInterval):
+		}
+		build, err = s.OpenChoreoSvcClient.GetComponentWorkflow(ctx, orgName, projectName, agentName, buildName)
+		if err != nil {
+			s.logger.Error("Failed to get build", "buildName", buildName, "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+			return fmt.Errorf("failed to get build %s for agent %s: %w", buildName, agentName, err)
+		}
+	}
+}
+
+// getAgentBuild validates that the organization, project and agent exist and returns the agent's build
+func (s *agentManagerService) getAgentBuild(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, buildName string) (*models.BuildDetailsResponse, error) {
 	// Validate organization exists
 	valid, err := s.validateOrganization(ctx, userIdpId, orgName)
 	if err != nil {
@@ -676,15 +1400,30 @@ func (s *agentManagerService) GetBuildLogs(ctx context.Context, userIdpId uuid.U
 		s.logger.Error("Failed to get build", "buildName", buildName, "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
 		return nil, fmt.Errorf("failed to get build %s for agent %s: %w", buildName, agentName, err)
 	}
+	return build, nil
+}
 
-	// Fetch the build logs from Observability service
-	buildLogs, err := s.ObservabilitySvcClient.GetBuildLogs(ctx, build.Name)
+// fetchBuildLogs returns up to limit logs of the build after the cursor, the cursor following the returned logs,
+// and whether more logs are available
+func (s *agentManagerService) fetchBuildLogs(ctx context.Context, build *models.BuildDetailsResponse, cursor *logCursor, limit int) (*models.BuildLogsResponse, *logCursor, bool, error) {
+	params := observabilitysvc.BuildLogsParams{
+		StartTime: build.StartedAt.Add(-buildLogWindowPadding),
+		EndTime:   time.Now(),
+		Limit:     logFetchLimit(cursor, limit),
+	}
+	if cursor != nil {
+		// Logs at the cursor's timestamp that were already returned are fetched again and skipped below
+		params.StartTime = cursor.Timestamp
+	}
+	buildLogs, err := s.ObservabilitySvcClient.GetBuildLogs(ctx, build.Name, params)
 	if err != nil {
 		s.logger.Error("Failed to fetch build logs from observability service", "buildName", build.Name, "error", err)
-		return nil, fmt.Errorf("failed to fetch build logs: %w", err)
+		return nil, nil, false, fmt.Errorf("failed to fetch build logs: %w", err)
 	}
-	s.logger.Info("Fetched build logs successfully", "agentName", agentName, "orgName", orgName, "projectName", projectName, "buildName", buildName, "logCount", len(buildLogs.Logs))
-	return buildLogs, nil
+
+	logs, nextCursor, hasMore := pageLogs(buildLogs.Logs, cursor, limit)
+	buildLogs.Logs = logs
+	return buildLogs, nextCursor, hasMore, nil
 }
 
 func (s *agentManagerService) validateOrganization(ctx context.Context, userIdpID uuid.UUID, orgName string) (bool, error) {
@@ -1013,10 +1752,10 @@ func (s *agentManagerService) convertManagedAgentToAgentResponse(ocAgentComponen
 }
 
 // buildWorkloadSpec constructs the workload specification from the create agent request
-func buildWorkloadSpec(req *spec.CreateAgentRequest) (map[string]interface{}, error) {
+func buildWorkloadSpec(req *spec.CreateAgentRequest, envVars []spec.EnvironmentVariable) (map[string]interface{}, error) {
 	workloadSpec := make(map[string]interface{})
 
-	workloadSpec["envVars"] = req.RuntimeConfigs.Env
+	workloadSpec["envVars"] = envVars
 
 	if req.AgentType.Type == string(utils.AgentTypeAPI) &&
 		utils.StrPointerAsStr(req.AgentType.SubType, "") == string(utils.AgentSubTypeChatAPI) {
@@ -1039,16 +1778,20 @@ func buildWorkloadSpec(req *spec.CreateAgentRequest) (map[string]interface{}, er
 
 	// Handle Custom API - use schema path from request
 	if req.AgentType.Type == string(utils.AgentTypeAPI) && utils.StrPointerAsStr(req.AgentType.SubType, "") == string(utils.AgentSubTypeCustomAPI) {
-		endpoints := []map[string]interface{}{
-			{
-				"name":       fmt.Sprintf("%s-endpoint", req.Name),
-				"port":       req.InputInterface.Port,
-				"type":       string(req.InputInterface.Type),
-				"schemaPath": req.InputInterface.Schema.Path,
-			},
-		}
-		workloadSpec["endpoints"] = endpoints
+		workloadSpec["endpoints"] = buildCustomAPIEndpoints(req.Name, req.InputInterface)
 	}
 
 	return workloadSpec, nil
 }
+
+// buildCustomAPIEndpoints constructs the workload spec endpoints for a custom API agent
+func buildCustomAPIEndpoints(agentName string, inputInterface *spec.InputInterface) []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"name":       fmt.Sprintf("%s-endpoint", agentName),
+			"port":       inputInterface.Port,
+			"type":       string(inputInterface.Type),
+			"schemaPath": inputInterface.Schema.Path,
+		},
+	}
+}
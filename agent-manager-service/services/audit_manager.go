@@ -0,0 +1,105 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type AuditManager interface {
+	RecordEvent(ctx context.Context, event *models.AuditEvent) error
+	ListAuditEvents(ctx context.Context, userIdpId uuid.UUID, orgName string, filter models.AuditEventFilter, limit int, offset int) ([]*models.AuditEventResponse, int32, error)
+}
+
+type auditManager struct {
+	OrganizationRepository repositories.OrganizationRepository
+	AuditEventRepository   repositories.AuditEventRepository
+	logger                 *slog.Logger
+}
+
+func NewAuditManager(
+	orgRepo repositories.OrganizationRepository,
+	auditEventRepo repositories.AuditEventRepository,
+	logger *slog.Logger,
+) AuditManager {
+	return &auditManager{
+		OrganizationRepository: orgRepo,
+		AuditEventRepository:   auditEventRepo,
+		logger:                 logger,
+	}
+}
+
+func (s *auditManager) RecordEvent(ctx context.Context, event *models.AuditEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	if err := s.AuditEventRepository.CreateAuditEvent(ctx, event); err != nil {
+		return fmt.Errorf("failed to record audit event %s: %w", event.Action, err)
+	}
+	return nil
+}
+
+func (s *auditManager) ListAuditEvents(ctx context.Context, userIdpId uuid.UUID, orgName string, filter models.AuditEventFilter, limit int, offset int) ([]*models.AuditEventResponse, int32, error) {
+	s.logger.Debug("ListAuditEvents called", "userIdpId", userIdpId, "orgName", orgName, "limit", limit, "offset", offset)
+
+	_, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Organization not found", "userIdpId", userIdpId, "orgName", orgName)
+			return nil, 0, utils.ErrOrganizationNotFound
+		}
+		s.logger.Error("Failed to get organization from repository", "userIdpId", userIdpId, "orgName", orgName, "error", err)
+		return nil, 0, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+
+	events, total, err := s.AuditEventRepository.ListAuditEvents(ctx, orgName, filter, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list audit events", "orgName", orgName, "error", err)
+		return nil, 0, fmt.Errorf("failed to list audit events for organization %s: %w", orgName, err)
+	}
+
+	responses := make([]*models.AuditEventResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, &models.AuditEventResponse{
+			ID:            event.ID.String(),
+			Actor:         event.Actor.String(),
+			Action:        event.Action,
+			Target:        event.Target,
+			ProjectName:   event.ProjectName,
+			AgentName:     event.AgentName,
+			CorrelationID: event.CorrelationID,
+			Result:        event.Result,
+			StatusCode:    event.StatusCode,
+			CreatedAt:     event.CreatedAt,
+		})
+	}
+	s.logger.Info("Fetched audit events successfully", "orgName", orgName, "count", len(responses), "total", total)
+	return responses, int32(total), nil
+}
@@ -110,7 +110,7 @@ func (b *buildCIManagerService) HandleBuildCallback(ctx context.Context, orgName
 // SCHEMA_CONTENT - placeholder for the OpenAPI schema content (if applicable)
 func buildWorkloadCRTemplate(workloadSpec map[string]interface{}, orgName, projectName, componentName string) (string, error) {
 	// Build environment variables
-	envVars, err := buildEnvVars(workloadSpec)
+	envVars, err := buildEnvVars(workloadSpec, componentName)
 	if err != nil {
 		return "", fmt.Errorf("failed to build environment variables: %w", err)
 	}
@@ -159,7 +159,8 @@ func buildWorkloadCRTemplate(workloadSpec map[string]interface{}, orgName, proje
 }
 
 // buildEnvVars converts environment variables from workload spec to v1alpha1.EnvVar slice
-func buildEnvVars(workloadSpec map[string]interface{}) ([]v1alpha1.EnvVar, error) {
+// Secret environment variables are referenced from the component's Kubernetes Secret
+func buildEnvVars(workloadSpec map[string]interface{}, componentName string) ([]v1alpha1.EnvVar, error) {
 	var envVars []v1alpha1.EnvVar
 
 	envVarsList, ok := workloadSpec["envVars"].([]interface{})
@@ -179,6 +180,19 @@ func buildEnvVars(workloadSpec map[string]interface{}) ([]v1alpha1.EnvVar, error
 			return nil, fmt.Errorf("envVar missing required key or value fields")
 		}
 
+		if isSecret, _ := envVar["isSecret"].(bool); isSecret {
+			envVars = append(envVars, v1alpha1.EnvVar{
+				Key: key,
+				ValueFrom: &v1alpha1.EnvVarValueFrom{
+					SecretRef: &v1alpha1.SecretKeyRef{
+						Name: clients.AgentSecretName(componentName),
+						Key:  key,
+					},
+				},
+			})
+			continue
+		}
+
 		envVars = append(envVars, v1alpha1.EnvVar{
 			Key:   key,
 			Value: value,
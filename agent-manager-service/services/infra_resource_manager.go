@@ -18,6 +18,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -49,6 +50,7 @@ type infraResourceManager struct {
 	AgentRepository        repositories.AgentRepository
 	ProjectRepository      repositories.ProjectRepository
 	OpenChoreoSvcClient    clients.OpenChoreoSvcClient
+	accessControl          AccessControlManager
 	logger                 *slog.Logger
 }
 
@@ -57,6 +59,7 @@ func NewInfraResourceManager(
 	projectRepo repositories.ProjectRepository,
 	agentRepo repositories.AgentRepository,
 	openChoreoSvcClient clients.OpenChoreoSvcClient,
+	accessControl AccessControlManager,
 	logger *slog.Logger,
 ) InfraResourceManager {
 	return &infraResourceManager{
@@ -64,6 +67,7 @@ func NewInfraResourceManager(
 		ProjectRepository:      projectRepo,
 		AgentRepository:        agentRepo,
 		OpenChoreoSvcClient:    openChoreoSvcClient,
+		accessControl:          accessControl,
 		logger:                 logger,
 	}
 }
@@ -235,6 +239,11 @@ func (s *infraResourceManager) ListProjects(ctx context.Context, userIdpId uuid.
 	}
 	s.logger.Debug("Retrieved projects from repository", "orgName", orgName, "totalCount", len(projects))
 
+	projects, err = s.filterReadableProjects(ctx, userIdpId, orgName, projects)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	total := len(projects)
 	// Apply pagination
 	start := offset
@@ -265,6 +274,30 @@ func (s *infraResourceManager) ListProjects(ctx context.Context, userIdpId uuid.
 	return projectResponses, int32(total), nil
 }
 
+// filterReadableProjects returns the projects of an organization the user can read. Users without a role on the
+// organization only see the projects they are members of.
+func (s *infraResourceManager) filterReadableProjects(ctx context.Context, userIdpId uuid.UUID, orgName string, projects []*models.ProjectResponse) ([]*models.ProjectResponse, error) {
+	err := s.accessControl.Authorize(ctx, userIdpId, orgName, "", utils.PermissionProjectRead)
+	if err == nil {
+		return projects, nil
+	}
+	if !errors.Is(err, utils.ErrPermissionDenied) {
+		return nil, err
+	}
+
+	readable := make([]*models.ProjectResponse, 0, len(projects))
+	for _, project := range projects {
+		if err := s.accessControl.Authorize(ctx, userIdpId, orgName, project.Name, utils.PermissionProjectRead); err != nil {
+			if errors.Is(err, utils.ErrPermissionDenied) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to authorize access to project %s: %w", project.Name, err)
+		}
+		readable = append(readable, project)
+	}
+	return readable, nil
+}
+
 func (s *infraResourceManager) DeleteProject(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string) error {
 	s.logger.Debug("DeleteProject called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName)
 
@@ -0,0 +1,122 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// buildLogWindowPadding widens the log query window to cover clock skew between the build and the cluster
+	buildLogWindowPadding = 5 * time.Minute
+	// logStreamBatchSize is the number of logs fetched per request while streaming
+	logStreamBatchSize = 500
+	// logStreamPollInterval is the delay between polls for new logs while streaming
+	logStreamPollInterval = 2 * time.Second
+	// logIngestionDelay is how long logs may take to become searchable after they are written
+	logIngestionDelay = 15 * time.Second
+	// maxLogCursorSkip bounds the logs at a cursor's timestamp that are fetched again to be skipped, so that a
+	// crafted cursor cannot request an arbitrarily large fetch. Logs beyond it at a single timestamp cannot be paged past.
+	maxLogCursorSkip = 4 * logStreamBatchSize
+	// maxLogFetchLimit bounds the number of logs fetched for a page of logs
+	maxLogFetchLimit = max(utils.MaxBuildLogsLimit, utils.MaxAgentLogsLimit) + 1 + maxLogCursorSkip
+)
+
+// logCursor points after the last log returned. Logs are ordered by timestamp and several logs can share a
+// timestamp, so the cursor holds the timestamp of the last log and how many logs with that timestamp were returned.
+type logCursor struct {
+	Timestamp time.Time `json:"t"`
+	Skip      int       `json:"n"`
+}
+
+// advance returns the cursor following a log with the given timestamp. A nil cursor points at the first log.
+func (c *logCursor) advance(timestamp time.Time) *logCursor {
+	if c != nil && c.Timestamp.Equal(timestamp) {
+		return &logCursor{Timestamp: c.Timestamp, Skip: c.Skip + 1}
+	}
+	return &logCursor{Timestamp: timestamp, Skip: 1}
+}
+
+// encode returns the opaque form of the cursor handed to clients
+func (c *logCursor) encode() string {
+	if c == nil {
+		return ""
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeLogCursor(cursor string) (*logCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, utils.ErrInvalidCursor
+	}
+	var decoded logCursor
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Timestamp.IsZero() ||
+		decoded.Skip < 0 || decoded.Skip > maxLogCursorSkip {
+		return nil, utils.ErrInvalidCursor
+	}
+	return &decoded, nil
+}
+
+// logFetchLimit returns the number of logs to fetch for a page of up to limit logs after the cursor: one more log
+// than needed tells whether there is a next page, and the logs already returned at the cursor's timestamp are skipped
+func logFetchLimit(cursor *logCursor, limit int) int {
+	fetchLimit := limit + 1
+	if cursor != nil {
+		fetchLimit += cursor.Skip
+	}
+	return min(fetchLimit, maxLogFetchLimit)
+}
+
+// pageLogs returns up to limit of the logs, in ascending timestamp order, that follow the cursor, the cursor
+// following the returned logs, and whether more logs are available. The logs are expected to be fetched from
+// the cursor's timestamp with logFetchLimit as the fetch limit.
+func pageLogs(logs []models.LogEntry, cursor *logCursor, limit int) ([]models.LogEntry, *logCursor, bool) {
+	if cursor != nil {
+		skipped := 0
+		for len(logs) > 0 {
+			timestamp := logs[0].Timestamp
+			if timestamp.Before(cursor.Timestamp) || (timestamp.Equal(cursor.Timestamp) && skipped < cursor.Skip) {
+				if timestamp.Equal(cursor.Timestamp) {
+					skipped++
+				}
+				logs = logs[1:]
+				continue
+			}
+			break
+		}
+	}
+	hasMore := len(logs) > limit
+	if hasMore {
+		logs = logs[:limit]
+	}
+
+	nextCursor := cursor
+	for _, logEntry := range logs {
+		nextCursor = nextCursor.advance(logEntry.Timestamp)
+	}
+	return logs, nextCursor, hasMore
+}
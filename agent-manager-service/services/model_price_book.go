@@ -0,0 +1,196 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// modelTagSeparators may follow a priced model name in the name reported by a span,
+// e.g. llama3:8b or claude-3-5-sonnet@20241022 are priced as llama3 and claude-3-5-sonnet.
+// A dash only starts a version when a digit follows it, so gpt-4o-2024-08-06 is priced
+// as gpt-4o while gpt-4o-mini is not.
+const modelTagSeparators = ":@"
+
+// modelPriceBook looks up the price of a model at a point in time from an organization's model prices
+type modelPriceBook struct {
+	prices []*models.ModelPrice
+}
+
+func newModelPriceBook(prices []*models.ModelPrice) *modelPriceBook {
+	return &modelPriceBook{prices: prices}
+}
+
+// find returns the price of the vendor and model that is effective at the given time, or nil if there is none.
+// The price with the longest matching model name wins; a vendor specific price wins over one for any vendor.
+func (b *modelPriceBook) find(vendor string, model string, at time.Time) *models.ModelPrice {
+	if b == nil || model == "" {
+		return nil
+	}
+
+	var best *models.ModelPrice
+	for _, price := range b.prices {
+		if at.Before(price.EffectiveFrom) || (price.EffectiveTo != nil && !at.Before(*price.EffectiveTo)) {
+			continue
+		}
+		if price.Vendor != "" && !strings.EqualFold(price.Vendor, vendor) {
+			continue
+		}
+		if !modelNameMatches(price.Model, model) {
+			continue
+		}
+		if best == nil || len(price.Model) > len(best.Model) ||
+			(len(price.Model) == len(best.Model) && best.Vendor == "" && price.Vendor != "") {
+			best = price
+		}
+	}
+	return best
+}
+
+// modelNameMatches reports whether a span's model name is the priced model name or a version of it
+func modelNameMatches(pricedModel string, model string) bool {
+	if len(model) < len(pricedModel) || !strings.EqualFold(model[:len(pricedModel)], pricedModel) {
+		return false
+	}
+	if len(model) == len(pricedModel) {
+		return true
+	}
+	suffix := model[len(pricedModel):]
+	if strings.ContainsRune(modelTagSeparators, rune(suffix[0])) {
+		return true
+	}
+	return len(suffix) > 1 && suffix[0] == '-' && unicode.IsDigit(rune(suffix[1]))
+}
+
+// estimate computes the cost of token usage per model at the given time. It returns nil when there is no usage.
+func (b *modelPriceBook) estimate(usage []traceobserversvc.ModelTokenUsage, at time.Time) *models.CostEstimate {
+	if len(usage) == 0 {
+		return nil
+	}
+
+	estimate := &models.CostEstimate{Currency: utils.ModelPriceCurrency}
+	for _, modelUsage := range usage {
+		price := b.find(modelUsage.Vendor, modelUsage.Model, at)
+		if price == nil {
+			estimate.UnpricedTokens += modelUsage.InputTokens + modelUsage.OutputTokens
+			continue
+		}
+		inputCost, outputCost := tokenCost(price, modelUsage.InputTokens, modelUsage.OutputTokens)
+		estimate.InputCost += inputCost
+		estimate.OutputCost += outputCost
+	}
+	estimate.InputCost = roundCost(estimate.InputCost)
+	estimate.OutputCost = roundCost(estimate.OutputCost)
+	estimate.TotalCost = roundCost(estimate.InputCost + estimate.OutputCost)
+	return estimate
+}
+
+// addCostEstimate adds estimate to total, returning total or a new estimate when total is nil
+func addCostEstimate(total *models.CostEstimate, estimate *models.CostEstimate) *models.CostEstimate {
+	if estimate == nil {
+		return total
+	}
+	if total == nil {
+		total = &models.CostEstimate{Currency: estimate.Currency}
+	}
+	total.InputCost = roundCost(total.InputCost + estimate.InputCost)
+	total.OutputCost = roundCost(total.OutputCost + estimate.OutputCost)
+	total.TotalCost = roundCost(total.TotalCost + estimate.TotalCost)
+	total.UnpricedTokens += estimate.UnpricedTokens
+	return total
+}
+
+// estimateSpan adds the estimated cost to the data of an LLM span, which is passed through from the
+// trace observer as {"model", "vendor", "tokenUsage": {"inputTokens", "outputTokens"}, ...}
+func (b *modelPriceBook) estimateSpan(span *models.Span) {
+	if span.AmpAttributes == nil || span.AmpAttributes.Kind != utils.TraceSpanKindLLM {
+		return
+	}
+	data, ok := span.AmpAttributes.Data.(map[string]interface{})
+	if !ok {
+		return
+	}
+	tokenUsage, ok := data["tokenUsage"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	model, _ := data["model"].(string)
+	vendor, _ := data["vendor"].(string)
+	inputTokens, _ := tokenUsage["inputTokens"].(float64)
+	outputTokens, _ := tokenUsage["outputTokens"].(float64)
+
+	price := b.find(vendor, model, span.StartTime)
+	if price == nil {
+		return
+	}
+	inputCost, outputCost := tokenCost(price, int(inputTokens), int(outputTokens))
+	data["cost"] = &models.CostEstimate{
+		Currency:   utils.ModelPriceCurrency,
+		InputCost:  roundCost(inputCost),
+		OutputCost: roundCost(outputCost),
+		TotalCost:  roundCost(inputCost + outputCost),
+	}
+}
+
+// tokenCost returns the cost of input and output tokens at the given price
+func tokenCost(price *models.ModelPrice, inputTokens int, outputTokens int) (float64, float64) {
+	return float64(inputTokens) / 1000 * price.InputPricePer1K, float64(outputTokens) / 1000 * price.OutputPricePer1K
+}
+
+// roundCost rounds a cost to a millionth of the currency unit to hide floating point noise
+func roundCost(cost float64) float64 {
+	return math.Round(cost*1e6) / 1e6
+}
+
+// addModelCost adds the daily usage of a model to a cost summary. The usage is priced at the price
+// in effect at the end of the usage day, so a price change applies from the (UTC) day it is made.
+// It reports whether a price was found.
+func (b *modelPriceBook) addModelCost(summary *models.CostSummary, usage traceobserversvc.DailyTokenUsage) bool {
+	summary.InputTokens += usage.InputTokens
+	summary.OutputTokens += usage.OutputTokens
+	summary.TotalTokens += usage.InputTokens + usage.OutputTokens
+
+	day, err := time.Parse(time.DateOnly, usage.Date)
+	if err != nil {
+		summary.UnpricedTokens += usage.InputTokens + usage.OutputTokens
+		return false
+	}
+	price := b.find(usage.Vendor, usage.Model, day.Add(24*time.Hour-time.Nanosecond))
+	if price == nil {
+		summary.UnpricedTokens += usage.InputTokens + usage.OutputTokens
+		return false
+	}
+	inputCost, outputCost := tokenCost(price, usage.InputTokens, usage.OutputTokens)
+	summary.InputCost += inputCost
+	summary.OutputCost += outputCost
+	summary.TotalCost += inputCost + outputCost
+	return true
+}
+
+// roundCostSummary rounds the costs of a summary, see roundCost
+func roundCostSummary(summary *models.CostSummary) {
+	summary.InputCost = roundCost(summary.InputCost)
+	summary.OutputCost = roundCost(summary.OutputCost)
+	summary.TotalCost = roundCost(summary.TotalCost)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type ModelPriceManager interface {
+	CreateModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, req *spec.ModelPriceRequest) (*models.ModelPriceResponse, error)
+	ListModelPrices(ctx context.Context, userIdpId uuid.UUID, orgName string) ([]*models.ModelPriceResponse, error)
+	UpdateModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, priceId uuid.UUID, req *spec.ModelPriceRequest) (*models.ModelPriceResponse, error)
+	DeleteModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, priceId uuid.UUID) error
+}
+
+type modelPriceManager struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ModelPriceRepository   repositories.ModelPriceRepository
+	logger                 *slog.Logger
+}
+
+func NewModelPriceManager(
+	orgRepo repositories.OrganizationRepository,
+	modelPriceRepo repositories.ModelPriceRepository,
+	logger *slog.Logger,
+) ModelPriceManager {
+	return &modelPriceManager{
+		OrganizationRepository: orgRepo,
+		ModelPriceRepository:   modelPriceRepo,
+		logger:                 logger,
+	}
+}
+
+func (s *modelPriceManager) CreateModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, req *spec.ModelPriceRequest) (*models.ModelPriceResponse, error) {
+	s.logger.Debug("CreateModelPrice called", "userIdpId", userIdpId, "orgName", orgName, "model", req.Model)
+
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	price := &models.ModelPrice{
+		ID:        uuid.New(),
+		OrgID:     org.ID,
+		CreatedBy: userIdpId,
+		CreatedAt: time.Now(),
+	}
+	applyModelPriceRequest(price, req, price.CreatedAt)
+	price.UpdatedAt = price.CreatedAt
+
+	err = s.writeModelPrice(ctx, price, s.ModelPriceRepository.CreateModelPrice)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidModelPriceRange) || errors.Is(err, utils.ErrModelPriceConflict) {
+			return nil, err
+		}
+		s.logger.Error("Failed to create model price", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to create model price: %w", err)
+	}
+	s.logger.Info("Created model price successfully", "orgName", orgName, "priceId", price.ID, "model", price.Model)
+	return toModelPriceResponse(price), nil
+}
+
+func (s *modelPriceManager) ListModelPrices(ctx context.Context, userIdpId uuid.UUID, orgName string) ([]*models.ModelPriceResponse, error) {
+	s.logger.Debug("ListModelPrices called", "userIdpId", userIdpId, "orgName", orgName)
+
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := s.ModelPriceRepository.ListModelPrices(ctx, org.ID)
+	if err != nil {
+		s.logger.Error("Failed to list model prices", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to list model prices for organization %s: %w", orgName, err)
+	}
+	responses := make([]*models.ModelPriceResponse, 0, len(prices))
+	for _, price := range prices {
+		responses = append(responses, toModelPriceResponse(price))
+	}
+	return responses, nil
+}
+
+func (s *modelPriceManager) UpdateModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, priceId uuid.UUID, req *spec.ModelPriceRequest) (*models.ModelPriceResponse, error) {
+	s.logger.Debug("UpdateModelPrice called", "userIdpId", userIdpId, "orgName", orgName, "priceId", priceId)
+
+	price, err := s.getModelPrice(ctx, userIdpId, orgName, priceId)
+	if err != nil {
+		return nil, err
+	}
+	// An omitted effectiveFrom keeps the current one rather than moving it to now
+	applyModelPriceRequest(price, req, price.EffectiveFrom)
+
+	err = s.writeModelPrice(ctx, price, s.ModelPriceRepository.UpdateModelPrice)
+	if err != nil {
+		if errors.Is(err, utils.ErrInvalidModelPriceRange) || errors.Is(err, utils.ErrModelPriceConflict) {
+			return nil, err
+		}
+		s.logger.Error("Failed to update model price", "orgName", orgName, "priceId", priceId, "error", err)
+		return nil, fmt.Errorf("failed to update model price %s: %w", priceId, err)
+	}
+	s.logger.Info("Updated model price successfully", "orgName", orgName, "priceId", priceId)
+	return toModelPriceResponse(price), nil
+}
+
+func (s *modelPriceManager) DeleteModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, priceId uuid.UUID) error {
+	s.logger.Debug("DeleteModelPrice called", "userIdpId", userIdpId, "orgName", orgName, "priceId", priceId)
+
+	price, err := s.getModelPrice(ctx, userIdpId, orgName, priceId)
+	if err != nil {
+		return err
+	}
+	if err := s.ModelPriceRepository.DeleteModelPrice(ctx, price.OrgID, price.ID); err != nil {
+		s.logger.Error("Failed to delete model price", "orgName", orgName, "priceId", priceId, "error", err)
+		return fmt.Errorf("failed to delete model price %s: %w", priceId, err)
+	}
+	s.logger.Info("Deleted model price successfully", "orgName", orgName, "priceId", priceId)
+	return nil
+}
+
+// writeModelPrice checks the price against the organization's other prices and writes it in one transaction,
+// holding the organization's model price lock so that concurrent writes cannot create overlapping prices
+func (s *modelPriceManager) writeModelPrice(ctx context.Context, price *models.ModelPrice, write func(ctx context.Context, price *models.ModelPrice) error) error {
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := db.CtxWithTx(ctx, tx)
+		if err := s.ModelPriceRepository.LockModelPrices(txCtx, price.OrgID); err != nil {
+			return err
+		}
+		if err := s.checkOverlap(txCtx, price); err != nil {
+			return err
+		}
+		return write(txCtx, price)
+	})
+}
+
+// checkOverlap rejects a price with an empty effective period, or one that overlaps another price of the same vendor and model
+func (s *modelPriceManager) checkOverlap(ctx context.Context, price *models.ModelPrice) error {
+	if price.EffectiveTo != nil && !price.EffectiveTo.After(price.EffectiveFrom) {
+		return utils.ErrInvalidModelPriceRange
+	}
+	prices, err := s.ModelPriceRepository.ListModelPrices(ctx, price.OrgID)
+	if err != nil {
+		s.logger.Error("Failed to list model prices", "orgId", price.OrgID, "error", err)
+		return fmt.Errorf("failed to check existing model prices: %w", err)
+	}
+	for _, existing := range prices {
+		if existing.ID == price.ID || !strings.EqualFold(existing.Vendor, price.Vendor) || !strings.EqualFold(existing.Model, price.Model) {
+			continue
+		}
+		startsBeforeEnd := existing.EffectiveTo == nil || price.EffectiveFrom.Before(*existing.EffectiveTo)
+		endsAfterStart := price.EffectiveTo == nil || existing.EffectiveFrom.Before(*price.EffectiveTo)
+		if startsBeforeEnd && endsAfterStart {
+			s.logger.Warn("Model price overlaps an existing price", "priceId", price.ID, "existingPriceId", existing.ID, "model", price.Model)
+			return utils.ErrModelPriceConflict
+		}
+	}
+	return nil
+}
+
+func (s *modelPriceManager) getOrganization(ctx context.Context, userIdpId uuid.UUID, orgName string) (*models.Organization, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Organization not found", "userIdpId", userIdpId, "orgName", orgName)
+			return nil, utils.ErrOrganizationNotFound
+		}
+		s.logger.Error("Failed to get organization from repository", "userIdpId", userIdpId, "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	return org, nil
+}
+
+func (s *modelPriceManager) getModelPrice(ctx context.Context, userIdpId uuid.UUID, orgName string, priceId uuid.UUID) (*models.ModelPrice, error) {
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	price, err := s.ModelPriceRepository.GetModelPrice(ctx, org.ID, priceId)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Model price not found", "orgName", orgName, "priceId", priceId)
+			return nil, utils.ErrModelPriceNotFound
+		}
+		s.logger.Error("Failed to get model price from repository", "orgName", orgName, "priceId", priceId, "error", err)
+		return nil, fmt.Errorf("failed to find model price %s: %w", priceId, err)
+	}
+	return price, nil
+}
+
+// applyModelPriceRequest copies the request fields to the price, using defaultEffectiveFrom when effectiveFrom is omitted
+func applyModelPriceRequest(price *models.ModelPrice, req *spec.ModelPriceRequest, defaultEffectiveFrom time.Time) {
+	price.Vendor = ""
+	if req.Vendor != nil {
+		price.Vendor = strings.TrimSpace(*req.Vendor)
+	}
+	price.Model = strings.TrimSpace(req.Model)
+	price.InputPricePer1K = req.InputPricePer1k
+	price.OutputPricePer1K = req.OutputPricePer1k
+	price.EffectiveFrom = defaultEffectiveFrom
+	if req.EffectiveFrom != nil {
+		price.EffectiveFrom = *req.EffectiveFrom
+	}
+	price.EffectiveTo = req.EffectiveTo
+}
+
+func toModelPriceResponse(price *models.ModelPrice) *models.ModelPriceResponse {
+	return &models.ModelPriceResponse{
+		ID:               price.ID.String(),
+		Vendor:           price.Vendor,
+		Model:            price.Model,
+		InputPricePer1K:  price.InputPricePer1K,
+		OutputPricePer1K: price.OutputPricePer1K,
+		Currency:         utils.ModelPriceCurrency,
+		EffectiveFrom:    price.EffectiveFrom,
+		EffectiveTo:      price.EffectiveTo,
+		CreatedBy:        price.CreatedBy.String(),
+		CreatedAt:        price.CreatedAt,
+		UpdatedAt:        price.UpdatedAt,
+	}
+}
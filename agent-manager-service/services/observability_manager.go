@@ -21,16 +21,32 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // ErrTraceNotFound is returned when a trace is not found
 var ErrTraceNotFound = errors.New("trace not found")
 
+// ErrSessionNotFound is returned when no traces of a session are found
+var ErrSessionNotFound = errors.New("session not found")
+
 // Service-level request/response types (not exposing client types)
+
+// ListTracesRequest selects a page of traces of an agent, or of all agents of a project when AgentName is empty
 type ListTracesRequest struct {
 	OrgName     string
 	ProjectName string
@@ -41,36 +57,139 @@ type ListTracesRequest struct {
 	Limit       int
 	Offset      int
 	SortOrder   string
+	Cursor      string
+	Filters     TraceFilters
+}
+
+// TraceFilters holds optional filters for listing traces. Span filters select traces
+// with at least one span matching all of them; duration filters apply to the whole trace.
+// The span and duration filters are passed through to the trace observer, which validates them.
+type TraceFilters struct {
+	Params url.Values // Span and duration filter query parameters of the trace observer
+	Rating string     // Optional, selects the traces with an annotation of the rating
 }
 
+// TraceDetailsRequest selects a trace of an agent, or of any agent of the organization when
+// ProjectName and AgentName are empty
 type TraceDetailsRequest struct {
 	TraceID     string
 	OrgName     string
 	ProjectName string
 	AgentName   string
 	Environment string
+	StartTime   string // Optional hint of when the trace ran, recent traces are searched when empty
+	EndTime     string
+	FullTrace   bool      // Also returns the spans of the other agents the user can read traces of
+	UserIdpId   uuid.UUID // User whose access decides which agents' spans are returned across projects
+}
+
+// CostRequest selects the usage of an agent, or of all agents of a project when AgentName is empty
+type CostRequest struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string // Optional, all environments when empty
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+// ListSessionsRequest selects a page of the conversation sessions of an agent
+type ListSessionsRequest struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	StartTime   string
+	EndTime     string
+	Limit       int
+	Offset      int
+}
+
+// SessionRequest selects a conversation session of an agent
+type SessionRequest struct {
+	SessionID   string
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	StartTime   string // Optional, recent sessions are searched when empty
+	EndTime     string
+}
+
+// AgentLogsRequest selects the runtime logs of an agent in an environment
+type AgentLogsRequest struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	StartTime   time.Time // Optional, the trace's time window or the last hour is searched when zero
+	EndTime     time.Time // Optional, now when zero
+	LogLevels   []string  // Optional, all levels when empty
+	Search      string
+	TraceID     string // Optional, keeps the logs of the trace and the logs that carry no trace ID
+	Limit       int
+	Cursor      string
+}
+
+type MetricsRequest struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	StartTime   string
+	EndTime     string
+	Interval    string
 }
 
 type ObservabilityManagerService interface {
 	ListTraces(ctx context.Context, req ListTracesRequest) (*models.TraceOverviewResponse, error)
+	ListProjectTraces(ctx context.Context, req ListTracesRequest) (*models.TraceOverviewResponse, error)
 	GetTraceDetails(ctx context.Context, req TraceDetailsRequest) (*models.TraceResponse, error)
+	GetOrgTraceDetails(ctx context.Context, req TraceDetailsRequest) (*models.TraceResponse, error)
+	GetMetrics(ctx context.Context, req MetricsRequest) (*models.AgentMetricsResponse, error)
+	GetAgentCost(ctx context.Context, req CostRequest) (*models.AgentCostResponse, error)
+	GetProjectCost(ctx context.Context, req CostRequest) (*models.ProjectCostResponse, error)
+	ListSessions(ctx context.Context, req ListSessionsRequest) (*models.SessionListResponse, error)
+	GetSession(ctx context.Context, req SessionRequest) (*models.SessionResponse, error)
+	GetAgentLogs(ctx context.Context, req AgentLogsRequest) (*models.AgentLogsResponse, error)
+	// StreamAgentLogs emits the agent's runtime logs after the cursor as they arrive, until the end time
+	// has passed or the context is cancelled
+	StreamAgentLogs(ctx context.Context, req AgentLogsRequest, emit func(event models.LogStreamEvent) error) error
 }
 
 type observabilityManagerService struct {
-	traceObserverClient traceobserversvc.TraceObserverClient
-	openChoreoClient    openchoreosvc.OpenChoreoSvcClient
-	logger              *slog.Logger
+	traceObserverClient       traceobserversvc.TraceObserverClient
+	observabilitySvcClient    observabilitysvc.ObservabilitySvcClient
+	openChoreoClient          openchoreosvc.OpenChoreoSvcClient
+	OrganizationRepository    repositories.OrganizationRepository
+	ProjectRepository         repositories.ProjectRepository
+	ModelPriceRepository      repositories.ModelPriceRepository
+	TraceAnnotationRepository repositories.TraceAnnotationRepository
+	accessControl             AccessControlManager
+	logger                    *slog.Logger
 }
 
 func NewObservabilityManager(
 	traceObserverClient traceobserversvc.TraceObserverClient,
+	observabilitySvcClient observabilitysvc.ObservabilitySvcClient,
 	openChoreoClient openchoreosvc.OpenChoreoSvcClient,
+	orgRepo repositories.OrganizationRepository,
+	projectRepo repositories.ProjectRepository,
+	modelPriceRepo repositories.ModelPriceRepository,
+	traceAnnotationRepo repositories.TraceAnnotationRepository,
+	accessControl AccessControlManager,
 	logger *slog.Logger,
 ) ObservabilityManagerService {
 	return &observabilityManagerService{
-		traceObserverClient: traceObserverClient,
-		openChoreoClient:    openChoreoClient,
-		logger:              logger,
+		traceObserverClient:       traceObserverClient,
+		observabilitySvcClient:    observabilitySvcClient,
+		openChoreoClient:          openChoreoClient,
+		OrganizationRepository:    orgRepo,
+		ProjectRepository:         projectRepo,
+		ModelPriceRepository:      modelPriceRepo,
+		TraceAnnotationRepository: traceAnnotationRepo,
+		accessControl:             accessControl,
+		logger:                    logger,
 	}
 }
 
@@ -93,68 +212,216 @@ func (s *observabilityManagerService) ListTraces(ctx context.Context, req ListTr
 		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
 
+	// Annotations are stored per project, so the traces of an agent whose project is not in the database have none
+	project, err := s.getProject(ctx, req.OrgName, req.ProjectName)
+	if err != nil && !errors.Is(err, utils.ErrOrganizationNotFound) && !errors.Is(err, utils.ErrProjectNotFound) {
+		return nil, err
+	}
+
 	// Convert service request to client params
-	clientParams := traceobserversvc.ListTracesParams{
-		ServiceName:    req.AgentName,
-		ComponentUid:   component.UUID,
-		EnvironmentUid: environment.UUID,
+	clientParams := newListTracesParams(req, environment.UUID)
+	clientParams.ServiceName = req.AgentName
+	clientParams.ComponentUid = component.UUID
+
+	response, err := s.listTraces(ctx, req, clientParams, nil, project)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Retrieved traces successfully", "agentName", req.AgentName, "totalCount", response.TotalCount)
+	return response, nil
+}
+
+// ListProjectTraces retrieves trace overviews of all agents of a project, each attributed to the agent of its root span
+func (s *observabilityManagerService) ListProjectTraces(ctx context.Context, req ListTracesRequest) (*models.TraceOverviewResponse, error) {
+	s.logger.Info("Listing project traces", "projectName", req.ProjectName, "limit", req.Limit, "offset", req.Offset)
+
+	project, err := s.getProject(ctx, req.OrgName, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := s.openChoreoClient.ListAgentComponents(ctx, req.OrgName, req.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to list agent components", "projectName", req.ProjectName, "error", err)
+		return nil, fmt.Errorf("failed to list agent components: %w", err)
+	}
+	if len(components) == 0 {
+		return &models.TraceOverviewResponse{Traces: []models.TraceOverview{}}, nil
+	}
+	agentNames := make(map[string]string, len(components))
+	for _, component := range components {
+		agentNames[component.UUID] = component.Name
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	clientParams := newListTracesParams(req, environment.UUID)
+	for componentUid := range agentNames {
+		clientParams.ComponentUids = append(clientParams.ComponentUids, componentUid)
+	}
+	sort.Strings(clientParams.ComponentUids)
+
+	response, err := s.listTraces(ctx, req, clientParams, agentNames, project)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Retrieved project traces successfully", "projectName", req.ProjectName,
+		"agentCount", len(agentNames), "totalCount", response.TotalCount)
+	return response, nil
+}
+
+// newListTracesParams converts a trace list request to client params, leaving the components to query unset
+func newListTracesParams(req ListTracesRequest, environmentUid string) traceobserversvc.ListTracesParams {
+	return traceobserversvc.ListTracesParams{
+		EnvironmentUid: environmentUid,
 		StartTime:      req.StartTime,
 		EndTime:        req.EndTime,
 		Limit:          req.Limit,
 		Offset:         req.Offset,
 		SortOrder:      req.SortOrder,
+		Cursor:         req.Cursor,
+		OrgName:        req.OrgName,
+		Filters:        req.Filters.Params,
+	}
+}
+
+// listTraces lists traces from the trace observer. When agentNames is set, keyed by component UID
+// with agent names as values, each trace is attributed to the agent of its root span. The traces'
+// annotation counts are looked up in project, which is nil when the project is not in the database.
+func (s *observabilityManagerService) listTraces(ctx context.Context, req ListTracesRequest, clientParams traceobserversvc.ListTracesParams, agentNames map[string]string, project *models.Project) (*models.TraceOverviewResponse, error) {
+	if req.Filters.Rating != "" {
+		traceIds, err := s.listRatedTraceIDs(ctx, req, project)
+		if err != nil {
+			return nil, err
+		}
+		if len(traceIds) == 0 {
+			return &models.TraceOverviewResponse{Traces: []models.TraceOverview{}}, nil
+		}
+		clientParams.TraceIDs = traceIds
 	}
 
 	// Call the trace observer client
 	clientResponse, err := s.traceObserverClient.ListTraces(ctx, clientParams)
 	if err != nil {
-		s.logger.Error("Failed to list traces", "agentName", req.AgentName, "error", err)
+		// The trace observer rejects cursors it did not issue and invalid filters with a bad request.
+		// Cursors are only issued for queries the trace observer accepted, so a rejected query with
+		// a cursor is reported as an invalid cursor.
+		if traceobserversvc.IsBadRequest(err) {
+			if req.Cursor != "" {
+				s.logger.Warn("Invalid trace cursor", "projectName", req.ProjectName, "agentName", req.AgentName)
+				return nil, utils.ErrInvalidCursor
+			}
+			s.logger.Warn("Invalid trace filter", "projectName", req.ProjectName, "agentName", req.AgentName, "error", err)
+			return nil, fmt.Errorf("%w: %s", utils.ErrInvalidTraceFilter, traceobserversvc.ErrorMessage(err))
+		}
+		s.logger.Error("Failed to list traces", "projectName", req.ProjectName, "agentName", req.AgentName, "error", err)
 		return nil, fmt.Errorf("failed to list traces: %w", err)
 	}
 
-	s.logger.Info("Successfully listed traces", "agentName", req.AgentName, "traceCount", len(clientResponse.Traces))
+	s.logger.Info("Successfully listed traces", "projectName", req.ProjectName, "agentName", req.AgentName,
+		"traceCount", len(clientResponse.Traces))
+	priceBook := s.loadModelPriceBook(ctx, req.OrgName)
 	// Convert client response to service model
 	traces := make([]models.TraceOverview, len(clientResponse.Traces))
 	for i, trace := range clientResponse.Traces {
-		var tokenUsage *models.TokenUsage
-		if trace.TokenUsage != nil {
-			tokenUsage = &models.TokenUsage{
-				InputTokens:  trace.TokenUsage.InputTokens,
-				OutputTokens: trace.TokenUsage.OutputTokens,
-				TotalTokens:  trace.TokenUsage.TotalTokens,
-			}
-		}
+		traces[i] = convertTraceOverview(trace, priceBook)
+		traces[i].AgentName = agentNames[trace.ComponentUid]
+	}
+	s.addAnnotationSummaries(ctx, project, traces)
 
-		var traceStatus *models.TraceStatus
-		if trace.Status != nil {
-			traceStatus = &models.TraceStatus{
-				ErrorCount: trace.Status.ErrorCount,
-			}
+	return &models.TraceOverviewResponse{
+		Traces:     traces,
+		TotalCount: clientResponse.TotalCount,
+		NextCursor: clientResponse.NextCursor,
+		Truncated:  clientResponse.Truncated,
+	}, nil
+}
+
+// convertTraceOverview converts a trace overview of the trace observer, estimating its cost with priceBook
+func convertTraceOverview(trace traceobserversvc.TraceOverview, priceBook *modelPriceBook) models.TraceOverview {
+	var tokenUsage *models.TokenUsage
+	if trace.TokenUsage != nil {
+		tokenUsage = &models.TokenUsage{
+			InputTokens:  trace.TokenUsage.InputTokens,
+			OutputTokens: trace.TokenUsage.OutputTokens,
+			TotalTokens:  trace.TokenUsage.TotalTokens,
 		}
+	}
 
-		traces[i] = models.TraceOverview{
-			TraceID:         trace.TraceID,
-			RootSpanID:      trace.RootSpanID,
-			RootSpanName:    trace.RootSpanName,
-			RootSpanKind:    trace.RootSpanKind,
-			StartTime:       trace.StartTime,
-			EndTime:         trace.EndTime,
-			DurationInNanos: trace.DurationInNanos,
-			SpanCount:       trace.SpanCount,
-			TokenUsage:      tokenUsage,
-			Status:          traceStatus,
-			Input:           trace.Input,
-			Output:          trace.Output,
+	var traceStatus *models.TraceStatus
+	if trace.Status != nil {
+		traceStatus = &models.TraceStatus{
+			ErrorCount: trace.Status.ErrorCount,
 		}
 	}
 
-	response := &models.TraceOverviewResponse{
-		Traces:     traces,
-		TotalCount: clientResponse.TotalCount,
+	return models.TraceOverview{
+		TraceID:         trace.TraceID,
+		RootSpanID:      trace.RootSpanID,
+		RootSpanName:    trace.RootSpanName,
+		RootSpanKind:    trace.RootSpanKind,
+		StartTime:       trace.StartTime,
+		EndTime:         trace.EndTime,
+		DurationInNanos: trace.DurationInNanos,
+		SpanCount:       trace.SpanCount,
+		TokenUsage:      tokenUsage,
+		EstimatedCost:   priceBook.estimate(trace.ModelUsage, parseTraceTime(trace.StartTime)),
+		Status:          traceStatus,
+		Input:           trace.Input,
+		Output:          trace.Output,
+		Redaction:       convertRedaction(trace.Redaction),
 	}
+}
 
-	s.logger.Info("Retrieved traces successfully", "agentName", req.AgentName, "totalCount", response.TotalCount)
-	return response, nil
+// convertRedaction converts the redaction marker of the trace observer
+func convertRedaction(redaction *traceobserversvc.Redaction) *models.Redaction {
+	if redaction == nil {
+		return nil
+	}
+	return &models.Redaction{
+		Policy: redaction.Policy,
+		Count:  redaction.Count,
+		Types:  redaction.Types,
+	}
+}
+
+// convertSpanEvents converts the events of a span from the trace observer
+func convertSpanEvents(events []traceobserversvc.SpanEvent) []models.SpanEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	result := make([]models.SpanEvent, len(events))
+	for i, event := range events {
+		result[i] = models.SpanEvent{
+			Name:       event.Name,
+			Timestamp:  event.Time,
+			Kind:       event.Kind,
+			Attributes: event.Attributes,
+		}
+	}
+	return result
+}
+
+// convertSpanLinks converts the links of a span from the trace observer
+func convertSpanLinks(links []traceobserversvc.SpanLink) []models.SpanLink {
+	if len(links) == 0 {
+		return nil
+	}
+	result := make([]models.SpanLink, len(links))
+	for i, link := range links {
+		result[i] = models.SpanLink{
+			TraceID:    link.TraceID,
+			SpanID:     link.SpanID,
+			Attributes: link.Attributes,
+		}
+	}
+	return result
 }
 
 // GetTraceDetails retrieves detailed trace information by trace ID
@@ -180,6 +447,20 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 		ServiceName:    req.AgentName,
 		ComponentUid:   component.UUID,
 		EnvironmentUid: environment.UUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		OrgName:        req.OrgName,
+	}
+
+	// A full trace also has the spans that other agents, such as agents called over HTTP, added to the trace
+	var agents map[string]models.TraceAgent
+	if req.FullTrace {
+		agents, err = s.listAccessibleAgents(ctx, req.OrgName, req.UserIdpId)
+		if err != nil {
+			return nil, err
+		}
+		agents[component.UUID] = models.TraceAgent{ProjectName: req.ProjectName, AgentName: req.AgentName}
+		clientParams.ComponentUids = sortedComponentUids(agents)
 	}
 
 	// Call the trace observer client
@@ -194,6 +475,140 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 		return nil, fmt.Errorf("failed to get trace details: %w", err)
 	}
 
+	response := s.convertTraceResponse(ctx, req.OrgName, clientResponse)
+	if req.FullTrace {
+		annotateTraceAgents(response, agents)
+	}
+
+	s.logger.Info("Retrieved trace details successfully", "traceId", req.TraceID, "spanCount", response.TotalCount,
+		"fullTrace", req.FullTrace, "agentCount", len(response.Agents))
+	return response, nil
+}
+
+// GetOrgTraceDetails looks up a trace across all agents of an organization that the user can read traces of,
+// and reports the agents that took part in it
+func (s *observabilityManagerService) GetOrgTraceDetails(ctx context.Context, req TraceDetailsRequest) (*models.TraceResponse, error) {
+	s.logger.Info("Getting organization trace details", "traceId", req.TraceID, "orgName", req.OrgName)
+
+	agents, err := s.listAccessibleAgents(ctx, req.OrgName, req.UserIdpId)
+	if err != nil {
+		return nil, err
+	}
+	if len(agents) == 0 {
+		s.logger.Warn("Trace not found, organization has no accessible agents", "traceId", req.TraceID, "orgName", req.OrgName)
+		return nil, ErrTraceNotFound
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	clientParams := traceobserversvc.TraceDetailsByIdParams{
+		TraceID:        req.TraceID,
+		ComponentUids:  sortedComponentUids(agents),
+		EnvironmentUid: environment.UUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		OrgName:        req.OrgName,
+	}
+
+	clientResponse, err := s.traceObserverClient.TraceDetailsById(ctx, clientParams)
+	if err != nil {
+		if traceobserversvc.IsNotFound(err) {
+			s.logger.Warn("Trace not found", "traceId", req.TraceID, "orgName", req.OrgName)
+			return nil, ErrTraceNotFound
+		}
+		s.logger.Error("Failed to get trace details", "traceId", req.TraceID, "orgName", req.OrgName, "error", err)
+		return nil, fmt.Errorf("failed to get trace details: %w", err)
+	}
+
+	response := s.convertTraceResponse(ctx, req.OrgName, clientResponse)
+	annotateTraceAgents(response, agents)
+
+	s.logger.Info("Retrieved organization trace details successfully", "traceId", req.TraceID,
+		"spanCount", response.TotalCount, "agentCount", len(response.Agents))
+	return response, nil
+}
+
+// listAccessibleAgents returns the agents of the projects of an organization whose traces the user can read,
+// keyed by component UID
+func (s *observabilityManagerService) listAccessibleAgents(ctx context.Context, orgName string, userIdpId uuid.UUID) (map[string]models.TraceAgent, error) {
+	projects, err := s.openChoreoClient.ListProjects(ctx, orgName)
+	if err != nil {
+		s.logger.Error("Failed to list projects", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	agents := make(map[string]models.TraceAgent)
+	for _, project := range projects {
+		if err := s.accessControl.Authorize(ctx, userIdpId, orgName, project.Name, utils.PermissionTraceRead); err != nil {
+			if errors.Is(err, utils.ErrPermissionDenied) || errors.Is(err, utils.ErrOrganizationNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to authorize trace access to project %s: %w", project.Name, err)
+		}
+		components, err := s.openChoreoClient.ListAgentComponents(ctx, orgName, project.Name)
+		if err != nil {
+			s.logger.Error("Failed to list agent components", "projectName", project.Name, "error", err)
+			return nil, fmt.Errorf("failed to list agent components: %w", err)
+		}
+		for _, component := range components {
+			agents[component.UUID] = models.TraceAgent{ProjectName: project.Name, AgentName: component.Name}
+		}
+	}
+	return agents, nil
+}
+
+// sortedComponentUids returns the component UIDs of the agents in ascending order
+func sortedComponentUids(agents map[string]models.TraceAgent) []string {
+	componentUids := make([]string, 0, len(agents))
+	for componentUid := range agents {
+		componentUids = append(componentUids, componentUid)
+	}
+	sort.Strings(componentUids)
+	return componentUids
+}
+
+// annotateTraceAgents sets the agent of each span from its component UID, marks the spans whose parent span
+// belongs to another agent as agent boundaries, and lists the agents that took part in the trace
+func annotateTraceAgents(response *models.TraceResponse, agents map[string]models.TraceAgent) {
+	spanComponents := make(map[string]string, len(response.Spans))
+	for _, span := range response.Spans {
+		spanComponents[span.SpanID] = span.Service
+	}
+
+	seen := make(map[string]bool)
+	for i := range response.Spans {
+		span := &response.Spans[i]
+		agent, ok := agents[span.Service]
+		if !ok {
+			continue
+		}
+		span.Agent = &agent
+		if parentComponent, ok := spanComponents[span.ParentSpanID]; ok && parentComponent != span.Service {
+			span.AgentBoundary = true
+		}
+		if !seen[span.Service] {
+			seen[span.Service] = true
+			response.Agents = append(response.Agents, agent)
+		}
+	}
+	sort.Slice(response.Agents, func(i, j int) bool {
+		if response.Agents[i].ProjectName != response.Agents[j].ProjectName {
+			return response.Agents[i].ProjectName < response.Agents[j].ProjectName
+		}
+		return response.Agents[i].AgentName < response.Agents[j].AgentName
+	})
+}
+
+// convertTraceResponse converts the spans of a trace from the trace observer, estimating their cost
+// with the model prices of the organization
+func (s *observabilityManagerService) convertTraceResponse(ctx context.Context, orgName string, clientResponse *traceobserversvc.TraceResponse) *models.TraceResponse {
+	priceBook := s.loadModelPriceBook(ctx, orgName)
+	var traceStartTime time.Time
+
 	// Convert client response to service model
 	spans := make([]models.Span, len(clientResponse.Spans))
 	for i, span := range clientResponse.Spans {
@@ -287,9 +702,17 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 			EndTime:         span.EndTime,
 			DurationInNanos: span.DurationInNanos,
 			Status:          span.Status,
+			StatusMessage:   span.StatusMessage,
 			Attributes:      span.Attributes,
 			Resource:        span.Resource,
+			Events:          convertSpanEvents(span.Events),
+			Links:           convertSpanLinks(span.Links),
 			AmpAttributes:   ampAttrs,
+			Redaction:       convertRedaction(span.Redaction),
+		}
+		priceBook.estimateSpan(&spans[i])
+		if traceStartTime.IsZero() || span.StartTime.Before(traceStartTime) {
+			traceStartTime = span.StartTime
 		}
 	}
 
@@ -311,13 +734,655 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 		}
 	}
 
-	response := &models.TraceResponse{
-		Spans:      spans,
+	return &models.TraceResponse{
+		Spans:         spans,
+		TotalCount:    clientResponse.TotalCount,
+		TokenUsage:    tokenUsage,
+		EstimatedCost: priceBook.estimate(clientResponse.ModelUsage, traceStartTime),
+		Status:        traceStatus,
+	}
+}
+
+// GetMetrics retrieves time-bucketed metrics of an agent from the trace observer service
+func (s *observabilityManagerService) GetMetrics(ctx context.Context, req MetricsRequest) (*models.AgentMetricsResponse, error) {
+	s.logger.Info("Getting agent metrics", "agentName", req.AgentName, "environment", req.Environment,
+		"startTime", req.StartTime, "endTime", req.EndTime, "interval", req.Interval)
+
+	// Fetch component to get UID
+	component, err := s.openChoreoClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	clientResponse, err := s.traceObserverClient.GetMetrics(ctx, traceobserversvc.MetricsParams{
+		ComponentUid:   component.UUID,
+		EnvironmentUid: environment.UUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Interval:       req.Interval,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get metrics", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get metrics: %w", err)
+	}
+
+	buckets := make([]models.AgentMetricsBucket, len(clientResponse.Buckets))
+	for i, bucket := range clientResponse.Buckets {
+		buckets[i] = convertMetricsBucket(bucket)
+	}
+
+	response := &models.AgentMetricsResponse{
+		Interval: clientResponse.Interval,
+		Summary:  convertMetricsBucket(clientResponse.Summary),
+		Buckets:  buckets,
+	}
+
+	s.logger.Info("Retrieved agent metrics successfully", "agentName", req.AgentName,
+		"bucketCount", len(buckets), "requestCount", response.Summary.RequestCount)
+	return response, nil
+}
+
+// ListSessions retrieves the conversation sessions of an agent from the trace observer service
+func (s *observabilityManagerService) ListSessions(ctx context.Context, req ListSessionsRequest) (*models.SessionListResponse, error) {
+	s.logger.Info("Listing sessions", "agentName", req.AgentName, "environment", req.Environment,
+		"limit", req.Limit, "offset", req.Offset)
+
+	// Fetch component to get UID
+	component, err := s.openChoreoClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	clientResponse, err := s.traceObserverClient.ListSessions(ctx, traceobserversvc.ListSessionsParams{
+		ComponentUid:   component.UUID,
+		EnvironmentUid: environment.UUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		Limit:          req.Limit,
+		Offset:         req.Offset,
+		OrgName:        req.OrgName,
+	})
+	if err != nil {
+		s.logger.Error("Failed to list sessions", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	sessions := make([]models.SessionOverview, len(clientResponse.Sessions))
+	for i, session := range clientResponse.Sessions {
+		sessions[i] = convertSessionOverview(session)
+	}
+
+	s.logger.Info("Listed sessions successfully", "agentName", req.AgentName, "totalCount", clientResponse.TotalCount,
+		"truncated", clientResponse.Truncated)
+	return &models.SessionListResponse{
+		Sessions:   sessions,
 		TotalCount: clientResponse.TotalCount,
-		TokenUsage: tokenUsage,
-		Status:     traceStatus,
+		Truncated:  clientResponse.Truncated,
+	}, nil
+}
+
+// GetSession retrieves a conversation session of an agent with its turns
+func (s *observabilityManagerService) GetSession(ctx context.Context, req SessionRequest) (*models.SessionResponse, error) {
+	s.logger.Info("Getting session", "sessionId", req.SessionID, "agentName", req.AgentName, "environment", req.Environment)
+
+	// Fetch component to get UID
+	component, err := s.openChoreoClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
 
-	s.logger.Info("Retrieved trace details successfully", "traceId", req.TraceID, "spanCount", response.TotalCount)
+	clientResponse, err := s.traceObserverClient.GetSession(ctx, traceobserversvc.GetSessionParams{
+		SessionID:      req.SessionID,
+		ComponentUid:   component.UUID,
+		EnvironmentUid: environment.UUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		OrgName:        req.OrgName,
+	})
+	if err != nil {
+		if traceobserversvc.IsNotFound(err) {
+			s.logger.Warn("Session not found", "sessionId", req.SessionID, "agentName", req.AgentName)
+			return nil, ErrSessionNotFound
+		}
+		s.logger.Error("Failed to get session", "sessionId", req.SessionID, "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	priceBook := s.loadModelPriceBook(ctx, req.OrgName)
+	response := &models.SessionResponse{
+		SessionOverview: convertSessionOverview(clientResponse.SessionOverview),
+		Turns:           make([]models.TraceOverview, len(clientResponse.Turns)),
+		Truncated:       clientResponse.Truncated,
+	}
+	for i, turn := range clientResponse.Turns {
+		response.Turns[i] = convertTraceOverview(turn, priceBook)
+		response.EstimatedCost = addCostEstimate(response.EstimatedCost, response.Turns[i].EstimatedCost)
+	}
+
+	s.logger.Info("Retrieved session successfully", "sessionId", req.SessionID, "turnCount", len(response.Turns))
 	return response, nil
 }
+
+func convertSessionOverview(session traceobserversvc.SessionOverview) models.SessionOverview {
+	return models.SessionOverview{
+		SessionID:  session.SessionID,
+		StartTime:  session.StartTime,
+		EndTime:    session.EndTime,
+		TurnCount:  session.TurnCount,
+		ErrorCount: session.ErrorCount,
+		TokenUsage: models.TokenUsage{
+			InputTokens:  session.TokenUsage.InputTokens,
+			OutputTokens: session.TokenUsage.OutputTokens,
+			TotalTokens:  session.TokenUsage.TotalTokens,
+		},
+	}
+}
+
+func convertMetricsBucket(bucket traceobserversvc.MetricsBucket) models.AgentMetricsBucket {
+	var latency *models.LatencyPercentiles
+	if bucket.Latency != nil {
+		latency = &models.LatencyPercentiles{
+			P50InNanos: bucket.Latency.P50InNanos,
+			P95InNanos: bucket.Latency.P95InNanos,
+			P99InNanos: bucket.Latency.P99InNanos,
+		}
+	}
+
+	return models.AgentMetricsBucket{
+		Timestamp:    bucket.Timestamp,
+		RequestCount: bucket.RequestCount,
+		ErrorCount:   bucket.ErrorCount,
+		ErrorRate:    bucket.ErrorRate,
+		Latency:      latency,
+		TokenUsage: models.TokenUsage{
+			InputTokens:  bucket.TokenUsage.InputTokens,
+			OutputTokens: bucket.TokenUsage.OutputTokens,
+			TotalTokens:  bucket.TokenUsage.TotalTokens,
+		},
+	}
+}
+
+// GetAgentCost rolls up the estimated LLM cost of an agent per model over a time range
+func (s *observabilityManagerService) GetAgentCost(ctx context.Context, req CostRequest) (*models.AgentCostResponse, error) {
+	s.logger.Info("Getting agent cost", "agentName", req.AgentName, "environment", req.Environment,
+		"startTime", req.StartTime, "endTime", req.EndTime)
+
+	component, err := s.openChoreoClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	agentCosts, err := s.getComponentCosts(ctx, req, map[string]string{component.UUID: req.AgentName})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.AgentCostResponse{
+		AgentName:   req.AgentName,
+		Environment: req.Environment,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Currency:    utils.ModelPriceCurrency,
+		Models:      []models.ModelCost{},
+	}
+	if len(agentCosts) > 0 {
+		response.Models = agentCosts[0].Models
+		response.CostSummary = agentCosts[0].CostSummary
+	}
+
+	s.logger.Info("Retrieved agent cost successfully", "agentName", req.AgentName, "totalCost", response.TotalCost)
+	return response, nil
+}
+
+// GetProjectCost rolls up the estimated LLM cost of a project and each of its agents over a time range
+func (s *observabilityManagerService) GetProjectCost(ctx context.Context, req CostRequest) (*models.ProjectCostResponse, error) {
+	s.logger.Info("Getting project cost", "projectName", req.ProjectName, "environment", req.Environment,
+		"startTime", req.StartTime, "endTime", req.EndTime)
+
+	if _, err := s.getProject(ctx, req.OrgName, req.ProjectName); err != nil {
+		return nil, err
+	}
+
+	components, err := s.openChoreoClient.ListAgentComponents(ctx, req.OrgName, req.ProjectName)
+	if err != nil {
+		s.logger.Error("Failed to list agent components", "projectName", req.ProjectName, "error", err)
+		return nil, fmt.Errorf("failed to list agent components: %w", err)
+	}
+	agentNames := make(map[string]string, len(components))
+	for _, component := range components {
+		agentNames[component.UUID] = component.Name
+	}
+
+	response := &models.ProjectCostResponse{
+		ProjectName: req.ProjectName,
+		Environment: req.Environment,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Currency:    utils.ModelPriceCurrency,
+		Agents:      []models.AgentCost{},
+	}
+	if len(agentNames) > 0 {
+		agentCosts, err := s.getComponentCosts(ctx, req, agentNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, agentCost := range agentCosts {
+			addCostSummary(&response.CostSummary, agentCost.CostSummary)
+		}
+		roundCostSummary(&response.CostSummary)
+		response.Agents = agentCosts
+	}
+
+	s.logger.Info("Retrieved project cost successfully", "projectName", req.ProjectName,
+		"agentCount", len(response.Agents), "totalCost", response.TotalCost)
+	return response, nil
+}
+
+// getProject returns the project of the organization from the database
+func (s *observabilityManagerService) getProject(ctx context.Context, orgName string, projectName string) (*models.Project, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByName(ctx, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Project not found", "orgName", orgName, "projectName", projectName)
+			return nil, utils.ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to find project %s: %w", projectName, err)
+	}
+	return project, nil
+}
+
+// listRatedTraceIDs returns the IDs of the most recently rated traces of the requested rating, of the agent or of
+// all agents of the project when no agent is requested
+func (s *observabilityManagerService) listRatedTraceIDs(ctx context.Context, req ListTracesRequest, project *models.Project) ([]string, error) {
+	if project == nil {
+		return nil, nil
+	}
+	traceIds, err := s.TraceAnnotationRepository.ListRatedTraceIDs(ctx, project.ID, req.AgentName, req.Filters.Rating, utils.MaxRatedTraceFilter)
+	if err != nil {
+		s.logger.Error("Failed to list rated traces", "projectName", req.ProjectName, "agentName", req.AgentName,
+			"rating", req.Filters.Rating, "error", err)
+		return nil, fmt.Errorf("failed to list rated traces: %w", err)
+	}
+	return traceIds, nil
+}
+
+// addAnnotationSummaries sets the annotation counts of the traces. Traces are still returned, without
+// counts, when the annotations cannot be loaded.
+func (s *observabilityManagerService) addAnnotationSummaries(ctx context.Context, project *models.Project, traces []models.TraceOverview) {
+	if project == nil || len(traces) == 0 {
+		return
+	}
+	traceIds := make([]string, len(traces))
+	for i, trace := range traces {
+		traceIds[i] = trace.TraceID
+	}
+	summaries, err := s.TraceAnnotationRepository.SummarizeTraceAnnotations(ctx, project.ID, traceIds)
+	if err != nil {
+		s.logger.Warn("Failed to load trace annotation counts", "projectId", project.ID, "error", err)
+		return
+	}
+	for i := range traces {
+		traces[i].Annotations = summaries[traces[i].TraceID]
+	}
+}
+
+// getComponentCosts prices the daily token usage of the given components, keyed by component UID with
+// agent names as values. Agents without usage are omitted; the result is sorted by agent name.
+func (s *observabilityManagerService) getComponentCosts(ctx context.Context, req CostRequest, agentNames map[string]string) ([]models.AgentCost, error) {
+	params := traceobserversvc.TokenUsageParams{
+		StartTime: req.StartTime.UTC().Format(time.RFC3339),
+		EndTime:   req.EndTime.UTC().Format(time.RFC3339),
+	}
+	for componentUid := range agentNames {
+		params.ComponentUids = append(params.ComponentUids, componentUid)
+	}
+	sort.Strings(params.ComponentUids)
+	if req.Environment != "" {
+		environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+		if err != nil {
+			s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+			return nil, fmt.Errorf("failed to get environment: %w", err)
+		}
+		params.EnvironmentUid = environment.UUID
+	}
+
+	usage, err := s.traceObserverClient.GetTokenUsage(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to get token usage", "projectName", req.ProjectName, "error", err)
+		return nil, fmt.Errorf("failed to get token usage: %w", err)
+	}
+
+	priceBook := s.loadModelPriceBook(ctx, req.OrgName)
+	type modelKey struct {
+		vendor string
+		model  string
+	}
+	agentCosts := make(map[string]*models.AgentCost)
+	modelCosts := make(map[string]map[modelKey]*models.ModelCost)
+	for _, entry := range usage.Usage {
+		agentName, ok := agentNames[entry.ComponentUid]
+		if !ok {
+			continue
+		}
+		agentCost, ok := agentCosts[agentName]
+		if !ok {
+			agentCost = &models.AgentCost{AgentName: agentName}
+			agentCosts[agentName] = agentCost
+			modelCosts[agentName] = make(map[modelKey]*models.ModelCost)
+		}
+		key := modelKey{vendor: entry.Vendor, model: entry.Model}
+		modelCost, ok := modelCosts[agentName][key]
+		if !ok {
+			modelCost = &models.ModelCost{Vendor: entry.Vendor, Model: entry.Model, Priced: true}
+			modelCosts[agentName][key] = modelCost
+		}
+		if !priceBook.addModelCost(&modelCost.CostSummary, entry) {
+			modelCost.Priced = false
+		}
+	}
+
+	result := make([]models.AgentCost, 0, len(agentCosts))
+	for agentName, agentCost := range agentCosts {
+		for _, modelCost := range modelCosts[agentName] {
+			addCostSummary(&agentCost.CostSummary, modelCost.CostSummary)
+			roundCostSummary(&modelCost.CostSummary)
+			agentCost.Models = append(agentCost.Models, *modelCost)
+		}
+		roundCostSummary(&agentCost.CostSummary)
+		sort.Slice(agentCost.Models, func(i, j int) bool {
+			if agentCost.Models[i].TotalCost != agentCost.Models[j].TotalCost {
+				return agentCost.Models[i].TotalCost > agentCost.Models[j].TotalCost
+			}
+			return agentCost.Models[i].Model < agentCost.Models[j].Model
+		})
+		result = append(result, *agentCost)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].AgentName < result[j].AgentName
+	})
+	return result, nil
+}
+
+// loadModelPriceBook loads the model prices of an organization. Cost estimation is best effort,
+// so a failure is logged and results in a price book without prices.
+func (s *observabilityManagerService) loadModelPriceBook(ctx context.Context, orgName string) *modelPriceBook {
+	org, err := s.OrganizationRepository.GetOrganizationByName(ctx, orgName)
+	if err != nil {
+		s.logger.Warn("Failed to find organization for cost estimation", "orgName", orgName, "error", err)
+		return newModelPriceBook(nil)
+	}
+	prices, err := s.ModelPriceRepository.ListModelPrices(ctx, org.ID)
+	if err != nil {
+		s.logger.Warn("Failed to load model prices for cost estimation", "orgName", orgName, "error", err)
+		return newModelPriceBook(nil)
+	}
+	return newModelPriceBook(prices)
+}
+
+// addCostSummary adds the tokens and costs of one summary to another
+func addCostSummary(total *models.CostSummary, summary models.CostSummary) {
+	total.InputTokens += summary.InputTokens
+	total.OutputTokens += summary.OutputTokens
+	total.TotalTokens += summary.TotalTokens
+	total.InputCost += summary.InputCost
+	total.OutputCost += summary.OutputCost
+	total.TotalCost += summary.TotalCost
+	total.UnpricedTokens += summary.UnpricedTokens
+}
+
+// traceLogWindowPadding widens the time window of a trace when searching for the logs emitted during the trace
+const traceLogWindowPadding = 5 * time.Second
+
+// logTraceIDPattern matches trace IDs written into log lines, such as trace_id=<id> or "traceId": "<id>"
+var logTraceIDPattern = regexp.MustCompile(`(?i)trace[_-]?id["']?\s*[:=]\s*["']?([0-9a-f]{32})`)
+
+// GetAgentLogs retrieves a page of the runtime logs of an agent from the observer service
+func (s *observabilityManagerService) GetAgentLogs(ctx context.Context, req AgentLogsRequest) (*models.AgentLogsResponse, error) {
+	s.logger.Info("Getting agent logs", "agentName", req.AgentName, "environment", req.Environment, "traceId", req.TraceID, "limit", req.Limit)
+
+	cursor, err := decodeLogCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch component to get UID
+	component, err := s.openChoreoClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	params := newComponentLogsParams(req, environment.UUID)
+	if params.EndTime.IsZero() {
+		params.EndTime = time.Now()
+	}
+	if params.StartTime.IsZero() {
+		if req.TraceID != "" {
+			// Jumping from a trace to its logs searches the time window of the trace
+			params.StartTime, params.EndTime, err = s.getTraceWindow(ctx, req, component.UUID, environment.UUID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			params.StartTime = params.EndTime.Add(-utils.DefaultAgentLogsLookback)
+		}
+	}
+
+	agentLogs, nextCursor, hasMore, err := s.fetchAgentLogs(ctx, component.UUID, params, cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	// The cursor moves past the logs of other traces too, so a page of a trace's logs can hold fewer logs than the limit
+	logs := make([]models.LogEntry, 0, len(agentLogs.Logs))
+	for _, logEntry := range agentLogs.Logs {
+		if matchesLogTrace(logEntry, req.TraceID) {
+			logs = append(logs, logEntry)
+		}
+	}
+	agentLogs.Logs = logs
+	if hasMore {
+		agentLogs.NextCursor = nextCursor.encode()
+	}
+
+	s.logger.Info("Retrieved agent logs successfully", "agentName", req.AgentName, "logCount", len(agentLogs.Logs))
+	return agentLogs, nil
+}
+
+func (s *observabilityManagerService) StreamAgentLogs(ctx context.Context, req AgentLogsRequest, emit func(event models.LogStreamEvent) error) error {
+	s.logger.Info("Streaming agent logs", "agentName", req.AgentName, "environment", req.Environment, "traceId", req.TraceID)
+
+	cursor, err := decodeLogCursor(req.Cursor)
+	if err != nil {
+		return err
+	}
+
+	// Fetch component to get UID
+	component, err := s.openChoreoClient.GetAgentComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	environment, err := s.openChoreoClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	params := newComponentLogsParams(req, environment.UUID)
+	if params.StartTime.IsZero() {
+		// Logs written shortly before the stream started may not be searchable yet, so tail from a little earlier
+		params.StartTime = time.Now().Add(-logIngestionDelay)
+	}
+
+	for {
+		// The end of the stream is checked before draining the logs so that no logs written before it are missed
+		finished := !req.EndTime.IsZero() && time.Since(req.EndTime) > logIngestionDelay
+		params.EndTime = time.Now()
+		if !req.EndTime.IsZero() && req.EndTime.Before(params.EndTime) {
+			params.EndTime = req.EndTime
+		}
+		for {
+			agentLogs, nextCursor, hasMore, err := s.fetchAgentLogs(ctx, component.UUID, params, cursor, logStreamBatchSize)
+			if err != nil {
+				return err
+			}
+			eventCursor := cursor
+			for i := range agentLogs.Logs {
+				eventCursor = eventCursor.advance(agentLogs.Logs[i].Timestamp)
+				if !matchesLogTrace(agentLogs.Logs[i], req.TraceID) {
+					continue
+				}
+				if err := emit(models.LogStreamEvent{Type: utils.LogStreamEventLog, Cursor: eventCursor.encode(), Log: &agentLogs.Logs[i]}); err != nil {
+					return err
+				}
+			}
+			cursor = nextCursor
+			if !hasMore {
+				break
+			}
+		}
+
+		if finished {
+			s.logger.Info("Agent log stream completed", "agentName", req.AgentName, "environment", req.Environment)
+			return emit(models.LogStreamEvent{Type: utils.LogStreamEventComplete, Cursor: cursor.encode()})
+		}
+		if err := emit(models.LogStreamEvent{Type: utils.LogStreamEventHeartbeat}); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(logStreamPollInterval):
+		}
+	}
+}
+
+// newComponentLogsParams converts an agent log request to client params, leaving the limit unset
+func newComponentLogsParams(req AgentLogsRequest, environmentUid string) observabilitysvc.ComponentLogsParams {
+	return observabilitysvc.ComponentLogsParams{
+		EnvironmentUid: environmentUid,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		LogLevels:      req.LogLevels,
+		SearchPhrase:   req.Search,
+	}
+}
+
+// fetchAgentLogs returns up to limit runtime logs of a component after the cursor, the cursor following the
+// returned logs, and whether more logs are available
+func (s *observabilityManagerService) fetchAgentLogs(ctx context.Context, componentUid string, params observabilitysvc.ComponentLogsParams, cursor *logCursor, limit int) (*models.AgentLogsResponse, *logCursor, bool, error) {
+	params.Limit = logFetchLimit(cursor, limit)
+	if cursor != nil {
+		// Logs at the cursor's timestamp that were already returned are fetched again and skipped
+		params.StartTime = cursor.Timestamp
+	}
+	agentLogs, err := s.observabilitySvcClient.GetComponentLogs(ctx, componentUid, params)
+	if err != nil {
+		s.logger.Error("Failed to fetch agent logs from observability service", "componentUid", componentUid, "error", err)
+		return nil, nil, false, fmt.Errorf("failed to fetch agent logs: %w", err)
+	}
+
+	logs, nextCursor, hasMore := pageLogs(agentLogs.Logs, cursor, limit)
+	agentLogs.Logs = logs
+	return agentLogs, nextCursor, hasMore, nil
+}
+
+// getTraceWindow returns the time window of the spans of a trace of the agent, widened by traceLogWindowPadding
+func (s *observabilityManagerService) getTraceWindow(ctx context.Context, req AgentLogsRequest, componentUid string, environmentUid string) (time.Time, time.Time, error) {
+	clientResponse, err := s.traceObserverClient.TraceDetailsById(ctx, traceobserversvc.TraceDetailsByIdParams{
+		TraceID:        req.TraceID,
+		ServiceName:    req.AgentName,
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		OrgName:        req.OrgName,
+	})
+	if err != nil {
+		if traceobserversvc.IsNotFound(err) {
+			s.logger.Warn("Trace not found", "traceId", req.TraceID, "agentName", req.AgentName)
+			return time.Time{}, time.Time{}, ErrTraceNotFound
+		}
+		s.logger.Error("Failed to get trace details", "traceId", req.TraceID, "agentName", req.AgentName, "error", err)
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to get trace details: %w", err)
+	}
+	if len(clientResponse.Spans) == 0 {
+		return time.Time{}, time.Time{}, ErrTraceNotFound
+	}
+
+	start, end := clientResponse.Spans[0].StartTime, clientResponse.Spans[0].EndTime
+	for _, span := range clientResponse.Spans {
+		if span.StartTime.Before(start) {
+			start = span.StartTime
+		}
+		if span.EndTime.After(end) {
+			end = span.EndTime
+		}
+	}
+	if end.Before(start) {
+		end = start
+	}
+	return start.Add(-traceLogWindowPadding), end.Add(traceLogWindowPadding), nil
+}
+
+// matchesLogTrace reports whether a log belongs to the trace. Logs that carry no trace ID, in their labels or
+// written into the log line, are kept since they cannot be told apart.
+func matchesLogTrace(logEntry models.LogEntry, traceID string) bool {
+	if traceID == "" {
+		return true
+	}
+	logTraceID := logEntry.Labels["trace_id"]
+	if logTraceID == "" {
+		logTraceID = logEntry.Labels["traceId"]
+	}
+	if logTraceID == "" {
+		if match := logTraceIDPattern.FindStringSubmatch(logEntry.Log); match != nil {
+			logTraceID = match[1]
+		}
+	}
+	return logTraceID == "" || strings.EqualFold(logTraceID, traceID)
+}
+
+// parseTraceTime parses a trace timestamp reported by the trace observer, returning the zero time if it is invalid
+func parseTraceTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
@@ -0,0 +1,365 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type TraceAnnotationManager interface {
+	CreateTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, traceId string, req *models.TraceAnnotationRequest) (*models.TraceAnnotationResponse, error)
+	ListTraceAnnotations(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, traceId string) (*models.TraceAnnotationListResponse, error)
+	DeleteTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, traceId string, annotationId uuid.UUID) error
+	// CreateAgentFeedbackKey issues the key that the agent presents to report end-user feedback, replacing its previous key
+	CreateAgentFeedbackKey(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) (*models.AgentFeedbackKeyResponse, error)
+	// RecordTraceFeedback stores the end-user feedback that a deployed agent reports for one of its traces,
+	// after checking that feedbackKey is the key issued to that agent
+	RecordTraceFeedback(ctx context.Context, orgName string, projectName string, agentName string, feedbackKey string, req *models.TraceFeedbackRequest) (*models.TraceAnnotationResponse, error)
+}
+
+type traceAnnotationManager struct {
+	OrganizationRepository     repositories.OrganizationRepository
+	ProjectRepository          repositories.ProjectRepository
+	TraceAnnotationRepository  repositories.TraceAnnotationRepository
+	AgentFeedbackKeyRepository repositories.AgentFeedbackKeyRepository
+	openChoreoClient           openchoreosvc.OpenChoreoSvcClient
+	logger                     *slog.Logger
+}
+
+func NewTraceAnnotationManager(
+	orgRepo repositories.OrganizationRepository,
+	projectRepo repositories.ProjectRepository,
+	traceAnnotationRepo repositories.TraceAnnotationRepository,
+	agentFeedbackKeyRepo repositories.AgentFeedbackKeyRepository,
+	openChoreoClient openchoreosvc.OpenChoreoSvcClient,
+	logger *slog.Logger,
+) TraceAnnotationManager {
+	return &traceAnnotationManager{
+		OrganizationRepository:     orgRepo,
+		ProjectRepository:          projectRepo,
+		TraceAnnotationRepository:  traceAnnotationRepo,
+		AgentFeedbackKeyRepository: agentFeedbackKeyRepo,
+		openChoreoClient:           openChoreoClient,
+		logger:                     logger,
+	}
+}
+
+func (s *traceAnnotationManager) CreateTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, traceId string, req *models.TraceAnnotationRequest) (*models.TraceAnnotationResponse, error) {
+	s.logger.Debug("CreateTraceAnnotation called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName,
+		"agentName", agentName, "traceId", traceId)
+
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAgent(ctx, orgName, projectName, agentName); err != nil {
+		return nil, err
+	}
+	annotation := newTraceAnnotation(traceId, req, time.Now())
+	annotation.Source = utils.TraceAnnotationSourceUser
+	annotation.CreatedBy = &userIdpId
+	return s.createTraceAnnotation(ctx, project, agentName, annotation)
+}
+
+func (s *traceAnnotationManager) CreateAgentFeedbackKey(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string) (*models.AgentFeedbackKeyResponse, error) {
+	s.logger.Debug("CreateAgentFeedbackKey called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName,
+		"agentName", agentName)
+
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAgent(ctx, orgName, projectName, agentName); err != nil {
+		return nil, err
+	}
+
+	key, err := generateAgentFeedbackKey()
+	if err != nil {
+		s.logger.Error("Failed to generate agent feedback key", "agentName", agentName, "error", err)
+		return nil, fmt.Errorf("failed to generate agent feedback key: %w", err)
+	}
+	feedbackKey := &models.AgentFeedbackKey{
+		ID:        uuid.New(),
+		OrgID:     project.OrgID,
+		ProjectID: project.ID,
+		AgentName: agentName,
+		KeyHash:   hashAgentFeedbackKey(key),
+		CreatedBy: userIdpId,
+		CreatedAt: time.Now(),
+	}
+	if err := s.AgentFeedbackKeyRepository.UpsertAgentFeedbackKey(ctx, feedbackKey); err != nil {
+		s.logger.Error("Failed to store agent feedback key", "agentName", agentName, "error", err)
+		return nil, fmt.Errorf("failed to store agent feedback key: %w", err)
+	}
+	s.logger.Info("Issued agent feedback key successfully", "projectName", projectName, "agentName", agentName)
+	return &models.AgentFeedbackKeyResponse{
+		AgentName: agentName,
+		Key:       key,
+		CreatedAt: feedbackKey.CreatedAt,
+	}, nil
+}
+
+func (s *traceAnnotationManager) RecordTraceFeedback(ctx context.Context, orgName string, projectName string, agentName string, feedbackKey string, req *models.TraceFeedbackRequest) (*models.TraceAnnotationResponse, error) {
+	s.logger.Debug("RecordTraceFeedback called", "orgName", orgName, "projectName", projectName,
+		"agentName", agentName, "traceId", req.TraceID)
+
+	project, err := s.verifyAgentFeedbackKey(ctx, orgName, projectName, agentName, feedbackKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAgent(ctx, orgName, projectName, agentName); err != nil {
+		return nil, err
+	}
+	annotation := newTraceAnnotation(strings.TrimSpace(req.TraceID), &req.TraceAnnotationRequest, time.Now())
+	annotation.Source = utils.TraceAnnotationSourceEndUser
+	annotation.EndUserID = req.EndUserID
+	return s.createTraceAnnotation(ctx, project, agentName, annotation)
+}
+
+func (s *traceAnnotationManager) ListTraceAnnotations(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, traceId string) (*models.TraceAnnotationListResponse, error) {
+	s.logger.Debug("ListTraceAnnotations called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName,
+		"agentName", agentName, "traceId", traceId)
+
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return nil, err
+	}
+	annotations, err := s.TraceAnnotationRepository.ListTraceAnnotations(ctx, project.ID, agentName, traceId)
+	if err != nil {
+		s.logger.Error("Failed to list trace annotations", "projectName", projectName, "agentName", agentName, "traceId", traceId, "error", err)
+		return nil, fmt.Errorf("failed to list annotations of trace %s: %w", traceId, err)
+	}
+
+	response := &models.TraceAnnotationListResponse{
+		Annotations: make([]models.TraceAnnotationResponse, 0, len(annotations)),
+	}
+	var scoreSum float64
+	var scoreCount int
+	for _, annotation := range annotations {
+		response.Annotations = append(response.Annotations, *toTraceAnnotationResponse(annotation))
+		response.Summary.Count++
+		if annotation.Rating != nil {
+			switch *annotation.Rating {
+			case utils.TraceRatingPositive:
+				response.Summary.PositiveCount++
+			case utils.TraceRatingNegative:
+				response.Summary.NegativeCount++
+			}
+		}
+		if annotation.Score != nil {
+			scoreSum += *annotation.Score
+			scoreCount++
+		}
+	}
+	if scoreCount > 0 {
+		averageScore := scoreSum / float64(scoreCount)
+		response.Summary.AverageScore = &averageScore
+	}
+	return response, nil
+}
+
+func (s *traceAnnotationManager) DeleteTraceAnnotation(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string, agentName string, traceId string, annotationId uuid.UUID) error {
+	s.logger.Debug("DeleteTraceAnnotation called", "userIdpId", userIdpId, "orgName", orgName, "projectName", projectName,
+		"agentName", agentName, "traceId", traceId, "annotationId", annotationId)
+
+	project, err := s.getProject(ctx, userIdpId, orgName, projectName)
+	if err != nil {
+		return err
+	}
+	annotation, err := s.TraceAnnotationRepository.GetTraceAnnotation(ctx, project.ID, agentName, traceId, annotationId)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Trace annotation not found", "traceId", traceId, "annotationId", annotationId)
+			return utils.ErrTraceAnnotationNotFound
+		}
+		s.logger.Error("Failed to get trace annotation from repository", "traceId", traceId, "annotationId", annotationId, "error", err)
+		return fmt.Errorf("failed to find trace annotation %s: %w", annotationId, err)
+	}
+	if err := s.TraceAnnotationRepository.DeleteTraceAnnotation(ctx, project.ID, annotation.ID); err != nil {
+		s.logger.Error("Failed to delete trace annotation", "traceId", traceId, "annotationId", annotationId, "error", err)
+		return fmt.Errorf("failed to delete trace annotation %s: %w", annotationId, err)
+	}
+	s.logger.Info("Deleted trace annotation successfully", "traceId", traceId, "annotationId", annotationId)
+	return nil
+}
+
+// createTraceAnnotation stores an annotation on a trace of an agent of the project
+func (s *traceAnnotationManager) createTraceAnnotation(ctx context.Context, project *models.Project, agentName string, annotation *models.TraceAnnotation) (*models.TraceAnnotationResponse, error) {
+	annotation.OrgID = project.OrgID
+	annotation.ProjectID = project.ID
+	annotation.AgentName = agentName
+	if err := s.TraceAnnotationRepository.CreateTraceAnnotation(ctx, annotation); err != nil {
+		s.logger.Error("Failed to create trace annotation", "agentName", agentName, "traceId", annotation.TraceID, "error", err)
+		return nil, fmt.Errorf("failed to create trace annotation: %w", err)
+	}
+	s.logger.Info("Created trace annotation successfully", "agentName", agentName, "traceId", annotation.TraceID,
+		"annotationId", annotation.ID, "source", annotation.Source)
+	return toTraceAnnotationResponse(annotation), nil
+}
+
+// checkAgent checks that the agent exists in OpenChoreo
+func (s *traceAnnotationManager) checkAgent(ctx context.Context, orgName string, projectName string, agentName string) error {
+	if _, err := s.openChoreoClient.GetAgentComponent(ctx, orgName, projectName, agentName); err != nil {
+		if errors.Is(err, utils.ErrAgentNotFound) {
+			s.logger.Debug("Agent not found", "orgName", orgName, "projectName", projectName, "agentName", agentName)
+			return utils.ErrAgentNotFound
+		}
+		s.logger.Error("Failed to get agent component", "agentName", agentName, "error", err)
+		return fmt.Errorf("failed to get agent component: %w", err)
+	}
+	return nil
+}
+
+// verifyAgentFeedbackKey returns the agent's project when feedbackKey is the key issued to the agent. Unknown
+// organizations, projects and agents are reported as an invalid key, so that callers cannot probe for them.
+func (s *traceAnnotationManager) verifyAgentFeedbackKey(ctx context.Context, orgName string, projectName string, agentName string, feedbackKey string) (*models.Project, error) {
+	if feedbackKey == "" {
+		return nil, utils.ErrInvalidAgentFeedbackKey
+	}
+	org, err := s.OrganizationRepository.GetOrganizationByName(ctx, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrInvalidAgentFeedbackKey
+		}
+		return nil, s.organizationError(err, orgName)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			return nil, utils.ErrInvalidAgentFeedbackKey
+		}
+		return nil, s.projectError(err, projectName)
+	}
+	storedKey, err := s.AgentFeedbackKeyRepository.GetAgentFeedbackKey(ctx, project.ID, agentName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Warn("Feedback reported for an agent without a feedback key", "orgName", orgName,
+				"projectName", projectName, "agentName", agentName)
+			return nil, utils.ErrInvalidAgentFeedbackKey
+		}
+		s.logger.Error("Failed to get agent feedback key", "agentName", agentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent feedback key: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashAgentFeedbackKey(feedbackKey)), []byte(storedKey.KeyHash)) != 1 {
+		s.logger.Warn("Invalid agent feedback key", "orgName", orgName, "projectName", projectName, "agentName", agentName)
+		return nil, utils.ErrInvalidAgentFeedbackKey
+	}
+	return project, nil
+}
+
+func (s *traceAnnotationManager) getProject(ctx context.Context, userIdpId uuid.UUID, orgName string, projectName string) (*models.Project, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, s.organizationError(err, orgName)
+	}
+	project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, projectName)
+	if err != nil {
+		return nil, s.projectError(err, projectName)
+	}
+	return project, nil
+}
+
+func (s *traceAnnotationManager) organizationError(err error, orgName string) error {
+	if db.IsRecordNotFoundError(err) {
+		s.logger.Debug("Organization not found", "orgName", orgName)
+		return utils.ErrOrganizationNotFound
+	}
+	s.logger.Error("Failed to get organization from repository", "orgName", orgName, "error", err)
+	return fmt.Errorf("failed to find organization %s: %w", orgName, err)
+}
+
+func (s *traceAnnotationManager) projectError(err error, projectName string) error {
+	if db.IsRecordNotFoundError(err) {
+		s.logger.Debug("Project not found", "projectName", projectName)
+		return utils.ErrProjectNotFound
+	}
+	s.logger.Error("Failed to get project from repository", "projectName", projectName, "error", err)
+	return fmt.Errorf("failed to find project %s: %w", projectName, err)
+}
+
+// generateAgentFeedbackKey returns a new random agent feedback key
+func generateAgentFeedbackKey() (string, error) {
+	key := make([]byte, utils.AgentFeedbackKeyBytes)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return utils.AgentFeedbackKeyPrefix + base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+// hashAgentFeedbackKey returns the hex-encoded SHA-256 hash under which a feedback key is stored
+func hashAgentFeedbackKey(key string) string {
+	digest := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(digest[:])
+}
+
+// newTraceAnnotation creates an annotation on a trace from the request, leaving its owner and source unset
+func newTraceAnnotation(traceId string, req *models.TraceAnnotationRequest, createdAt time.Time) *models.TraceAnnotation {
+	tags := make([]string, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		tags = append(tags, strings.TrimSpace(tag))
+	}
+	return &models.TraceAnnotation{
+		ID:        uuid.New(),
+		TraceID:   traceId,
+		SpanID:    strings.TrimSpace(req.SpanID),
+		Rating:    req.Rating,
+		Score:     req.Score,
+		Note:      strings.TrimSpace(req.Note),
+		Tags:      tags,
+		CreatedAt: createdAt,
+	}
+}
+
+func toTraceAnnotationResponse(annotation *models.TraceAnnotation) *models.TraceAnnotationResponse {
+	response := &models.TraceAnnotationResponse{
+		ID:        annotation.ID.String(),
+		TraceID:   annotation.TraceID,
+		SpanID:    annotation.SpanID,
+		Rating:    annotation.Rating,
+		Score:     annotation.Score,
+		Note:      annotation.Note,
+		Tags:      annotation.Tags,
+		Source:    annotation.Source,
+		EndUserID: annotation.EndUserID,
+		CreatedAt: annotation.CreatedAt,
+	}
+	if response.Tags == nil {
+		response.Tags = []string{}
+	}
+	if annotation.CreatedBy != nil {
+		response.CreatedBy = annotation.CreatedBy.String()
+	}
+	return response
+}
@@ -0,0 +1,527 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// webhookDeliveryBatchSize is the maximum number of deliveries attempted concurrently per delivery run
+	webhookDeliveryBatchSize = 20
+	// webhookMaxResponseBodyBytes limits how much of a subscriber's response is read
+	webhookMaxResponseBodyBytes = 64 * 1024
+	// webhookMaxErrorLength limits the length of the error recorded for a failed attempt
+	webhookMaxErrorLength = 1024
+)
+
+// Phases recorded for builds and deployments. Status changes within the same phase do not produce events.
+const (
+	webhookPhaseBuildPending   = "pending"
+	webhookPhaseBuildRunning   = "running"
+	webhookPhaseBuildSucceeded = "succeeded"
+	webhookPhaseBuildFailed    = "failed"
+)
+
+// WebhookDispatcher turns build and deployment status transitions into webhook deliveries and delivers them.
+type WebhookDispatcher interface {
+	// Run polls for status changes and delivers pending events until the context is cancelled
+	Run(ctx context.Context)
+	// PollStatusChanges queues a delivery for every subscribed webhook when a build or deployment changes phase
+	PollStatusChanges(ctx context.Context) error
+	// DeliverPendingEvents attempts the deliveries that are due, scheduling retries for failed attempts
+	DeliverPendingEvents(ctx context.Context) error
+}
+
+type webhookDispatcher struct {
+	OpenChoreoSvcClient clients.OpenChoreoSvcClient
+	WebhookRepository   repositories.WebhookRepository
+	Encryptor           secrets.Encryptor
+	httpClient          *http.Client
+	logger              *slog.Logger
+
+	// observed holds, per organization, the resource version of every build and deployment seen by the last
+	// poll, so that unchanged resources are skipped and deleted ones are pruned from the recorded phases
+	observedMu sync.Mutex
+	observed   map[string]map[string]string
+}
+
+func NewWebhookDispatcher(
+	openChoreoSvcClient clients.OpenChoreoSvcClient,
+	webhookRepo repositories.WebhookRepository,
+	encryptor secrets.Encryptor,
+	logger *slog.Logger,
+) WebhookDispatcher {
+	return &webhookDispatcher{
+		OpenChoreoSvcClient: openChoreoSvcClient,
+		WebhookRepository:   webhookRepo,
+		Encryptor:           encryptor,
+		httpClient: &http.Client{
+			Timeout:   time.Duration(config.GetConfig().Webhooks.DeliveryTimeoutSeconds) * time.Second,
+			Transport: newWebhookTransport(),
+		},
+		logger:   logger,
+		observed: make(map[string]map[string]string),
+	}
+}
+
+func (s *webhookDispatcher) Run(ctx context.Context) {
+	cfg := config.GetConfig().Webhooks
+	pollTicker := time.NewTicker(time.Duration(cfg.PollIntervalSeconds) * time.Second)
+	defer pollTicker.Stop()
+	deliveryTicker := time.NewTicker(time.Duration(cfg.DeliveryIntervalSeconds) * time.Second)
+	defer deliveryTicker.Stop()
+
+	s.logger.Info("Webhook dispatcher started", "pollIntervalSeconds", cfg.PollIntervalSeconds, "deliveryIntervalSeconds", cfg.DeliveryIntervalSeconds)
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Webhook dispatcher stopped")
+			return
+		case <-pollTicker.C:
+			if err := s.PollStatusChanges(ctx); err != nil {
+				s.logger.Error("Failed to poll build and deployment status changes", "error", err)
+			}
+		case <-deliveryTicker.C:
+			if err := s.DeliverPendingEvents(ctx); err != nil {
+				s.logger.Error("Failed to deliver webhook events", "error", err)
+			}
+		}
+	}
+}
+
+func (s *webhookDispatcher) PollStatusChanges(ctx context.Context) error {
+	orgs, err := s.WebhookRepository.ListOrganizationsWithWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list organizations with webhooks: %w", err)
+	}
+	for i := range orgs {
+		// A failure in one organization should not block events of the others
+		if err := s.pollOrganization(ctx, &orgs[i]); err != nil {
+			s.logger.Error("Failed to poll status changes of organization", "orgName", orgs[i].OrgName, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *webhookDispatcher) pollOrganization(ctx context.Context, org *models.Organization) error {
+	webhooks, err := s.WebhookRepository.ListWebhooks(ctx, org.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	previous, polledBefore := s.observedResources(org.OrgName)
+	current := make(map[string]string, len(previous))
+
+	builds, err := s.OpenChoreoSvcClient.ListOrgComponentWorkflows(ctx, org.OrgName)
+	if err != nil {
+		return fmt.Errorf("failed to list builds: %w", err)
+	}
+	for _, build := range builds {
+		resourceKey := buildEventStateKey(org.OrgName, build.UUID)
+		current[resourceKey] = build.ResourceVersion
+		if isUnchangedResource(previous, resourceKey, build.ResourceVersion) {
+			continue
+		}
+		phase, eventType, occurredAt := buildTransition(build)
+		payload := &models.WebhookEventPayload{
+			Type:        eventType,
+			OccurredAt:  occurredAt,
+			OrgName:     org.OrgName,
+			ProjectName: build.ProjectName,
+			AgentName:   build.AgentName,
+			Build: &models.WebhookBuildEvent{
+				Name:      build.Name,
+				Status:    build.Status,
+				CommitID:  build.CommitID,
+				Image:     build.Image,
+				StartedAt: build.StartedAt,
+			},
+		}
+		if build.EndedAt != nil && !build.EndedAt.IsZero() {
+			payload.Build.EndedAt = build.EndedAt
+		}
+		if err := s.recordTransition(ctx, webhooks, resourceKey, phase, payload); err != nil {
+			return err
+		}
+	}
+
+	deployments, err := s.OpenChoreoSvcClient.ListOrgDeploymentStatuses(ctx, org.OrgName)
+	if err != nil {
+		return fmt.Errorf("failed to list deployment statuses: %w", err)
+	}
+	for _, deployment := range deployments {
+		resourceKey := deploymentEventStateKey(org.OrgName, deployment.UID)
+		current[resourceKey] = deployment.ResourceVersion
+		if isUnchangedResource(previous, resourceKey, deployment.ResourceVersion) {
+			continue
+		}
+		payload := &models.WebhookEventPayload{
+			Type:        deploymentEventType(deployment.Status),
+			OccurredAt:  deployment.UpdatedAt,
+			OrgName:     org.OrgName,
+			ProjectName: deployment.ProjectName,
+			AgentName:   deployment.AgentName,
+			Deployment: &models.WebhookDeploymentEvent{
+				Environment: deployment.Environment,
+				Status:      deployment.Status,
+			},
+		}
+		// The generation is part of the phase so that redeployments produce new events
+		phase := fmt.Sprintf("%d/%s", deployment.Generation, deployment.Status)
+		if err := s.recordTransition(ctx, webhooks, resourceKey, phase, payload); err != nil {
+			return err
+		}
+	}
+
+	if err := s.pruneEventStates(ctx, org.OrgName, previous, polledBefore, current); err != nil {
+		return err
+	}
+	s.setObservedResources(org.OrgName, current)
+	return nil
+}
+
+// pruneEventStates deletes the recorded phases of the builds and deployments of the organization that no longer
+// exist. On the first poll the recorded keys are read from the database, as resources may have been deleted while
+// the dispatcher was not running; afterwards the resources seen by the previous poll are compared.
+func (s *webhookDispatcher) pruneEventStates(ctx context.Context, orgName string, previous map[string]string, polledBefore bool, current map[string]string) error {
+	var known []string
+	if polledBefore {
+		known = slices.Collect(maps.Keys(previous))
+	} else {
+		for _, keyPrefix := range []string{buildEventStateKey(orgName, ""), deploymentEventStateKey(orgName, "")} {
+			keys, err := s.WebhookRepository.ListEventStateKeys(ctx, keyPrefix)
+			if err != nil {
+				return fmt.Errorf("failed to list recorded resources: %w", err)
+			}
+			known = append(known, keys...)
+		}
+	}
+
+	var stale []string
+	for _, resourceKey := range known {
+		if _, ok := current[resourceKey]; !ok {
+			stale = append(stale, resourceKey)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	if err := s.WebhookRepository.DeleteEventStates(ctx, stale); err != nil {
+		return fmt.Errorf("failed to prune recorded resources: %w", err)
+	}
+	s.logger.Debug("Pruned recorded phases of deleted resources", "orgName", orgName, "count", len(stale))
+	return nil
+}
+
+func (s *webhookDispatcher) observedResources(orgName string) (map[string]string, bool) {
+	s.observedMu.Lock()
+	defer s.observedMu.Unlock()
+	resources, ok := s.observed[orgName]
+	return resources, ok
+}
+
+func (s *webhookDispatcher) setObservedResources(orgName string, resources map[string]string) {
+	s.observedMu.Lock()
+	defer s.observedMu.Unlock()
+	s.observed[orgName] = resources
+}
+
+// isUnchangedResource reports whether a resource has the same version as at the previous poll.
+// Resources without a version are always checked.
+func isUnchangedResource(previous map[string]string, resourceKey string, resourceVersion string) bool {
+	return resourceVersion != "" && previous[resourceKey] == resourceVersion
+}
+
+func buildEventStateKey(orgName string, buildUid string) string {
+	return fmt.Sprintf("build/%s/%s", orgName, buildUid)
+}
+
+func deploymentEventStateKey(orgName string, bindingUid string) string {
+	return fmt.Sprintf("deployment/%s/%s", orgName, bindingUid)
+}
+
+// recordTransition stores the phase of a resource and, when it changed into a phase with an event type,
+// queues the event for every matching webhook. Resources seen for the first time only produce an event when
+// the change happened recently, so that enabling webhooks does not replay the history of the organization.
+func (s *webhookDispatcher) recordTransition(ctx context.Context, webhooks []*models.Webhook, resourceKey string, phase string, payload *models.WebhookEventPayload) error {
+	recentSince := time.Now().Add(-2 * time.Duration(config.GetConfig().Webhooks.PollIntervalSeconds) * time.Second)
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := db.CtxWithTx(ctx, tx)
+		changed, firstSeen, err := s.WebhookRepository.RecordEventState(txCtx, resourceKey, phase)
+		if err != nil {
+			return err
+		}
+		if !changed || payload.Type == "" || (firstSeen && payload.OccurredAt.Before(recentSince)) {
+			return nil
+		}
+
+		eventId := uuid.New()
+		payload.ID = eventId.String()
+		now := time.Now()
+		var deliveries []*models.WebhookDelivery
+		for _, webhook := range webhooks {
+			if !webhookMatches(webhook, payload) {
+				continue
+			}
+			deliveries = append(deliveries, &models.WebhookDelivery{
+				ID:            uuid.New(),
+				WebhookID:     webhook.ID,
+				EventID:       eventId,
+				EventType:     payload.Type,
+				Payload:       payload,
+				Status:        utils.WebhookDeliveryStatusPending,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			})
+		}
+		if len(deliveries) > 0 {
+			s.logger.Info("Queued webhook event", "eventType", payload.Type, "resource", resourceKey, "deliveries", len(deliveries))
+		}
+		return s.WebhookRepository.CreateWebhookDeliveries(txCtx, deliveries)
+	})
+}
+
+func (s *webhookDispatcher) DeliverPendingEvents(ctx context.Context) error {
+	cfg := config.GetConfig().Webhooks
+	now := time.Now()
+	// The lease outlasts the delivery timeout so that a claimed delivery is retried only if this worker stops
+	leaseUntil := now.Add(2 * time.Duration(cfg.DeliveryTimeoutSeconds) * time.Second)
+	deliveries, err := s.WebhookRepository.ClaimDueWebhookDeliveries(ctx, now, leaseUntil, webhookDeliveryBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *models.WebhookDelivery) {
+			defer wg.Done()
+			if err := s.deliver(ctx, delivery); err != nil {
+				s.logger.Error("Failed to deliver webhook event", "deliveryId", delivery.ID, "webhookId", delivery.WebhookID, "error", err)
+			}
+		}(delivery)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (s *webhookDispatcher) deliver(ctx context.Context, delivery *models.WebhookDelivery) error {
+	webhook, err := s.WebhookRepository.GetWebhookByID(ctx, delivery.WebhookID)
+	if err != nil {
+		// A webhook deleted after the delivery was claimed can never be delivered to
+		final := db.IsRecordNotFoundError(err)
+		return s.recordFailedSetup(ctx, delivery, fmt.Errorf("failed to find webhook: %w", err), final)
+	}
+	secret, err := s.Encryptor.Decrypt(&secrets.EncryptedValue{
+		KeyID:            webhook.KeyID,
+		EncryptedDataKey: webhook.EncryptedDataKey,
+		Ciphertext:       webhook.SecretCiphertext,
+	})
+	if err != nil {
+		return s.recordFailedSetup(ctx, delivery, fmt.Errorf("failed to decrypt webhook secret: %w", err), false)
+	}
+	body, err := json.Marshal(delivery.Payload)
+	if err != nil {
+		return s.recordFailedSetup(ctx, delivery, fmt.Errorf("failed to marshal webhook payload: %w", err), true)
+	}
+
+	statusCode, sendErr := s.send(ctx, webhook.URL, secret, delivery, body)
+	if err := s.recordAttempt(ctx, delivery, statusCode, sendErr, false); err != nil {
+		return err
+	}
+	s.logger.Debug("Attempted webhook delivery", "deliveryId", delivery.ID, "webhookId", webhook.ID,
+		"attempts", delivery.Attempts, "status", delivery.Status, "statusCode", statusCode)
+	return nil
+}
+
+// recordFailedSetup records an attempt that failed before the request could be sent, so that the delivery
+// is retried or failed instead of staying pending, and returns the failure
+func (s *webhookDispatcher) recordFailedSetup(ctx context.Context, delivery *models.WebhookDelivery, attemptErr error, final bool) error {
+	if err := s.recordAttempt(ctx, delivery, 0, attemptErr, final); err != nil {
+		return fmt.Errorf("%w; %w", attemptErr, err)
+	}
+	return attemptErr
+}
+
+// recordAttempt stores the outcome of a delivery attempt. A failed attempt is retried with backoff until the
+// maximum number of attempts is reached, unless it is final.
+func (s *webhookDispatcher) recordAttempt(ctx context.Context, delivery *models.WebhookDelivery, statusCode int, attemptErr error, final bool) error {
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = nil
+	delivery.LastError = nil
+	if statusCode != 0 {
+		delivery.LastStatusCode = &statusCode
+	}
+	if attemptErr == nil {
+		delivery.Status = utils.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+	} else {
+		lastError := attemptErr.Error()
+		if len(lastError) > webhookMaxErrorLength {
+			lastError = lastError[:webhookMaxErrorLength]
+		}
+		delivery.LastError = &lastError
+		cfg := config.GetConfig().Webhooks
+		if final || delivery.Attempts >= cfg.MaxDeliveryAttempts {
+			delivery.Status = utils.WebhookDeliveryStatusFailed
+		} else {
+			delivery.NextAttemptAt = now.Add(webhookRetryDelay(delivery.Attempts, cfg))
+		}
+	}
+	if err := s.WebhookRepository.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// send posts the signed payload and returns the response status code, which is 0 when no response was received
+func (s *webhookDispatcher) send(ctx context.Context, url string, secret string, delivery *models.WebhookDelivery, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(utils.WebhookHeaderEvent, delivery.EventType)
+	req.Header.Set(utils.WebhookHeaderDelivery, delivery.ID.String())
+	req.Header.Set(utils.WebhookHeaderTimestamp, timestamp)
+	req.Header.Set(utils.WebhookHeaderSignature, "sha256="+signWebhookPayload(secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookMaxResponseBodyBytes))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("received unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// newWebhookTransport returns a transport that only connects to public addresses. The address is checked
+// after DNS resolution, for every connection including redirects, so that a webhook host cannot be pointed
+// at internal services after the webhook was created. Proxies are not used, as they would hide the address.
+func newWebhookTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			if config.GetConfig().Webhooks.AllowPrivateNetworks {
+				return nil
+			}
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("%w: %w", utils.ErrWebhookURLNotAllowed, err)
+			}
+			if !utils.IsPublicWebhookAddress(addrPort.Addr()) {
+				return fmt.Errorf("%w: refusing to connect to %s", utils.ErrWebhookURLNotAllowed, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// signWebhookPayload computes the hex encoded HMAC-SHA256 of "<timestamp>.<body>" with the webhook secret.
+// Including the timestamp lets subscribers reject replayed requests.
+func signWebhookPayload(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookRetryDelay doubles the delay after every failed attempt, up to the configured maximum
+func webhookRetryDelay(attempts int, cfg config.WebhooksConfig) time.Duration {
+	delay := time.Duration(cfg.RetryBaseDelaySeconds) * time.Second
+	maxDelay := time.Duration(cfg.RetryMaxDelaySeconds) * time.Second
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+func webhookMatches(webhook *models.Webhook, payload *models.WebhookEventPayload) bool {
+	if !webhook.Enabled || !slices.Contains(webhook.EventTypes, payload.Type) {
+		return false
+	}
+	return webhook.ProjectName == "" || webhook.ProjectName == payload.ProjectName
+}
+
+// buildTransition maps a build status to its phase, the event type emitted on entering the phase and the time it was entered
+func buildTransition(build *models.BuildResponse) (string, string, time.Time) {
+	endedAt := time.Now()
+	if build.EndedAt != nil && !build.EndedAt.IsZero() {
+		endedAt = *build.EndedAt
+	}
+	switch clients.BuildStatus(build.Status) {
+	case clients.BuildStatusRunning:
+		return webhookPhaseBuildRunning, utils.WebhookEventBuildStarted, build.StartedAt
+	case clients.BuildStatusCompleted, clients.BuildStatusSucceeded, clients.WorkloadUpdated:
+		return webhookPhaseBuildSucceeded, utils.WebhookEventBuildSucceeded, endedAt
+	case clients.BuildStatusFailed:
+		return webhookPhaseBuildFailed, utils.WebhookEventBuildFailed, endedAt
+	default:
+		return webhookPhaseBuildPending, "", build.StartedAt
+	}
+}
+
+func deploymentEventType(status string) string {
+	switch status {
+	case clients.DeploymentStatusActive:
+		return utils.WebhookEventDeploymentReady
+	case clients.DeploymentStatusFailed:
+		return utils.WebhookEventDeploymentFailed
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type WebhookManager interface {
+	CreateWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, req *spec.CreateWebhookRequest) (*models.WebhookResponse, error)
+	ListWebhooks(ctx context.Context, userIdpId uuid.UUID, orgName string) ([]*models.WebhookResponse, error)
+	GetWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID) (*models.WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID) error
+	ListWebhookDeliveries(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID, status string, limit int, offset int) ([]*models.WebhookDeliveryResponse, int32, error)
+}
+
+type webhookManager struct {
+	OrganizationRepository repositories.OrganizationRepository
+	ProjectRepository      repositories.ProjectRepository
+	WebhookRepository      repositories.WebhookRepository
+	Encryptor              secrets.Encryptor
+	logger                 *slog.Logger
+}
+
+func NewWebhookManager(
+	orgRepo repositories.OrganizationRepository,
+	projectRepo repositories.ProjectRepository,
+	webhookRepo repositories.WebhookRepository,
+	encryptor secrets.Encryptor,
+	logger *slog.Logger,
+) WebhookManager {
+	return &webhookManager{
+		OrganizationRepository: orgRepo,
+		ProjectRepository:      projectRepo,
+		WebhookRepository:      webhookRepo,
+		Encryptor:              encryptor,
+		logger:                 logger,
+	}
+}
+
+func (s *webhookManager) CreateWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, req *spec.CreateWebhookRequest) (*models.WebhookResponse, error) {
+	s.logger.Debug("CreateWebhook called", "userIdpId", userIdpId, "orgName", orgName, "url", req.Url)
+
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &models.Webhook{
+		ID:         uuid.New(),
+		OrgID:      org.ID,
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Enabled:    true,
+		CreatedBy:  userIdpId,
+	}
+	if req.ProjectName != nil {
+		project, err := s.ProjectRepository.GetProjectByName(ctx, org.ID, *req.ProjectName)
+		if err != nil {
+			if db.IsRecordNotFoundError(err) {
+				s.logger.Debug("Project not found", "orgName", orgName, "projectName", *req.ProjectName)
+				return nil, utils.ErrProjectNotFound
+			}
+			s.logger.Error("Failed to get project from repository", "orgName", orgName, "projectName", *req.ProjectName, "error", err)
+			return nil, fmt.Errorf("failed to find project %s: %w", *req.ProjectName, err)
+		}
+		webhook.ProjectID = &project.ID
+		webhook.ProjectName = project.Name
+	}
+
+	if err := checkWebhookURL(ctx, req.Url); err != nil {
+		s.logger.Warn("Rejected webhook url", "orgName", orgName, "url", req.Url, "error", err)
+		return nil, err
+	}
+
+	encrypted, err := s.Encryptor.Encrypt(req.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+	webhook.KeyID = encrypted.KeyID
+	webhook.EncryptedDataKey = encrypted.EncryptedDataKey
+	webhook.SecretCiphertext = encrypted.Ciphertext
+	webhook.CreatedAt = time.Now()
+	webhook.UpdatedAt = webhook.CreatedAt
+
+	if err := s.WebhookRepository.CreateWebhook(ctx, webhook); err != nil {
+		s.logger.Error("Failed to create webhook", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	s.logger.Info("Created webhook successfully", "orgName", orgName, "webhookId", webhook.ID)
+	return toWebhookResponse(webhook), nil
+}
+
+func (s *webhookManager) ListWebhooks(ctx context.Context, userIdpId uuid.UUID, orgName string) ([]*models.WebhookResponse, error) {
+	s.logger.Debug("ListWebhooks called", "userIdpId", userIdpId, "orgName", orgName)
+
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.WebhookRepository.ListWebhooks(ctx, org.ID)
+	if err != nil {
+		s.logger.Error("Failed to list webhooks", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to list webhooks for organization %s: %w", orgName, err)
+	}
+	responses := make([]*models.WebhookResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		responses = append(responses, toWebhookResponse(webhook))
+	}
+	return responses, nil
+}
+
+func (s *webhookManager) GetWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID) (*models.WebhookResponse, error) {
+	s.logger.Debug("GetWebhook called", "userIdpId", userIdpId, "orgName", orgName, "webhookId", webhookId)
+
+	webhook, err := s.getWebhook(ctx, userIdpId, orgName, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookResponse(webhook), nil
+}
+
+func (s *webhookManager) DeleteWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID) error {
+	s.logger.Debug("DeleteWebhook called", "userIdpId", userIdpId, "orgName", orgName, "webhookId", webhookId)
+
+	webhook, err := s.getWebhook(ctx, userIdpId, orgName, webhookId)
+	if err != nil {
+		return err
+	}
+	if err := s.WebhookRepository.DeleteWebhook(ctx, webhook.OrgID, webhook.ID); err != nil {
+		s.logger.Error("Failed to delete webhook", "orgName", orgName, "webhookId", webhookId, "error", err)
+		return fmt.Errorf("failed to delete webhook %s: %w", webhookId, err)
+	}
+	s.logger.Info("Deleted webhook successfully", "orgName", orgName, "webhookId", webhookId)
+	return nil
+}
+
+func (s *webhookManager) ListWebhookDeliveries(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID, status string, limit int, offset int) ([]*models.WebhookDeliveryResponse, int32, error) {
+	s.logger.Debug("ListWebhookDeliveries called", "userIdpId", userIdpId, "orgName", orgName, "webhookId", webhookId, "limit", limit, "offset", offset)
+
+	webhook, err := s.getWebhook(ctx, userIdpId, orgName, webhookId)
+	if err != nil {
+		return nil, 0, err
+	}
+	deliveries, total, err := s.WebhookRepository.ListWebhookDeliveries(ctx, webhook.ID, status, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list webhook deliveries", "orgName", orgName, "webhookId", webhookId, "error", err)
+		return nil, 0, fmt.Errorf("failed to list deliveries of webhook %s: %w", webhookId, err)
+	}
+
+	responses := make([]*models.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response := &models.WebhookDeliveryResponse{
+			ID:             delivery.ID.String(),
+			EventID:        delivery.EventID.String(),
+			EventType:      delivery.EventType,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			LastStatusCode: delivery.LastStatusCode,
+			LastError:      delivery.LastError,
+			DeliveredAt:    delivery.DeliveredAt,
+			CreatedAt:      delivery.CreatedAt,
+		}
+		if delivery.Status == utils.WebhookDeliveryStatusPending {
+			nextAttemptAt := delivery.NextAttemptAt
+			response.NextAttemptAt = &nextAttemptAt
+		}
+		responses = append(responses, response)
+	}
+	return responses, int32(total), nil
+}
+
+func (s *webhookManager) getOrganization(ctx context.Context, userIdpId uuid.UUID, orgName string) (*models.Organization, error) {
+	org, err := s.OrganizationRepository.GetOrganizationByOrgName(ctx, userIdpId, orgName)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Organization not found", "userIdpId", userIdpId, "orgName", orgName)
+			return nil, utils.ErrOrganizationNotFound
+		}
+		s.logger.Error("Failed to get organization from repository", "userIdpId", userIdpId, "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to find organization %s: %w", orgName, err)
+	}
+	return org, nil
+}
+
+func (s *webhookManager) getWebhook(ctx context.Context, userIdpId uuid.UUID, orgName string, webhookId uuid.UUID) (*models.Webhook, error) {
+	org, err := s.getOrganization(ctx, userIdpId, orgName)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := s.WebhookRepository.GetWebhook(ctx, org.ID, webhookId)
+	if err != nil {
+		if db.IsRecordNotFoundError(err) {
+			s.logger.Debug("Webhook not found", "orgName", orgName, "webhookId", webhookId)
+			return nil, utils.ErrWebhookNotFound
+		}
+		s.logger.Error("Failed to get webhook from repository", "orgName", orgName, "webhookId", webhookId, "error", err)
+		return nil, fmt.Errorf("failed to find webhook %s: %w", webhookId, err)
+	}
+	return webhook, nil
+}
+
+func toWebhookResponse(webhook *models.Webhook) *models.WebhookResponse {
+	return &models.WebhookResponse{
+		ID:          webhook.ID.String(),
+		URL:         webhook.URL,
+		ProjectName: webhook.ProjectName,
+		EventTypes:  webhook.EventTypes,
+		Enabled:     webhook.Enabled,
+		CreatedBy:   webhook.CreatedBy.String(),
+		CreatedAt:   webhook.CreatedAt,
+	}
+}
+
+// checkWebhookURL resolves the host of a webhook URL and rejects it when any of its addresses is not public.
+// The dispatcher checks the address again when it connects, as the DNS records may change after creation.
+func checkWebhookURL(ctx context.Context, rawURL string) error {
+	if config.GetConfig().Webhooks.AllowPrivateNetworks {
+		return nil
+	}
+	webhookURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", utils.ErrWebhookURLNotAllowed, err)
+	}
+	host := webhookURL.Hostname()
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("%w: failed to resolve %s: %w", utils.ErrWebhookURLNotAllowed, host, err)
+	}
+	for _, addr := range addrs {
+		if !utils.IsPublicWebhookAddress(addr) {
+			return fmt.Errorf("%w: %s resolves to %s", utils.ErrWebhookURLNotAllowed, host, addr)
+		}
+	}
+	return nil
+}
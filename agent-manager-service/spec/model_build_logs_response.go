@@ -22,6 +22,8 @@ type BuildLogsResponse struct {
 	Logs       []LogEntry `json:"logs"`
 	TotalCount int32      `json:"totalCount"`
 	TookMs     float32    `json:"tookMs"`
+	// Cursor to fetch the next page of logs. Absent when there are no more logs.
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // NewBuildLogsResponse instantiates a new BuildLogsResponse object
@@ -116,6 +118,38 @@ func (o *BuildLogsResponse) SetTookMs(v float32) {
 	o.TookMs = v
 }
 
+// GetNextCursor returns the NextCursor field value if set, zero value otherwise.
+func (o *BuildLogsResponse) GetNextCursor() string {
+	if o == nil || IsNil(o.NextCursor) {
+		var ret string
+		return ret
+	}
+	return *o.NextCursor
+}
+
+// GetNextCursorOk returns a tuple with the NextCursor field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BuildLogsResponse) GetNextCursorOk() (*string, bool) {
+	if o == nil || IsNil(o.NextCursor) {
+		return nil, false
+	}
+	return o.NextCursor, true
+}
+
+// HasNextCursor returns a boolean if a field has been set.
+func (o *BuildLogsResponse) HasNextCursor() bool {
+	if o != nil && !IsNil(o.NextCursor) {
+		return true
+	}
+
+	return false
+}
+
+// SetNextCursor gets a reference to the given string and assigns it to the NextCursor field.
+func (o *BuildLogsResponse) SetNextCursor(v string) {
+	o.NextCursor = &v
+}
+
 func (o BuildLogsResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -129,6 +163,9 @@ func (o BuildLogsResponse) ToMap() (map[string]interface{}, error) {
 	toSerialize["logs"] = o.Logs
 	toSerialize["totalCount"] = o.TotalCount
 	toSerialize["tookMs"] = o.TookMs
+	if !IsNil(o.NextCursor) {
+		toSerialize["nextCursor"] = o.NextCursor
+	}
 	return toSerialize, nil
 }
 
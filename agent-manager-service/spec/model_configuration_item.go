@@ -23,6 +23,8 @@ type ConfigurationItem struct {
 	Key string `json:"key"`
 	// Configuration value
 	Value string `json:"value"`
+	// Whether the value is a secret. Secret values are masked.
+	IsSecret *bool `json:"isSecret,omitempty"`
 }
 
 // NewConfigurationItem instantiates a new ConfigurationItem object
@@ -92,6 +94,38 @@ func (o *ConfigurationItem) SetValue(v string) {
 	o.Value = v
 }
 
+// GetIsSecret returns the IsSecret field value if set, zero value otherwise.
+func (o *ConfigurationItem) GetIsSecret() bool {
+	if o == nil || IsNil(o.IsSecret) {
+		var ret bool
+		return ret
+	}
+	return *o.IsSecret
+}
+
+// GetIsSecretOk returns a tuple with the IsSecret field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ConfigurationItem) GetIsSecretOk() (*bool, bool) {
+	if o == nil || IsNil(o.IsSecret) {
+		return nil, false
+	}
+	return o.IsSecret, true
+}
+
+// HasIsSecret returns a boolean if a field has been set.
+func (o *ConfigurationItem) HasIsSecret() bool {
+	if o != nil && !IsNil(o.IsSecret) {
+		return true
+	}
+
+	return false
+}
+
+// SetIsSecret gets a reference to the given bool and assigns it to the IsSecret field.
+func (o *ConfigurationItem) SetIsSecret(v bool) {
+	o.IsSecret = &v
+}
+
 func (o ConfigurationItem) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -104,6 +138,9 @@ func (o ConfigurationItem) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["key"] = o.Key
 	toSerialize["value"] = o.Value
+	if !IsNil(o.IsSecret) {
+		toSerialize["isSecret"] = o.IsSecret
+	}
 	return toSerialize, nil
 }
 
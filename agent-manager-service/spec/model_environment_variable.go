@@ -21,6 +21,8 @@ var _ MappedNullable = &EnvironmentVariable{}
 type EnvironmentVariable struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
+	// Marks the variable as a secret. Secret values are stored encrypted, injected from a Kubernetes Secret and never returned in responses.
+	IsSecret *bool `json:"isSecret,omitempty"`
 }
 
 // NewEnvironmentVariable instantiates a new EnvironmentVariable object
@@ -90,6 +92,38 @@ func (o *EnvironmentVariable) SetValue(v string) {
 	o.Value = v
 }
 
+// GetIsSecret returns the IsSecret field value if set, zero value otherwise.
+func (o *EnvironmentVariable) GetIsSecret() bool {
+	if o == nil || IsNil(o.IsSecret) {
+		var ret bool
+		return ret
+	}
+	return *o.IsSecret
+}
+
+// GetIsSecretOk returns a tuple with the IsSecret field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *EnvironmentVariable) GetIsSecretOk() (*bool, bool) {
+	if o == nil || IsNil(o.IsSecret) {
+		return nil, false
+	}
+	return o.IsSecret, true
+}
+
+// HasIsSecret returns a boolean if a field has been set.
+func (o *EnvironmentVariable) HasIsSecret() bool {
+	if o != nil && !IsNil(o.IsSecret) {
+		return true
+	}
+
+	return false
+}
+
+// SetIsSecret gets a reference to the given bool and assigns it to the IsSecret field.
+func (o *EnvironmentVariable) SetIsSecret(v bool) {
+	o.IsSecret = &v
+}
+
 func (o EnvironmentVariable) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -102,6 +136,9 @@ func (o EnvironmentVariable) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["key"] = o.Key
 	toSerialize["value"] = o.Value
+	if !IsNil(o.IsSecret) {
+		toSerialize["isSecret"] = o.IsSecret
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,293 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const agentLogsTestTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+// createAgentLogs returns runtime logs where several logs share a timestamp, some of them written during a trace
+func createAgentLogs(start time.Time) []models.LogEntry {
+	lines := []string{
+		"starting request",
+		fmt.Sprintf("trace_id=%s planning the answer", agentLogsTestTraceID),
+		"connection pool ready",
+		fmt.Sprintf(`{"traceId": "%s", "msg": "calling tool"}`, agentLogsTestTraceID),
+		"trace_id=0af7651916cd43dd8448eb211c80319c handling another request",
+		fmt.Sprintf("trace_id=%s answer sent", agentLogsTestTraceID),
+	}
+	logs := make([]models.LogEntry, 0, len(lines))
+	for i, line := range lines {
+		logs = append(logs, models.LogEntry{
+			Timestamp:   start.Add(time.Duration(i/2) * time.Second),
+			Log:         line,
+			LogLevel:    "INFO",
+			ComponentId: "component-uid-123",
+		})
+	}
+	return logs
+}
+
+func createMockObservabilityClientForAgentLogs(logs []models.LogEntry) *clientmocks.ObservabilitySvcClientMock {
+	return &clientmocks.ObservabilitySvcClientMock{
+		GetComponentLogsFunc: func(ctx context.Context, componentUid string, params observabilitysvc.ComponentLogsParams) (*models.AgentLogsResponse, error) {
+			matched := make([]models.LogEntry, 0, len(logs))
+			for _, logEntry := range logs {
+				if !logEntry.Timestamp.Before(params.StartTime) && !logEntry.Timestamp.After(params.EndTime) {
+					matched = append(matched, logEntry)
+				}
+			}
+			total := len(matched)
+			if len(matched) > params.Limit {
+				matched = matched[:params.Limit]
+			}
+			return &models.AgentLogsResponse{Logs: matched, TotalCount: int32(total)}, nil
+		},
+	}
+}
+
+func TestAgentLogs(t *testing.T) {
+	agentLogsOrgId := uuid.New()
+	agentLogsUserIdpId := uuid.New()
+	agentLogsProjId := uuid.New()
+	agentLogsOrgName := fmt.Sprintf("agent-logs-org-%s", uuid.New().String()[:5])
+	agentLogsProjName := fmt.Sprintf("agent-logs-project-%s", uuid.New().String()[:5])
+	agentLogsAgentName := fmt.Sprintf("agent-logs-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, agentLogsOrgId, agentLogsUserIdpId, agentLogsOrgName)
+	_ = apitestutils.CreateProject(t, agentLogsProjId, agentLogsOrgId, agentLogsProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, agentLogsOrgId, agentLogsUserIdpId)
+
+	start := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+	logs := createAgentLogs(start)
+	agentLogsURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/logs", agentLogsOrgName, agentLogsProjName, agentLogsAgentName)
+
+	newTraceObserverClient := func() *clientmocks.TraceObserverClientMock {
+		return &clientmocks.TraceObserverClientMock{
+			TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+				if params.TraceID != agentLogsTestTraceID {
+					return &traceobserversvc.TraceResponse{Spans: []traceobserversvc.Span{}}, nil
+				}
+				return &traceobserversvc.TraceResponse{
+					Spans: []traceobserversvc.Span{
+						{TraceID: params.TraceID, SpanID: "span-2", ParentSpanID: "span-1", Name: "tool", StartTime: start.Add(time.Second), EndTime: start.Add(2 * time.Second)},
+						{TraceID: params.TraceID, SpanID: "span-1", Name: "invoke", StartTime: start, EndTime: start.Add(2 * time.Second)},
+					},
+					TotalCount: 2,
+				}, nil
+			},
+		}
+	}
+	newApp := func(t *testing.T, observabilityClient *clientmocks.ObservabilitySvcClientMock) http.Handler {
+		return apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient:    createMockOpenChoreoClient(),
+			ObservabilitySvcClient: observabilityClient,
+			TraceObserverClient:    newTraceObserverClient(),
+		}, authMiddleware)
+	}
+
+	t.Run("Paging through agent logs should return every log once", func(t *testing.T) {
+		observabilityClient := createMockObservabilityClientForAgentLogs(logs)
+		app := newApp(t, observabilityClient)
+		var received []string
+		cursor := ""
+		for page := 0; page < 10; page++ {
+			query := url.Values{"environment": {"Development"}, "limit": {"2"}, "level": {"info,error"}, "search": {"request"}}
+			if cursor != "" {
+				query.Set("cursor", cursor)
+			}
+			req := httptest.NewRequest(http.MethodGet, agentLogsURL+"?"+query.Encode(), nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var response models.AgentLogsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			require.LessOrEqual(t, len(response.Logs), 2)
+			for _, logEntry := range response.Logs {
+				received = append(received, logEntry.Log)
+			}
+			if response.NextCursor == "" {
+				break
+			}
+			cursor = response.NextCursor
+		}
+
+		expected := make([]string, 0, len(logs))
+		for _, logEntry := range logs {
+			expected = append(expected, logEntry.Log)
+		}
+		require.Equal(t, expected, received)
+
+		params := observabilityClient.GetComponentLogsCalls()[0]
+		require.Equal(t, "component-uid-123", params.ComponentUid)
+		require.Equal(t, "environment-uid-123", params.Params.EnvironmentUid)
+		require.Equal(t, []string{"INFO", "ERROR"}, params.Params.LogLevels)
+		require.Equal(t, "request", params.Params.SearchPhrase)
+		require.WithinDuration(t, time.Now().Add(-utils.DefaultAgentLogsLookback), params.Params.StartTime, time.Minute)
+	})
+
+	t.Run("Getting the logs of a trace should search the trace's time window and leave out other traces", func(t *testing.T) {
+		observabilityClient := createMockObservabilityClientForAgentLogs(logs)
+		app := newApp(t, observabilityClient)
+		query := url.Values{"environment": {"Development"}, "traceId": {agentLogsTestTraceID}}
+		req := httptest.NewRequest(http.MethodGet, agentLogsURL+"?"+query.Encode(), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.AgentLogsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		var received []string
+		for _, logEntry := range response.Logs {
+			received = append(received, logEntry.Log)
+		}
+		require.Equal(t, []string{logs[0].Log, logs[1].Log, logs[2].Log, logs[3].Log, logs[5].Log}, received)
+
+		require.Len(t, observabilityClient.GetComponentLogsCalls(), 1)
+		params := observabilityClient.GetComponentLogsCalls()[0].Params
+		require.Equal(t, start.Add(-5*time.Second), params.StartTime)
+		require.Equal(t, start.Add(7*time.Second), params.EndTime)
+	})
+
+	t.Run("Streaming agent logs up to a past time should send the logs and complete", func(t *testing.T) {
+		app := newApp(t, createMockObservabilityClientForAgentLogs(logs))
+		query := url.Values{
+			"environment": {"Development"},
+			"since":       {start.Format(time.RFC3339)},
+			"until":       {start.Add(time.Minute).Format(time.RFC3339)},
+		}
+		req := httptest.NewRequest(http.MethodGet, agentLogsURL+"/stream?"+query.Encode(), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+
+		events := parseSSEEvents(rr.Body.String())
+		require.Len(t, events, len(logs)+1)
+		for i, logEntry := range logs {
+			require.Equal(t, utils.LogStreamEventLog, events[i].event)
+			require.NotEmpty(t, events[i].id)
+			var entry models.LogEntry
+			require.NoError(t, json.Unmarshal([]byte(events[i].data), &entry))
+			require.Equal(t, logEntry.Log, entry.Log)
+		}
+		require.Equal(t, utils.LogStreamEventComplete, events[len(events)-1].event)
+
+		// Reconnecting with the id of the fourth event resumes after it, in the middle of a timestamp
+		req = httptest.NewRequest(http.MethodGet, agentLogsURL+"/stream?"+query.Encode(), nil)
+		req.Header.Set("Last-Event-ID", events[3].id)
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resumed := parseSSEEvents(rr.Body.String())
+		require.Len(t, resumed, len(logs)-4+1)
+		var entry models.LogEntry
+		require.NoError(t, json.Unmarshal([]byte(resumed[0].data), &entry))
+		require.Equal(t, logs[4].Log, entry.Log)
+	})
+
+	validationTests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 when the environment is missing",
+			url:        agentLogsURL,
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "environment is required",
+		},
+		{
+			name:       "return 400 on an invalid level",
+			url:        agentLogsURL + "?environment=Development&level=TRACE",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid level parameter",
+		},
+		{
+			name:       "return 400 when since is not before until",
+			url:        agentLogsURL + "?environment=Development&since=2025-12-20T11:00:00Z&until=2025-12-20T10:00:00Z",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid time range",
+		},
+		{
+			name:       "return 400 on an invalid limit",
+			url:        agentLogsURL + "?environment=Development&limit=5000",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid limit parameter",
+		},
+		{
+			name:       "return 400 on an invalid cursor",
+			url:        agentLogsURL + "?environment=Development&cursor=not-a-cursor",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid cursor",
+		},
+		{
+			// The cursor skips a million logs at its timestamp
+			name:       "return 400 on a cursor that skips too many logs",
+			url:        agentLogsURL + "?environment=Development&cursor=eyJ0IjoiMjAyNS0wMS0wMVQwMDowMDowMFoiLCJuIjoxMDAwMDAwfQ",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid cursor",
+		},
+		{
+			name:       "return 400 when streaming from an invalid cursor",
+			url:        agentLogsURL + "/stream?environment=Development&cursor=not-a-cursor",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid cursor",
+		},
+		{
+			name:       "return 404 when the trace is not found",
+			url:        agentLogsURL + "?environment=Development&traceId=0af7651916cd43dd8448eb211c80319c",
+			wantStatus: http.StatusNotFound,
+			wantErrMsg: "Trace not found",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newApp(t, createMockObservabilityClientForAgentLogs(logs))
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func createMockTraceObserverClientForMetrics() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		GetMetricsFunc: func(ctx context.Context, params traceobserversvc.MetricsParams) (*traceobserversvc.MetricsResponse, error) {
+			return &traceobserversvc.MetricsResponse{
+				Interval: "1h",
+				Summary: traceobserversvc.MetricsBucket{
+					RequestCount: 40,
+					ErrorCount:   2,
+					ErrorRate:    0.05,
+					Latency: &traceobserversvc.LatencyPercentiles{
+						P50InNanos: 1000000000,
+						P95InNanos: 4000000000,
+						P99InNanos: 9000000000,
+					},
+					TokenUsage: traceobserversvc.TokenUsage{InputTokens: 4000, OutputTokens: 1000, TotalTokens: 5000},
+				},
+				Buckets: []traceobserversvc.MetricsBucket{
+					{
+						Timestamp:    "2025-12-16T10:00:00Z",
+						RequestCount: 40,
+						ErrorCount:   2,
+						ErrorRate:    0.05,
+						Latency: &traceobserversvc.LatencyPercentiles{
+							P50InNanos: 1000000000,
+							P95InNanos: 4000000000,
+							P99InNanos: 9000000000,
+						},
+						TokenUsage: traceobserversvc.TokenUsage{InputTokens: 4000, OutputTokens: 1000, TotalTokens: 5000},
+					},
+					{
+						Timestamp: "2025-12-16T11:00:00Z",
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestGetAgentMetrics(t *testing.T) {
+	metricsOrgId := uuid.New()
+	metricsUserIdpId := uuid.New()
+	metricsProjId := uuid.New()
+	metricsOrgName := fmt.Sprintf("metrics-org-%s", uuid.New().String()[:5])
+	metricsProjName := fmt.Sprintf("metrics-project-%s", uuid.New().String()[:5])
+	metricsAgentName := fmt.Sprintf("metrics-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, metricsOrgId, metricsUserIdpId, metricsOrgName)
+	_ = apitestutils.CreateProject(t, metricsProjId, metricsOrgId, metricsProjName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, metricsOrgId, metricsUserIdpId)
+
+	metricsURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/metrics", metricsOrgName, metricsProjName, metricsAgentName)
+
+	t.Run("Getting metrics should return time-bucketed metrics", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForMetrics()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := metricsURL + "?environment=Development&startTime=2025-12-16T10:00:00Z&endTime=2025-12-16T12:00:00Z&interval=1h"
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.AgentMetricsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, "1h", response.Interval)
+		require.Equal(t, 40, response.Summary.RequestCount)
+		require.Equal(t, 0.05, response.Summary.ErrorRate)
+		require.NotNil(t, response.Summary.Latency)
+		require.Equal(t, int64(4000000000), response.Summary.Latency.P95InNanos)
+		require.Equal(t, 5000, response.Summary.TokenUsage.TotalTokens)
+		require.Len(t, response.Buckets, 2)
+		require.Equal(t, "2025-12-16T10:00:00Z", response.Buckets[0].Timestamp)
+		require.Nil(t, response.Buckets[1].Latency)
+
+		// Validate the trace observer was queried with the component and environment UIDs
+		require.Len(t, traceObserverClient.GetMetricsCalls(), 1)
+		params := traceObserverClient.GetMetricsCalls()[0].Params
+		require.Equal(t, "component-uid-123", params.ComponentUid)
+		require.Equal(t, "environment-uid-123", params.EnvironmentUid)
+		require.Equal(t, "2025-12-16T10:00:00Z", params.StartTime)
+		require.Equal(t, "2025-12-16T12:00:00Z", params.EndTime)
+		require.Equal(t, "1h", params.Interval)
+	})
+
+	t.Run("Getting metrics of an unknown agent should return 404", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.GetAgentComponentFunc = func(ctx context.Context, orgName, projectName, agentName string) (*openchoreosvc.AgentComponent, error) {
+			return nil, utils.ErrAgentNotFound
+		}
+		traceObserverClient := createMockTraceObserverClientForMetrics()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := metricsURL + "?environment=Development&startTime=2025-12-16T10:00:00Z&endTime=2025-12-16T12:00:00Z"
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Len(t, traceObserverClient.GetMetricsCalls(), 0)
+	})
+
+	validationTests := []struct {
+		name       string
+		query      string
+		wantErrMsg string
+	}{
+		{
+			name:       "missing environment",
+			query:      "startTime=2025-12-16T10:00:00Z&endTime=2025-12-16T12:00:00Z",
+			wantErrMsg: "environment is required",
+		},
+		{
+			name:       "missing startTime",
+			query:      "environment=Development&endTime=2025-12-16T12:00:00Z",
+			wantErrMsg: "Invalid startTime",
+		},
+		{
+			name:       "startTime after endTime",
+			query:      "environment=Development&startTime=2025-12-16T12:00:00Z&endTime=2025-12-16T10:00:00Z",
+			wantErrMsg: "startTime must be before endTime",
+		},
+		{
+			name:       "interval shorter than a minute",
+			query:      "environment=Development&startTime=2025-12-16T10:00:00Z&endTime=2025-12-16T12:00:00Z&interval=30s",
+			wantErrMsg: "Invalid interval parameter",
+		},
+		{
+			name:       "too many intervals",
+			query:      "environment=Development&startTime=2025-12-01T00:00:00Z&endTime=2025-12-16T00:00:00Z&interval=1m",
+			wantErrMsg: "Invalid interval parameter",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(fmt.Sprintf("Getting metrics with %s should return 400", tt.name), func(t *testing.T) {
+			traceObserverClient := createMockTraceObserverClientForMetrics()
+			testClients := wiring.TestClients{
+				OpenChoreoSvcClient: createMockOpenChoreoClient(),
+				TraceObserverClient: traceObserverClient,
+			}
+			app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+			req := httptest.NewRequest(http.MethodGet, metricsURL+"?"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+			require.Len(t, traceObserverClient.GetMetricsCalls(), 0)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	auditTestOrgId       = uuid.New()
+	auditTestProjId      = uuid.New()
+	auditTestEmptyProjId = uuid.New()
+	auditTestOwnerIdpId  = uuid.New()
+	auditTestViewerIdpId = uuid.New()
+	auditTestOrgName     = fmt.Sprintf("audit-org-%s", uuid.New().String()[:5])
+	auditTestProjName    = fmt.Sprintf("audit-project-%s", uuid.New().String()[:5])
+	auditTestEmptyProj   = fmt.Sprintf("audit-empty-%s", uuid.New().String()[:5])
+	auditTestAgentName   = fmt.Sprintf("audit-agent-%s", uuid.New().String()[:5])
+)
+
+func TestAuditEvents(t *testing.T) {
+	setUpAuditEventsTest(t)
+	ownerAuth := jwtassertion.NewMockMiddleware(t, auditTestOrgId, auditTestOwnerIdpId)
+	viewerAuth := jwtassertion.NewMockMiddleware(t, auditTestOrgId, auditTestViewerIdpId)
+
+	setMemberRole(t, ownerAuth, fmt.Sprintf("/api/v1/orgs/%s/members/%s", auditTestOrgName, auditTestViewerIdpId), utils.RoleViewer, http.StatusOK)
+
+	deleteCorrelationId := uuid.New().String()
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: createMockOpenChoreoClientForProjectDelete()}, ownerAuth)
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/orgs/%s/projects/%s", auditTestOrgName, auditTestEmptyProj), nil)
+	req.Header.Set(middleware.CorrelationIDHeader, deleteCorrelationId)
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	app = apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: createMockOpenChoreoClientForDeploy()}, viewerAuth)
+	body, _ := json.Marshal(map[string]interface{}{"imageId": "registry.example.com/myapp:v1.0.0"})
+	req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments", auditTestOrgName, auditTestProjName, auditTestAgentName), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr = httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusForbidden, rr.Code)
+
+	listAuditEvents := func(t *testing.T, authMiddleware jwtassertion.Middleware, query string) *httptest.ResponseRecorder {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, authMiddleware)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/audit-events%s", auditTestOrgName, query), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Listing audit events should return mutating operations newest first", func(t *testing.T) {
+		rr := listAuditEvents(t, ownerAuth, "")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response spec.AuditEventListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, int32(3), response.Total)
+		require.Len(t, response.Events, 3)
+
+		deployEvent := response.Events[0]
+		require.Equal(t, utils.AuditActionAgentDeploy, deployEvent.Action)
+		require.Equal(t, auditTestViewerIdpId.String(), deployEvent.Actor)
+		require.Equal(t, utils.AuditResultFailure, deployEvent.Result)
+		require.Equal(t, int32(http.StatusForbidden), deployEvent.StatusCode)
+		require.Equal(t, auditTestAgentName, deployEvent.GetAgentName())
+
+		deleteEvent := response.Events[1]
+		require.Equal(t, utils.AuditActionProjectDelete, deleteEvent.Action)
+		require.Equal(t, auditTestOwnerIdpId.String(), deleteEvent.Actor)
+		require.Equal(t, utils.AuditResultSuccess, deleteEvent.Result)
+		require.Equal(t, deleteCorrelationId, deleteEvent.CorrelationId)
+		require.Equal(t, fmt.Sprintf("/orgs/%s/projects/%s", auditTestOrgName, auditTestEmptyProj), deleteEvent.Target)
+
+		require.Equal(t, utils.AuditActionOrgMemberSetRole, response.Events[2].Action)
+	})
+
+	t.Run("Listing audit events should apply filters and pagination", func(t *testing.T) {
+		rr := listAuditEvents(t, ownerAuth, "?result=success&limit=1")
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response spec.AuditEventListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, int32(2), response.Total)
+		require.Len(t, response.Events, 1)
+		require.Equal(t, utils.AuditActionProjectDelete, response.Events[0].Action)
+
+		rr = listAuditEvents(t, ownerAuth, fmt.Sprintf("?actor=%s&action=%s", auditTestViewerIdpId, utils.AuditActionAgentDeploy))
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, int32(1), response.Total)
+
+		rr = listAuditEvents(t, ownerAuth, "?startTime=2100-01-01T00:00:00Z")
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, int32(0), response.Total)
+		require.Empty(t, response.Events)
+	})
+
+	validationTests := []struct {
+		name           string
+		authMiddleware jwtassertion.Middleware
+		query          string
+		wantStatus     int
+		wantErrMsg     string
+	}{
+		{
+			name:           "return 403 when a viewer reads the audit log",
+			authMiddleware: viewerAuth,
+			wantStatus:     http.StatusForbidden,
+			wantErrMsg:     "Insufficient permissions to perform this action",
+		},
+		{
+			name:           "return 400 on invalid actor",
+			authMiddleware: ownerAuth,
+			query:          "?actor=someone",
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "Invalid actor parameter",
+		},
+		{
+			name:           "return 400 on invalid result",
+			authMiddleware: ownerAuth,
+			query:          "?result=partial",
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "Invalid result parameter",
+		},
+		{
+			name:           "return 400 on invalid startTime",
+			authMiddleware: ownerAuth,
+			query:          "?startTime=yesterday",
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "Invalid startTime format",
+		},
+		{
+			name:           "return 400 on invalid limit",
+			authMiddleware: ownerAuth,
+			query:          "?limit=0",
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "Invalid limit parameter",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := listAuditEvents(t, tt.authMiddleware, tt.query)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
+
+func setUpAuditEventsTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, auditTestOrgId, auditTestOwnerIdpId, auditTestOrgName)
+	_ = apitestutils.CreateProject(t, auditTestProjId, auditTestOrgId, auditTestProjName)
+	_ = apitestutils.CreateProject(t, auditTestEmptyProjId, auditTestOrgId, auditTestEmptyProj)
+	_ = apitestutils.CreateAgent(t, uuid.New(), auditTestOrgId, auditTestProjId, auditTestAgentName, string(utils.InternalAgent))
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	buildLogsTestOrgId     = uuid.New()
+	buildLogsTestUserIdpId = uuid.New()
+	buildLogsTestProjId    = uuid.New()
+	buildLogsTestOrgName   = fmt.Sprintf("build-logs-org-%s", uuid.New().String()[:5])
+	buildLogsTestProjName  = fmt.Sprintf("build-logs-project-%s", uuid.New().String()[:5])
+	buildLogsTestAgentName = fmt.Sprintf("build-logs-agent-%s", uuid.New().String()[:5])
+	buildLogsTestBuildName = fmt.Sprintf("%s-build-1", buildLogsTestAgentName)
+)
+
+// sseEvent is an event parsed from a server-sent events response
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+func parseSSEEvents(body string) []sseEvent {
+	var events []sseEvent
+	for _, block := range strings.Split(body, "\n\n") {
+		var event sseEvent
+		for _, line := range strings.Split(block, "\n") {
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				event.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				event.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+		if event.event != "" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// createBuildLogs returns logs where several logs share a timestamp, as happens with multi-line build output
+func createBuildLogs(startedAt time.Time) []models.LogEntry {
+	logs := make([]models.LogEntry, 0, 7)
+	for i := 0; i < 7; i++ {
+		logs = append(logs, models.LogEntry{
+			Timestamp: startedAt.Add(time.Duration(i/3) * time.Second),
+			Log:       fmt.Sprintf("build log line %d", i),
+			LogLevel:  "INFO",
+		})
+	}
+	return logs
+}
+
+func createMockObservabilityClient(logs []models.LogEntry) *clientmocks.ObservabilitySvcClientMock {
+	return &clientmocks.ObservabilitySvcClientMock{
+		GetBuildLogsFunc: func(ctx context.Context, buildName string, params observabilitysvc.BuildLogsParams) (*models.BuildLogsResponse, error) {
+			matched := make([]models.LogEntry, 0, len(logs))
+			for _, logEntry := range logs {
+				if !logEntry.Timestamp.Before(params.StartTime) && !logEntry.Timestamp.After(params.EndTime) {
+					matched = append(matched, logEntry)
+				}
+			}
+			total := len(matched)
+			if len(matched) > params.Limit {
+				matched = matched[:params.Limit]
+			}
+			return &models.BuildLogsResponse{Logs: matched, TotalCount: int32(total)}, nil
+		},
+	}
+}
+
+func createMockOpenChoreoClientForBuildLogs(status string, startedAt time.Time, endedAt time.Time) *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetProjectFunc: func(ctx context.Context, projectName string, orgName string) (*models.ProjectResponse, error) {
+			return &models.ProjectResponse{Name: projectName, OrgName: orgName}, nil
+		},
+		GetAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
+			return &openchoreosvc.AgentComponent{Name: agentName, ProjectName: projName}, nil
+		},
+		GetComponentWorkflowFunc: func(ctx context.Context, orgName string, projName string, componentName string, buildName string) (*models.BuildDetailsResponse, error) {
+			if buildName != buildLogsTestBuildName {
+				return nil, utils.ErrBuildNotFound
+			}
+			return &models.BuildDetailsResponse{
+				BuildResponse: models.BuildResponse{
+					Name:        buildName,
+					AgentName:   componentName,
+					ProjectName: projName,
+					Status:      status,
+					StartedAt:   startedAt,
+					EndedAt:     &endedAt,
+				},
+			}, nil
+		},
+	}
+}
+
+func TestBuildLogs(t *testing.T) {
+	setUpBuildLogsTest(t)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, buildLogsTestOrgId, buildLogsTestUserIdpId)
+
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	logs := createBuildLogs(startedAt)
+	buildLogsURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/builds/%s/build-logs",
+		buildLogsTestOrgName, buildLogsTestProjName, buildLogsTestAgentName, buildLogsTestBuildName)
+
+	newApp := func(t *testing.T) http.Handler {
+		return apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient:    createMockOpenChoreoClientForBuildLogs("BuildSucceeded", startedAt, startedAt.Add(10*time.Minute)),
+			ObservabilitySvcClient: createMockObservabilityClient(logs),
+		}, authMiddleware)
+	}
+
+	t.Run("Paging through build logs should return every log once", func(t *testing.T) {
+		app := newApp(t)
+		var received []string
+		cursor := ""
+		for page := 0; page < 10; page++ {
+			query := url.Values{"limit": {"2"}}
+			if cursor != "" {
+				query.Set("cursor", cursor)
+			}
+			req := httptest.NewRequest(http.MethodGet, buildLogsURL+"?"+query.Encode(), nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			var response spec.BuildLogsResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			require.LessOrEqual(t, len(response.Logs), 2)
+			for _, logEntry := range response.Logs {
+				received = append(received, logEntry.Log)
+			}
+			if response.NextCursor == nil {
+				break
+			}
+			cursor = *response.NextCursor
+		}
+
+		expected := make([]string, 0, len(logs))
+		for _, logEntry := range logs {
+			expected = append(expected, logEntry.Log)
+		}
+		require.Equal(t, expected, received)
+	})
+
+	t.Run("Streaming logs of a finished build should send all logs and complete", func(t *testing.T) {
+		app := newApp(t)
+		req := httptest.NewRequest(http.MethodGet, buildLogsURL+"/stream", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+
+		events := parseSSEEvents(rr.Body.String())
+		require.Len(t, events, len(logs)+1)
+		for i, logEntry := range logs {
+			require.Equal(t, utils.LogStreamEventLog, events[i].event)
+			require.NotEmpty(t, events[i].id)
+			var entry spec.LogEntry
+			require.NoError(t, json.Unmarshal([]byte(events[i].data), &entry))
+			require.Equal(t, logEntry.Log, entry.Log)
+		}
+		completeEvent := events[len(events)-1]
+		require.Equal(t, utils.LogStreamEventComplete, completeEvent.event)
+		var complete spec.BuildLogStreamComplete
+		require.NoError(t, json.Unmarshal([]byte(completeEvent.data), &complete))
+		require.Equal(t, "BuildSucceeded", complete.Status)
+
+		// Reconnecting with the id of the fourth event resumes after it, in the middle of a timestamp
+		req = httptest.NewRequest(http.MethodGet, buildLogsURL+"/stream", nil)
+		req.Header.Set("Last-Event-ID", events[3].id)
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		resumed := parseSSEEvents(rr.Body.String())
+		require.Len(t, resumed, len(logs)-4+1)
+		var entry spec.LogEntry
+		require.NoError(t, json.Unmarshal([]byte(resumed[0].data), &entry))
+		require.Equal(t, logs[4].Log, entry.Log)
+	})
+
+	validationTests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 on an invalid cursor",
+			url:        buildLogsURL + "?cursor=not-a-cursor",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid cursor",
+		},
+		{
+			// The cursor skips a million logs at its timestamp
+			name:       "return 400 on a cursor that skips too many logs",
+			url:        buildLogsURL + "?cursor=eyJ0IjoiMjAyNS0wMS0wMVQwMDowMDowMFoiLCJuIjoxMDAwMDAwfQ",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid cursor",
+		},
+		{
+			name:       "return 400 on an invalid limit",
+			url:        buildLogsURL + "?limit=5000",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid limit parameter",
+		},
+		{
+			name:       "return 400 when streaming from an invalid cursor",
+			url:        buildLogsURL + "/stream?cursor=not-a-cursor",
+			wantStatus: http.StatusBadRequest,
+			wantErrMsg: "Invalid cursor",
+		},
+		{
+			name: "return 404 when streaming logs of an unknown build",
+			url: fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/builds/%s/build-logs/stream",
+				buildLogsTestOrgName, buildLogsTestProjName, buildLogsTestAgentName, "missing-build"),
+			wantStatus: http.StatusNotFound,
+			wantErrMsg: "Build not found",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newApp(t)
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
+
+func setUpBuildLogsTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, buildLogsTestOrgId, buildLogsTestUserIdpId, buildLogsTestOrgName)
+	_ = apitestutils.CreateProject(t, buildLogsTestProjId, buildLogsTestOrgId, buildLogsTestProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), buildLogsTestOrgId, buildLogsTestProjId, buildLogsTestAgentName, string(utils.InternalAgent))
+}
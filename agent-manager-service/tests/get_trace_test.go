@@ -30,9 +30,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
 )
 
@@ -204,4 +207,376 @@ func TestGetTrace(t *testing.T) {
 		// Validate service was called
 		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
 	})
+
+	t.Run("Getting trace details with a time hint should search that time range", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development&startTime=%s&endTime=%s",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123",
+			"2025-06-01T00:00:00Z", "2025-06-02T00:00:00Z")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
+		traceDetailsCall := traceObserverClient.TraceDetailsByIdCalls()[0]
+		require.Equal(t, "2025-06-01T00:00:00Z", traceDetailsCall.Params.StartTime)
+		require.Equal(t, "2025-06-02T00:00:00Z", traceDetailsCall.Params.EndTime)
+	})
+
+	t.Run("Getting trace details should apply the organization's redaction policy", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		getTraceDetails := traceObserverClient.TraceDetailsByIdFunc
+		traceObserverClient.TraceDetailsByIdFunc = func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			response, err := getTraceDetails(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+			response.Spans[1].Attributes["db.statement"] = "SELECT * FROM users WHERE email = '[REDACTED:email]'"
+			response.Spans[1].Redaction = &traceobserversvc.Redaction{Policy: params.OrgName, Count: 1, Types: []string{"email"}}
+			return response, nil
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
+		require.Equal(t, traceDetailsOrgName, traceObserverClient.TraceDetailsByIdCalls()[0].Params.OrgName)
+
+		var response traceobserversvc.TraceResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Spans, 2)
+		require.Nil(t, response.Spans[0].Redaction)
+		require.Equal(t, &traceobserversvc.Redaction{Policy: traceDetailsOrgName, Count: 1, Types: []string{"email"}},
+			response.Spans[1].Redaction)
+		require.Equal(t, "SELECT * FROM users WHERE email = '[REDACTED:email]'", response.Spans[1].Attributes["db.statement"])
+	})
+
+	t.Run("Getting trace details should return span events, links and error messages", func(t *testing.T) {
+		eventTime := time.Date(2025, 6, 1, 10, 0, 1, 0, time.UTC)
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		getTraceDetails := traceObserverClient.TraceDetailsByIdFunc
+		traceObserverClient.TraceDetailsByIdFunc = func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			response, err := getTraceDetails(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+			response.Spans[1].Status = "2"
+			response.Spans[1].StatusMessage = "tool failed"
+			response.Spans[1].Events = []traceobserversvc.SpanEvent{
+				{
+					Name: "exception",
+					Time: eventTime,
+					Kind: "exception",
+					Attributes: map[string]interface{}{
+						"exception.type":    "ValueError",
+						"exception.message": "unknown city: Atlantis",
+					},
+				},
+			}
+			response.Spans[1].Links = []traceobserversvc.SpanLink{
+				{TraceID: "trace-id-456", SpanID: "span-id-789", Attributes: map[string]interface{}{"handoff": "billing-agent"}},
+			}
+			response.Spans[1].AmpAttributes = &traceobserversvc.AmpAttributes{
+				Kind:   "tool",
+				Status: &traceobserversvc.SpanStatus{Error: true, ErrorType: "ValueError", ErrorMessage: "unknown city: Atlantis"},
+			}
+			return response, nil
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Spans, 2)
+		require.Empty(t, response.Spans[0].Events)
+		require.Empty(t, response.Spans[0].Links)
+
+		span := response.Spans[1]
+		require.Equal(t, "tool failed", span.StatusMessage)
+		require.Equal(t, []models.SpanEvent{
+			{
+				Name:      "exception",
+				Timestamp: eventTime,
+				Kind:      "exception",
+				Attributes: map[string]interface{}{
+					"exception.type":    "ValueError",
+					"exception.message": "unknown city: Atlantis",
+				},
+			},
+		}, span.Events)
+		require.Equal(t, []models.SpanLink{
+			{TraceID: "trace-id-456", SpanID: "span-id-789", Attributes: map[string]interface{}{"handoff": "billing-agent"}},
+		}, span.Links)
+		require.NotNil(t, span.AmpAttributes)
+		require.Equal(t, &traceobserversvc.SpanStatus{Error: true, ErrorType: "ValueError", ErrorMessage: "unknown city: Atlantis"},
+			span.AmpAttributes.Status)
+	})
+
+	t.Run("Getting trace details with an invalid time hint should return 400", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		for _, query := range []string{
+			"startTime=2025-06-01T00:00:00Z",
+			"startTime=yesterday&endTime=2025-06-02T00:00:00Z",
+			"startTime=2025-06-02T00:00:00Z&endTime=2025-06-01T00:00:00Z",
+		} {
+			url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development&%s",
+				traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123", query)
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+		require.Empty(t, traceObserverClient.TraceDetailsByIdCalls())
+	})
+
+	t.Run("Looking up a trace across the organization should report the agents that took part", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListProjectsFunc = func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+			return []*models.ProjectResponse{{Name: "project-a"}, {Name: "project-b"}}, nil
+		}
+		openChoreoClient.ListAgentComponentsFunc = func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			if projName == "project-a" {
+				return []*openchoreosvc.AgentComponent{{UUID: "component-uid-planner", Name: "planner"}}, nil
+			}
+			return []*openchoreosvc.AgentComponent{
+				{UUID: "component-uid-search", Name: "search"},
+				{UUID: "component-uid-idle", Name: "idle"},
+			}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+				return &traceobserversvc.TraceResponse{
+					Spans: []traceobserversvc.Span{
+						{TraceID: params.TraceID, SpanID: "span-1", Name: "plan", Service: "component-uid-planner"},
+						{TraceID: params.TraceID, SpanID: "span-2", ParentSpanID: "span-1", Name: "search", Service: "component-uid-search"},
+						{TraceID: params.TraceID, SpanID: "span-3", ParentSpanID: "span-2", Name: "fetch", Service: "component-uid-search"},
+					},
+					TotalCount: 3,
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/traces/%s?environment=Development", traceDetailsOrgName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.TraceResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, 3, response.TotalCount)
+		require.Equal(t, []models.TraceAgent{
+			{ProjectName: "project-a", AgentName: "planner"},
+			{ProjectName: "project-b", AgentName: "search"},
+		}, response.Agents)
+
+		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
+		params := traceObserverClient.TraceDetailsByIdCalls()[0].Params
+		require.Equal(t, "trace-id-123", params.TraceID)
+		require.Equal(t, []string{"component-uid-idle", "component-uid-planner", "component-uid-search"}, params.ComponentUids)
+		require.Equal(t, "environment-uid-123", params.EnvironmentUid)
+		require.Equal(t, traceDetailsOrgName, params.OrgName)
+	})
+
+	t.Run("Getting a full trace should annotate the spans of each agent and mark agent boundaries", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListProjectsFunc = func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+			return []*models.ProjectResponse{{Name: traceDetailsProjName}, {Name: "retrieval-project"}}, nil
+		}
+		openChoreoClient.ListAgentComponentsFunc = func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			if projName == traceDetailsProjName {
+				return []*openchoreosvc.AgentComponent{{UUID: "component-uid-123", Name: traceDetailsAgentName}}, nil
+			}
+			return []*openchoreosvc.AgentComponent{{UUID: "component-uid-retrieval", Name: "retrieval"}}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+				return &traceobserversvc.TraceResponse{
+					Spans: []traceobserversvc.Span{
+						{TraceID: params.TraceID, SpanID: "span-1", Name: "orchestrate", Service: "component-uid-123"},
+						{TraceID: params.TraceID, SpanID: "span-2", ParentSpanID: "span-1", Name: "POST /retrieve", Service: "component-uid-123"},
+						{TraceID: params.TraceID, SpanID: "span-3", ParentSpanID: "span-2", Name: "retrieve", Service: "component-uid-retrieval"},
+						{TraceID: params.TraceID, SpanID: "span-4", ParentSpanID: "span-3", Name: "embed", Service: "component-uid-retrieval"},
+					},
+					TotalCount: 4,
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development&fullTrace=true",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.TraceResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Spans, 4)
+		require.Equal(t, []models.TraceAgent{
+			{ProjectName: "retrieval-project", AgentName: "retrieval"},
+			{ProjectName: traceDetailsProjName, AgentName: traceDetailsAgentName},
+		}, response.Agents)
+
+		orchestrator := &models.TraceAgent{ProjectName: traceDetailsProjName, AgentName: traceDetailsAgentName}
+		retrieval := &models.TraceAgent{ProjectName: "retrieval-project", AgentName: "retrieval"}
+		require.Equal(t, orchestrator, response.Spans[0].Agent)
+		require.Equal(t, orchestrator, response.Spans[1].Agent)
+		require.Equal(t, retrieval, response.Spans[2].Agent)
+		require.Equal(t, retrieval, response.Spans[3].Agent)
+		require.False(t, response.Spans[0].AgentBoundary)
+		require.False(t, response.Spans[1].AgentBoundary)
+		require.True(t, response.Spans[2].AgentBoundary)
+		require.False(t, response.Spans[3].AgentBoundary)
+
+		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
+		params := traceObserverClient.TraceDetailsByIdCalls()[0].Params
+		require.Equal(t, []string{"component-uid-123", "component-uid-retrieval"}, params.ComponentUids)
+	})
+
+	t.Run("Getting a trace with an invalid fullTrace parameter should return 400", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development&fullTrace=maybe",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Empty(t, traceObserverClient.TraceDetailsByIdCalls())
+	})
+
+	t.Run("Project members should look up traces only in the projects they can read", func(t *testing.T) {
+		memberIdpId := uuid.New()
+		memberAuth := jwtassertion.NewMockMiddleware(t, traceDetailsOrgId, memberIdpId)
+		setMemberRole(t, authMiddleware, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/members/%s", traceDetailsOrgName, traceDetailsProjName, memberIdpId), utils.RoleViewer, http.StatusOK)
+
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListProjectsFunc = func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+			return []*models.ProjectResponse{{Name: traceDetailsProjName}, {Name: "restricted-project"}}, nil
+		}
+		openChoreoClient.ListAgentComponentsFunc = func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			if projName == traceDetailsProjName {
+				return []*openchoreosvc.AgentComponent{{UUID: "component-uid-123", Name: traceDetailsAgentName}}, nil
+			}
+			return []*openchoreosvc.AgentComponent{{UUID: "component-uid-restricted", Name: "restricted"}}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+				if params.TraceID == "restricted-trace-id" {
+					return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotFound}
+				}
+				return &traceobserversvc.TraceResponse{
+					Spans: []traceobserversvc.Span{
+						{TraceID: params.TraceID, SpanID: "span-1", Name: "orchestrate", Service: "component-uid-123"},
+					},
+					TotalCount: 1,
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, memberAuth)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/traces/%s?environment=Development", traceDetailsOrgName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.TraceResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, []models.TraceAgent{{ProjectName: traceDetailsProjName, AgentName: traceDetailsAgentName}}, response.Agents)
+
+		url = fmt.Sprintf("/api/v1/orgs/%s/traces/%s?environment=Development", traceDetailsOrgName, "restricted-trace-id")
+		req = httptest.NewRequest(http.MethodGet, url, nil)
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		calls := traceObserverClient.TraceDetailsByIdCalls()
+		require.Len(t, calls, 2)
+		for _, call := range calls {
+			require.Equal(t, []string{"component-uid-123"}, call.Params.ComponentUids)
+		}
+	})
+
+	t.Run("Looking up a trace in an organization without agents should return 404", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListProjectsFunc = func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+			return []*models.ProjectResponse{}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/traces/%s?environment=Development", traceDetailsOrgName, "trace-id-123")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		require.Empty(t, traceObserverClient.TraceDetailsByIdCalls())
+	})
 }
@@ -0,0 +1,278 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	jwtTestOrgId     = uuid.New()
+	jwtTestUserIdpId = uuid.New()
+	jwtTestOrgName   = fmt.Sprintf("jwt-test-org-%s", uuid.New().String()[:5])
+)
+
+const (
+	jwtTestIssuer   = "https://idp.example.com"
+	jwtTestAudience = "agent-manager"
+)
+
+type jwtTestKeys struct {
+	rsaKey   *rsa.PrivateKey
+	ecKey    *ecdsa.PrivateKey
+	otherKey *rsa.PrivateKey
+}
+
+func TestJWTVerification(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, jwtTestOrgId, jwtTestUserIdpId, jwtTestOrgName)
+
+	keys := newJWTTestKeys(t)
+	jwksServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": "rsa-key",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(keys.rsaKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(keys.rsaKey.E)).Bytes()),
+				},
+				{
+					"kty": "EC",
+					"kid": "ec-key",
+					"use": "sig",
+					"crv": "P-256",
+					"x":   base64.RawURLEncoding.EncodeToString(keys.ecKey.X.FillBytes(make([]byte, 32))),
+					"y":   base64.RawURLEncoding.EncodeToString(keys.ecKey.Y.FillBytes(make([]byte, 32))),
+				},
+			},
+		})
+	}))
+	defer jwksServer.Close()
+
+	verifier := jwtassertion.NewVerifier(jwtassertion.VerifierConfig{
+		JWKSURL:   jwksServer.URL,
+		Issuer:    jwtTestIssuer,
+		Audience:  jwtTestAudience,
+		CacheTTL:  time.Minute,
+		ClockSkew: 30 * time.Second,
+	})
+	authMiddleware := jwtassertion.JWTVerificationMiddleware("Authorization", verifier)
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, authMiddleware)
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub":   jwtTestUserIdpId.String(),
+			"scope": "openid",
+			"iss":   jwtTestIssuer,
+			"aud":   []string{jwtTestAudience, "other"},
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"nbf":   time.Now().Add(-time.Minute).Unix(),
+		}
+	}
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	sendRequest := func(t *testing.T, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/orgs", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	successTests := []struct {
+		name  string
+		token string
+	}{
+		{
+			name:  "Token signed with RS256 should be accepted",
+			token: signRS256(t, keys.rsaKey, "rsa-key", validClaims()),
+		},
+		{
+			name:  "Token signed with ES256 should be accepted",
+			token: signES256(t, keys.ecKey, "ec-key", validClaims()),
+		},
+		{
+			name:  "Token with a single audience string should be accepted",
+			token: signRS256(t, keys.rsaKey, "rsa-key", withClaim("aud", jwtTestAudience)),
+		},
+	}
+	for _, tt := range successTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := sendRequest(t, tt.token)
+			require.Equal(t, http.StatusOK, rr.Code)
+
+			var response spec.OrganizationListResponse
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+			require.Len(t, response.Organizations, 1)
+			require.Equal(t, jwtTestOrgName, response.Organizations[0].Name)
+		})
+	}
+
+	failureTests := []struct {
+		name       string
+		token      string
+		wantErrMsg string
+	}{
+		{
+			name:       "Expired token should return 401",
+			token:      signRS256(t, keys.rsaKey, "rsa-key", withClaim("exp", time.Now().Add(-time.Hour).Unix())),
+			wantErrMsg: "invalid jwt: token has expired",
+		},
+		{
+			name:       "Token without expiry should return 401",
+			token:      signRS256(t, keys.rsaKey, "rsa-key", withClaim("exp", nil)),
+			wantErrMsg: "invalid jwt: token has no expiry",
+		},
+		{
+			name:       "Token used before nbf should return 401",
+			token:      signRS256(t, keys.rsaKey, "rsa-key", withClaim("nbf", time.Now().Add(time.Hour).Unix())),
+			wantErrMsg: "invalid jwt: token is not valid yet",
+		},
+		{
+			name:       "Token from another issuer should return 401",
+			token:      signRS256(t, keys.rsaKey, "rsa-key", withClaim("iss", "https://evil.example.com")),
+			wantErrMsg: "invalid jwt: invalid token issuer",
+		},
+		{
+			name:       "Token for another audience should return 401",
+			token:      signRS256(t, keys.rsaKey, "rsa-key", withClaim("aud", "other")),
+			wantErrMsg: "invalid jwt: invalid token audience",
+		},
+		{
+			name:       "Token signed with a different key should return 401",
+			token:      signRS256(t, keys.otherKey, "rsa-key", validClaims()),
+			wantErrMsg: "invalid jwt: invalid token signature",
+		},
+		{
+			name:       "Token with an unknown key id should return 401",
+			token:      signRS256(t, keys.rsaKey, "unknown-key", validClaims()),
+			wantErrMsg: "invalid jwt: unknown signing key",
+		},
+		{
+			name:       "Unsigned token should return 401",
+			token:      encodeJWTSegment(t, map[string]string{"alg": "none"}) + "." + encodeJWTSegment(t, validClaims()) + ".",
+			wantErrMsg: "invalid jwt: unsupported signing algorithm",
+		},
+		{
+			name:       "Malformed token should return 401",
+			token:      "not-a-jwt",
+			wantErrMsg: "invalid jwt: malformed token",
+		},
+	}
+	for _, tt := range failureTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := sendRequest(t, tt.token)
+			require.Equal(t, http.StatusUnauthorized, rr.Code)
+
+			body, err := io.ReadAll(rr.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), tt.wantErrMsg)
+		})
+	}
+
+	t.Run("Unreachable JWKS should return 503", func(t *testing.T) {
+		var fetches atomic.Int32
+		unavailableServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fetches.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer unavailableServer.Close()
+		unavailableVerifier := jwtassertion.NewVerifier(jwtassertion.VerifierConfig{
+			JWKSURL:  unavailableServer.URL,
+			CacheTTL: time.Minute,
+		})
+		unavailableApp := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, jwtassertion.JWTVerificationMiddleware("Authorization", unavailableVerifier))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/orgs", nil)
+		req.Header.Set("Authorization", "Bearer "+signRS256(t, keys.rsaKey, "rsa-key", validClaims()))
+		rr := httptest.NewRecorder()
+		unavailableApp.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+
+		// A failed fetch is not retried for every request
+		rr = httptest.NewRecorder()
+		unavailableApp.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		require.Equal(t, int32(1), fetches.Load())
+	})
+}
+
+func newJWTTestKeys(t *testing.T) *jwtTestKeys {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &jwtTestKeys{rsaKey: rsaKey, ecKey: ecKey, otherKey: otherKey}
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	signingInput := encodeJWTSegment(t, map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid}) + "." + encodeJWTSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	signingInput := encodeJWTSegment(t, map[string]string{"alg": "ES256", "typ": "JWT", "kid": kid}) + "." + encodeJWTSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeJWTSegment(t *testing.T, v interface{}) string {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -23,14 +23,17 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	neturl "net/url"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
 )
@@ -178,6 +181,220 @@ func TestListTraces(t *testing.T) {
 		require.Equal(t, "asc", listTracesCall.Params.SortOrder)
 	})
 
+	t.Run("Listing traces with a cursor should pass it on and return the next cursor", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClient()
+		listTraces := traceObserverClient.ListTracesFunc
+		traceObserverClient.ListTracesFunc = func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+			response, err := listTraces(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+			response.NextCursor = "next-page-cursor"
+			return response, nil
+		}
+		openChoreoClient := createMockOpenChoreoClient()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		// Send the request with a cursor
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&limit=2&cursor=page-cursor",
+			tracesOrgName, tracesProjName, tracesAgentName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response traceobserversvc.TraceOverviewResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, "next-page-cursor", response.NextCursor)
+
+		// Validate call parameters
+		require.Len(t, traceObserverClient.ListTracesCalls(), 1)
+		listTracesCall := traceObserverClient.ListTracesCalls()[0]
+		require.Equal(t, "page-cursor", listTracesCall.Params.Cursor)
+		require.Equal(t, 2, listTracesCall.Params.Limit)
+	})
+
+	t.Run("Listing traces with a cursor rejected by the trace observer should return 400", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+				return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusBadRequest, Message: "cursor is invalid"}
+			},
+		}
+		openChoreoClient := createMockOpenChoreoClient()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&cursor=not-a-cursor",
+			tracesOrgName, tracesProjName, tracesAgentName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "Invalid cursor")
+	})
+
+	t.Run("Listing traces with both cursor and offset should return 400", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClient()
+		openChoreoClient := createMockOpenChoreoClient()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&cursor=page-cursor&offset=10",
+			tracesOrgName, tracesProjName, tracesAgentName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+
+		// Validate no service calls were made
+		require.Len(t, traceObserverClient.ListTracesCalls(), 0)
+	})
+
+	t.Run("Listing traces with filters should pass them to the trace observer", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClient()
+		openChoreoClient := createMockOpenChoreoClient()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		// Send the request with filters
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&spanKind=tool&errorsOnly=true"+
+			"&model=gpt-4o&toolName=search_orders&search=order+%%231234&attribute=gen_ai.system%%3Dopenai&minDuration=10s&maxDuration=1m",
+			tracesOrgName, tracesProjName, tracesAgentName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		// Validate call parameters
+		require.Len(t, traceObserverClient.ListTracesCalls(), 1)
+		listTracesCall := traceObserverClient.ListTracesCalls()[0]
+		require.Equal(t, neturl.Values{
+			"spanKind":    {"tool"},
+			"errorsOnly":  {"true"},
+			"model":       {"gpt-4o"},
+			"toolName":    {"search_orders"},
+			"search":      {"order #1234"},
+			"attribute":   {"gen_ai.system=openai"},
+			"minDuration": {"10s"},
+			"maxDuration": {"1m"},
+		}, listTracesCall.Params.Filters)
+	})
+
+	t.Run("Listing traces with filters matching too many traces should report the truncation", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+				return &traceobserversvc.TraceOverviewResponse{
+					Traces:     []traceobserversvc.TraceOverview{},
+					TotalCount: 0,
+					Truncated:  true,
+				}, nil
+			},
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&errorsOnly=true",
+			tracesOrgName, tracesProjName, tracesAgentName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.True(t, response.Truncated)
+	})
+
+	t.Run("Listing traces with filters rejected by the trace observer should return 400", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+				return nil, &traceobserversvc.HTTPError{
+					StatusCode: http.StatusBadRequest,
+					Message:    `{"error":"error","message":"spanKind 'database' is not a valid span kind"}`,
+				}
+			},
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&spanKind=database",
+			tracesOrgName, tracesProjName, tracesAgentName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "invalid filter parameter: spanKind 'database' is not a valid span kind")
+		require.Len(t, traceObserverClient.ListTracesCalls(), 1)
+		require.Equal(t, "database", traceObserverClient.ListTracesCalls()[0].Params.Filters.Get("spanKind"))
+	})
+
+	invalidFilterTests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown rating", query: "rating=neutral"},
+	}
+
+	for _, tt := range invalidFilterTests {
+		t.Run(fmt.Sprintf("Listing traces with %s should return 400", tt.name), func(t *testing.T) {
+			traceObserverClient := createMockTraceObserverClient()
+			openChoreoClient := createMockOpenChoreoClient()
+			testClients := wiring.TestClients{
+				OpenChoreoSvcClient: openChoreoClient,
+				TraceObserverClient: traceObserverClient,
+			}
+
+			app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+			url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development&%s",
+				tracesOrgName, tracesProjName, tracesAgentName, tt.query)
+			req := httptest.NewRequest(http.MethodGet, url, nil)
+
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), "Invalid filter parameter")
+
+			// Validate no service calls were made
+			require.Len(t, traceObserverClient.ListTracesCalls(), 0)
+		})
+	}
+
 	t.Run("Listing traces with time range should return 200", func(t *testing.T) {
 		traceObserverClient := createMockTraceObserverClient()
 		openChoreoClient := createMockOpenChoreoClient()
@@ -377,4 +594,87 @@ func TestListTraces(t *testing.T) {
 		// Validate no service calls were made
 		require.Len(t, traceObserverClient.ListTracesCalls(), 0)
 	})
+
+	t.Run("Listing the traces of a project should query all its agents", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListAgentComponentsFunc = func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			return []*openchoreosvc.AgentComponent{
+				{UUID: "component-uid-b", Name: "agent-b"},
+				{UUID: "component-uid-a", Name: "agent-a"},
+			}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+				return &traceobserversvc.TraceOverviewResponse{
+					Traces: []traceobserversvc.TraceOverview{
+						{TraceID: "trace-id-1", RootSpanID: "root-span-1", ComponentUid: "component-uid-a"},
+						{TraceID: "trace-id-2", RootSpanID: "root-span-2", ComponentUid: "component-uid-b"},
+					},
+					TotalCount: 2,
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/traces?environment=Development&errorsOnly=true", tracesOrgName, tracesProjName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, 2, response.TotalCount)
+		require.Len(t, response.Traces, 2)
+		require.Equal(t, "agent-a", response.Traces[0].AgentName)
+		require.Equal(t, "agent-b", response.Traces[1].AgentName)
+
+		require.Len(t, traceObserverClient.ListTracesCalls(), 1)
+		params := traceObserverClient.ListTracesCalls()[0].Params
+		require.Equal(t, []string{"component-uid-a", "component-uid-b"}, params.ComponentUids)
+		require.Empty(t, params.ComponentUid)
+		require.Equal(t, "environment-uid-123", params.EnvironmentUid)
+		require.Equal(t, "true", params.Filters.Get("errorsOnly"))
+	})
+
+	t.Run("Listing the traces of a project without agents should return no traces", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListAgentComponentsFunc = func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			return []*openchoreosvc.AgentComponent{}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/traces?environment=Development", tracesOrgName, tracesProjName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Empty(t, response.Traces)
+		require.Empty(t, traceObserverClient.ListTracesCalls())
+	})
+
+	t.Run("Listing the traces of an unknown project should return 404", func(t *testing.T) {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: &clientmocks.TraceObserverClientMock{},
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/traces?environment=Development", tracesOrgName, "missing-project")
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
 }
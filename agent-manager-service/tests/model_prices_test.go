@@ -0,0 +1,454 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func callModelPriceAPI(t *testing.T, authMiddleware jwtassertion.Middleware, method string, url string, payload interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, authMiddleware)
+	var body bytes.Buffer
+	if payload != nil {
+		require.NoError(t, json.NewEncoder(&body).Encode(payload))
+	}
+	req := httptest.NewRequest(method, url, &body)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	return rr
+}
+
+func createModelPrice(t *testing.T, authMiddleware jwtassertion.Middleware, orgName string, payload spec.ModelPriceRequest) spec.ModelPriceResponse {
+	t.Helper()
+	rr := callModelPriceAPI(t, authMiddleware, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/model-prices", orgName), payload)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var price spec.ModelPriceResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &price))
+	return price
+}
+
+func TestModelPrices(t *testing.T) {
+	orgId := uuid.New()
+	ownerIdpId := uuid.New()
+	viewerIdpId := uuid.New()
+	orgName := fmt.Sprintf("pricing-org-%s", uuid.New().String()[:5])
+	_ = apitestutils.CreateOrganization(t, orgId, ownerIdpId, orgName)
+	ownerAuth := jwtassertion.NewMockMiddleware(t, orgId, ownerIdpId)
+	viewerAuth := jwtassertion.NewMockMiddleware(t, orgId, viewerIdpId)
+	setMemberRole(t, ownerAuth, fmt.Sprintf("/api/v1/orgs/%s/members/%s", orgName, viewerIdpId), utils.RoleViewer, http.StatusOK)
+
+	pricesURL := fmt.Sprintf("/api/v1/orgs/%s/model-prices", orgName)
+	vendor := "openai"
+	effectiveFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	price := createModelPrice(t, ownerAuth, orgName, spec.ModelPriceRequest{
+		Vendor:           &vendor,
+		Model:            "gpt-4o",
+		InputPricePer1k:  0.0025,
+		OutputPricePer1k: 0.01,
+		EffectiveFrom:    &effectiveFrom,
+	})
+
+	t.Run("Created model price should be listed", func(t *testing.T) {
+		require.Equal(t, "gpt-4o", price.Model)
+		require.Equal(t, utils.ModelPriceCurrency, price.Currency)
+		require.Equal(t, ownerIdpId.String(), price.CreatedBy)
+
+		rr := callModelPriceAPI(t, viewerAuth, http.MethodGet, pricesURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list spec.ModelPriceListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Prices, 1)
+		require.Equal(t, price.Id, list.Prices[0].Id)
+		require.Equal(t, "openai", list.Prices[0].GetVendor())
+		require.Equal(t, 0.01, list.Prices[0].OutputPricePer1k)
+	})
+
+	t.Run("Viewer should not be allowed to create a model price", func(t *testing.T) {
+		rr := callModelPriceAPI(t, viewerAuth, http.MethodPost, pricesURL, spec.ModelPriceRequest{
+			Model:            "gpt-4o-mini",
+			InputPricePer1k:  0.00015,
+			OutputPricePer1k: 0.0006,
+		})
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Creating a model price with invalid values should return 400", func(t *testing.T) {
+		rr := callModelPriceAPI(t, ownerAuth, http.MethodPost, pricesURL, spec.ModelPriceRequest{
+			Model:            "gpt-4o-mini",
+			InputPricePer1k:  -1,
+			OutputPricePer1k: 0.0006,
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+
+		effectiveTo := effectiveFrom.Add(-time.Hour)
+		rr = callModelPriceAPI(t, ownerAuth, http.MethodPost, pricesURL, spec.ModelPriceRequest{
+			Model:            "gpt-4o-mini",
+			InputPricePer1k:  0.00015,
+			OutputPricePer1k: 0.0006,
+			EffectiveFrom:    &effectiveFrom,
+			EffectiveTo:      &effectiveTo,
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Creating an overlapping price of the same model should return 409", func(t *testing.T) {
+		laterFrom := effectiveFrom.AddDate(0, 6, 0)
+		rr := callModelPriceAPI(t, ownerAuth, http.MethodPost, pricesURL, spec.ModelPriceRequest{
+			Vendor:           &vendor,
+			Model:            "GPT-4o",
+			InputPricePer1k:  0.002,
+			OutputPricePer1k: 0.008,
+			EffectiveFrom:    &laterFrom,
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Concurrently creating overlapping prices should create only one of them", func(t *testing.T) {
+		const attempts = 4
+		codes := make([]int, attempts)
+		var wg sync.WaitGroup
+		for i := range codes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rr := callModelPriceAPI(t, ownerAuth, http.MethodPost, pricesURL, spec.ModelPriceRequest{
+					Model:            "o1-concurrent",
+					InputPricePer1k:  0.015,
+					OutputPricePer1k: 0.06,
+					EffectiveFrom:    &effectiveFrom,
+				})
+				codes[i] = rr.Code
+			}()
+		}
+		wg.Wait()
+
+		created := 0
+		for _, code := range codes {
+			if code == http.StatusCreated {
+				created++
+				continue
+			}
+			require.Equal(t, http.StatusConflict, code)
+		}
+		require.Equal(t, 1, created)
+	})
+
+	t.Run("Updating and deleting a model price should succeed", func(t *testing.T) {
+		other := createModelPrice(t, ownerAuth, orgName, spec.ModelPriceRequest{
+			Model:            "claude-3-5-sonnet",
+			InputPricePer1k:  0.003,
+			OutputPricePer1k: 0.015,
+		})
+
+		rr := callModelPriceAPI(t, ownerAuth, http.MethodPut, fmt.Sprintf("%s/%s", pricesURL, other.Id), spec.ModelPriceRequest{
+			Model:            "claude-3-5-sonnet",
+			InputPricePer1k:  0.004,
+			OutputPricePer1k: 0.02,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var updated spec.ModelPriceResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &updated))
+		require.Equal(t, 0.004, updated.InputPricePer1k)
+		require.WithinDuration(t, other.EffectiveFrom, updated.EffectiveFrom, time.Millisecond)
+
+		rr = callModelPriceAPI(t, ownerAuth, http.MethodDelete, fmt.Sprintf("%s/%s", pricesURL, other.Id), nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = callModelPriceAPI(t, ownerAuth, http.MethodDelete, fmt.Sprintf("%s/%s", pricesURL, other.Id), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
+
+func TestEstimatedTraceCost(t *testing.T) {
+	orgId := uuid.New()
+	userIdpId := uuid.New()
+	projId := uuid.New()
+	orgName := fmt.Sprintf("cost-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("cost-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("cost-agent-%s", uuid.New().String()[:5])
+	_ = apitestutils.CreateOrganization(t, orgId, userIdpId, orgName)
+	_ = apitestutils.CreateProject(t, projId, orgId, projName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, orgId, userIdpId)
+
+	effectiveFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createModelPrice(t, authMiddleware, orgName, spec.ModelPriceRequest{
+		Model:            "gpt-4o",
+		InputPricePer1k:  0.0025,
+		OutputPricePer1k: 0.01,
+		EffectiveFrom:    &effectiveFrom,
+	})
+	agentURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", orgName, projName, agentName)
+
+	t.Run("Trace overviews should include the estimated cost of priced models", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+				return &traceobserversvc.TraceOverviewResponse{
+					Traces: []traceobserversvc.TraceOverview{
+						{
+							TraceID:   "trace-id-1",
+							StartTime: "2025-12-16T10:00:00Z",
+							ModelUsage: []traceobserversvc.ModelTokenUsage{
+								{Vendor: "openai", Model: "gpt-4o-2024-08-06", InputTokens: 1000, OutputTokens: 500, TotalTokens: 1500},
+								{Vendor: "ollama", Model: "llama3", InputTokens: 150, OutputTokens: 50, TotalTokens: 200},
+							},
+						},
+						{
+							TraceID:   "trace-id-2",
+							StartTime: "2024-12-16T10:00:00Z",
+							ModelUsage: []traceobserversvc.ModelTokenUsage{
+								{Vendor: "openai", Model: "gpt-4o", InputTokens: 1000, OutputTokens: 500, TotalTokens: 1500},
+							},
+						},
+						{
+							TraceID:   "trace-id-3",
+							StartTime: "2025-12-16T10:00:00Z",
+						},
+					},
+					TotalCount: 3,
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, agentURL+"/traces?environment=Development", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Traces, 3)
+
+		cost := response.Traces[0].EstimatedCost
+		require.NotNil(t, cost)
+		require.Equal(t, utils.ModelPriceCurrency, cost.Currency)
+		require.Equal(t, 0.0025, cost.InputCost)
+		require.Equal(t, 0.005, cost.OutputCost)
+		require.Equal(t, 0.0075, cost.TotalCost)
+		require.Equal(t, 200, cost.UnpricedTokens)
+
+		// The price is not yet effective at the start of the second trace
+		require.NotNil(t, response.Traces[1].EstimatedCost)
+		require.Equal(t, 0.0, response.Traces[1].EstimatedCost.TotalCost)
+		require.Equal(t, 1500, response.Traces[1].EstimatedCost.UnpricedTokens)
+
+		require.Nil(t, response.Traces[2].EstimatedCost)
+	})
+
+	t.Run("Trace details should include the estimated cost of the trace and its LLM spans", func(t *testing.T) {
+		spanStart := time.Date(2025, 12, 16, 10, 0, 0, 0, time.UTC)
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+				return &traceobserversvc.TraceResponse{
+					Spans: []traceobserversvc.Span{
+						{
+							TraceID:   "trace-id-1",
+							SpanID:    "span-1",
+							StartTime: spanStart,
+							AmpAttributes: &traceobserversvc.AmpAttributes{
+								Kind: "llm",
+								Data: map[string]interface{}{
+									"model":  "gpt-4o",
+									"vendor": "openai",
+									"tokenUsage": map[string]interface{}{
+										"inputTokens":  float64(2000),
+										"outputTokens": float64(100),
+									},
+								},
+							},
+						},
+						{
+							TraceID:       "trace-id-1",
+							SpanID:        "span-2",
+							StartTime:     spanStart,
+							AmpAttributes: &traceobserversvc.AmpAttributes{Kind: "tool"},
+						},
+					},
+					TotalCount: 2,
+					ModelUsage: []traceobserversvc.ModelTokenUsage{
+						{Vendor: "openai", Model: "gpt-4o", InputTokens: 2000, OutputTokens: 100, TotalTokens: 2100},
+					},
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, agentURL+"/trace/trace-id-1?environment=Development", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response struct {
+			EstimatedCost *models.CostEstimate `json:"estimatedCost"`
+			Spans         []struct {
+				AmpAttributes struct {
+					Data struct {
+						Cost *models.CostEstimate `json:"cost"`
+					} `json:"data"`
+				} `json:"ampAttributes"`
+			} `json:"spans"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.NotNil(t, response.EstimatedCost)
+		require.Equal(t, 0.006, response.EstimatedCost.TotalCost)
+		require.Len(t, response.Spans, 2)
+		require.NotNil(t, response.Spans[0].AmpAttributes.Data.Cost)
+		require.Equal(t, 0.005, response.Spans[0].AmpAttributes.Data.Cost.InputCost)
+		require.Equal(t, 0.001, response.Spans[0].AmpAttributes.Data.Cost.OutputCost)
+		require.Nil(t, response.Spans[1].AmpAttributes.Data.Cost)
+	})
+
+	t.Run("Agent cost should roll up daily usage per model", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetTokenUsageFunc: func(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error) {
+				return &traceobserversvc.TokenUsageResponse{
+					Usage: []traceobserversvc.DailyTokenUsage{
+						{ComponentUid: "component-uid-123", Date: "2025-12-15", ModelTokenUsage: traceobserversvc.ModelTokenUsage{Vendor: "openai", Model: "gpt-4o", InputTokens: 10000, OutputTokens: 1000}},
+						{ComponentUid: "component-uid-123", Date: "2025-12-16", ModelTokenUsage: traceobserversvc.ModelTokenUsage{Vendor: "openai", Model: "gpt-4o", InputTokens: 2000, OutputTokens: 1000}},
+						{ComponentUid: "component-uid-123", Date: "2025-12-16", ModelTokenUsage: traceobserversvc.ModelTokenUsage{Vendor: "ollama", Model: "llama3", InputTokens: 300, OutputTokens: 200}},
+					},
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, agentURL+"/cost?environment=Development&startTime=2025-12-15T00:00:00Z&endTime=2025-12-17T00:00:00Z", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.AgentCostResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, agentName, response.AgentName)
+		require.Equal(t, "Development", response.Environment)
+		require.Equal(t, 14500, response.TotalTokens)
+		require.Equal(t, 0.03, response.InputCost)
+		require.Equal(t, 0.02, response.OutputCost)
+		require.Equal(t, 0.05, response.TotalCost)
+		require.Equal(t, 500, response.UnpricedTokens)
+		require.Len(t, response.Models, 2)
+		require.Equal(t, "gpt-4o", response.Models[0].Model)
+		require.True(t, response.Models[0].Priced)
+		require.Equal(t, 14000, response.Models[0].TotalTokens)
+		require.Equal(t, "llama3", response.Models[1].Model)
+		require.False(t, response.Models[1].Priced)
+
+		require.Len(t, traceObserverClient.GetTokenUsageCalls(), 1)
+		params := traceObserverClient.GetTokenUsageCalls()[0].Params
+		require.Equal(t, []string{"component-uid-123"}, params.ComponentUids)
+		require.Equal(t, "environment-uid-123", params.EnvironmentUid)
+	})
+
+	t.Run("Project cost should roll up the cost of each agent", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClient()
+		openChoreoClient.ListAgentComponentsFunc = func(ctx context.Context, orgName string, projName string) ([]*openchoreosvc.AgentComponent, error) {
+			return []*openchoreosvc.AgentComponent{
+				{UUID: "component-uid-a", Name: "agent-a"},
+				{UUID: "component-uid-b", Name: "agent-b"},
+				{UUID: "component-uid-c", Name: "agent-c"},
+			}, nil
+		}
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetTokenUsageFunc: func(ctx context.Context, params traceobserversvc.TokenUsageParams) (*traceobserversvc.TokenUsageResponse, error) {
+				return &traceobserversvc.TokenUsageResponse{
+					Usage: []traceobserversvc.DailyTokenUsage{
+						{ComponentUid: "component-uid-a", Date: "2025-12-16", ModelTokenUsage: traceobserversvc.ModelTokenUsage{Model: "gpt-4o", InputTokens: 4000, OutputTokens: 0}},
+						{ComponentUid: "component-uid-b", Date: "2025-12-16", ModelTokenUsage: traceobserversvc.ModelTokenUsage{Model: "gpt-4o-mini", InputTokens: 1000, OutputTokens: 1000}},
+					},
+				}, nil
+			},
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/cost?startTime=2025-12-01T00:00:00Z&endTime=2026-01-01T00:00:00Z", orgName, projName)
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.ProjectCostResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, projName, response.ProjectName)
+		require.Empty(t, response.Environment)
+		require.Len(t, response.Agents, 2)
+		require.Equal(t, "agent-a", response.Agents[0].AgentName)
+		require.Equal(t, 0.01, response.Agents[0].TotalCost)
+		// gpt-4o-mini is a different model, not a version of gpt-4o
+		require.Equal(t, "agent-b", response.Agents[1].AgentName)
+		require.Equal(t, 2000, response.Agents[1].UnpricedTokens)
+		require.Equal(t, 0.01, response.TotalCost)
+		require.Equal(t, 6000, response.TotalTokens)
+
+		require.Len(t, traceObserverClient.GetTokenUsageCalls(), 1)
+		params := traceObserverClient.GetTokenUsageCalls()[0].Params
+		require.ElementsMatch(t, []string{"component-uid-a", "component-uid-b", "component-uid-c"}, params.ComponentUids)
+		require.Empty(t, params.EnvironmentUid)
+	})
+
+	t.Run("Cost with an invalid time range should return 400", func(t *testing.T) {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: &clientmocks.TraceObserverClientMock{},
+		}, authMiddleware)
+
+		for _, query := range []string{
+			"startTime=2025-12-16T00:00:00Z",
+			"startTime=2025-12-17T00:00:00Z&endTime=2025-12-16T00:00:00Z",
+			"startTime=2024-01-01T00:00:00Z&endTime=2025-12-16T00:00:00Z",
+		} {
+			req := httptest.NewRequest(http.MethodGet, agentURL+"/cost?"+query, nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+}
@@ -0,0 +1,281 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	promoteTestOrgId     = uuid.New()
+	promoteTestUserIdpId = uuid.New()
+	promoteTestProjId    = uuid.New()
+	promoteTestAgentId   = uuid.New()
+	promoteTestOrgName   = fmt.Sprintf("promote-test-org-%s", uuid.New().String()[:5])
+	promoteTestProjName  = fmt.Sprintf("promote-test-project-%s", uuid.New().String()[:5])
+	promoteTestAgentName = fmt.Sprintf("promote-test-agent-%s", uuid.New().String()[:5])
+)
+
+func createMockOpenChoreoClientForPromote() *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetProjectFunc: func(ctx context.Context, projectName string, orgName string) (*models.ProjectResponse, error) {
+			return &models.ProjectResponse{
+				Name:               projectName,
+				DisplayName:        projectName,
+				OrgName:            orgName,
+				DeploymentPipeline: "default",
+				CreatedAt:          time.Now(),
+			}, nil
+		},
+		GetDeploymentPipelineFunc: func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
+			return &models.DeploymentPipelineResponse{
+				Name:      deploymentPipelineName,
+				OrgName:   orgName,
+				CreatedAt: time.Now(),
+				PromotionPaths: []models.PromotionPath{
+					{
+						SourceEnvironmentRef:  "development",
+						TargetEnvironmentRefs: []models.TargetEnvironmentRef{{Name: "staging"}},
+					},
+					{
+						SourceEnvironmentRef:  "staging",
+						TargetEnvironmentRefs: []models.TargetEnvironmentRef{{Name: "production"}},
+					},
+				},
+			}, nil
+		},
+		GetEnvironmentFunc: func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+			return &models.EnvironmentResponse{
+				Name:        environmentName,
+				DisplayName: environmentName,
+				CreatedAt:   time.Now(),
+			}, nil
+		},
+		PromoteAgentComponentFunc: func(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+			return &models.PromotionResponse{
+				AgentName:         componentName,
+				ProjectName:       projName,
+				SourceEnvironment: sourceEnv,
+				TargetEnvironment: targetEnv,
+				ReleaseName:       componentName + "-release-1",
+				PromotedBy:        promotedBy,
+				PromotedAt:        time.Now(),
+			}, nil
+		},
+	}
+}
+
+func TestPromoteAgent(t *testing.T) {
+	setUpPromoteTest(t)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, promoteTestOrgId, promoteTestUserIdpId)
+
+	t.Run("Promoting agent without target should use the next pipeline environment and return 202", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForPromote()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments/%s/promote",
+			promoteTestOrgName, promoteTestProjName, promoteTestAgentName, "development")
+		req := httptest.NewRequest(http.MethodPost, url, nil)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		b, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+		t.Logf("response body: %s", string(b))
+
+		var response spec.PromoteAgentResponse
+		require.NoError(t, json.Unmarshal(b, &response))
+		require.Equal(t, promoteTestAgentName, response.AgentName)
+		require.Equal(t, "development", response.SourceEnvironment)
+		require.Equal(t, "staging", response.TargetEnvironment)
+		require.Equal(t, promoteTestUserIdpId.String(), response.PromotedBy)
+
+		require.Len(t, openChoreoClient.PromoteAgentComponentCalls(), 1)
+		promoteCall := openChoreoClient.PromoteAgentComponentCalls()[0]
+		require.Equal(t, promoteTestOrgName, promoteCall.OrgName)
+		require.Equal(t, promoteTestProjName, promoteCall.ProjName)
+		require.Equal(t, promoteTestAgentName, promoteCall.ComponentName)
+		require.Equal(t, "development", promoteCall.SourceEnv)
+		require.Equal(t, "staging", promoteCall.TargetEnv)
+		require.Equal(t, promoteTestUserIdpId.String(), promoteCall.PromotedBy)
+
+		// The development environment has no history, so only the promoted release is recorded
+		var revision models.DeploymentRevision
+		require.NoError(t, db.DB(context.Background()).
+			Where("agent_id = ? AND environment = ?", promoteTestAgentId, "staging").First(&revision).Error)
+		require.Equal(t, promoteTestAgentName+"-release-1", revision.ReleaseName)
+		require.Equal(t, string(utils.DeploymentActionPromote), revision.Action)
+		require.Nil(t, revision.SourceRevision)
+	})
+
+	t.Run("Promoting agent to an explicit target should return 202", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForPromote()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"targetEnvironment": "production",
+		})
+		require.NoError(t, err)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments/%s/promote",
+			promoteTestOrgName, promoteTestProjName, promoteTestAgentName, "staging")
+		req := httptest.NewRequest(http.MethodPost, url, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		require.Len(t, openChoreoClient.PromoteAgentComponentCalls(), 1)
+		require.Equal(t, "production", openChoreoClient.PromoteAgentComponentCalls()[0].TargetEnv)
+	})
+
+	validationTests := []struct {
+		name       string
+		sourceEnv  string
+		payload    map[string]interface{}
+		wantStatus int
+		wantErrMsg string
+		setupMock  func() *clientmocks.OpenChoreoSvcClientMock
+	}{
+		{
+			name:      "return 400 when skipping an environment in the pipeline",
+			sourceEnv: "development",
+			payload: map[string]interface{}{
+				"targetEnvironment": "production",
+			},
+			wantStatus: 400,
+			wantErrMsg: "Promotion path is not allowed by the deployment pipeline",
+			setupMock:  createMockOpenChoreoClientForPromote,
+		},
+		{
+			name:       "return 400 when promoting from the last environment",
+			sourceEnv:  "production",
+			wantStatus: 400,
+			wantErrMsg: "Promotion path is not allowed by the deployment pipeline",
+			setupMock:  createMockOpenChoreoClientForPromote,
+		},
+		{
+			name:       "return 404 when agent is not deployed in the source environment",
+			sourceEnv:  "development",
+			wantStatus: 404,
+			wantErrMsg: "Agent is not deployed in environment development",
+			setupMock: func() *clientmocks.OpenChoreoSvcClientMock {
+				mock := createMockOpenChoreoClientForPromote()
+				mock.PromoteAgentComponentFunc = func(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+					return nil, utils.ErrDeploymentNotFound
+				}
+				return mock
+			},
+		},
+		{
+			name:       "return 404 when target environment does not exist",
+			sourceEnv:  "development",
+			wantStatus: 404,
+			wantErrMsg: "Environment not found",
+			setupMock: func() *clientmocks.OpenChoreoSvcClientMock {
+				mock := createMockOpenChoreoClientForPromote()
+				mock.GetEnvironmentFunc = func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+					return nil, utils.ErrEnvironmentNotFound
+				}
+				return mock
+			},
+		},
+		{
+			name:       "return 500 on service error",
+			sourceEnv:  "development",
+			wantStatus: 500,
+			wantErrMsg: "Failed to promote agent",
+			setupMock: func() *clientmocks.OpenChoreoSvcClientMock {
+				mock := createMockOpenChoreoClientForPromote()
+				mock.PromoteAgentComponentFunc = func(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+					return nil, fmt.Errorf("internal service error")
+				}
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			openChoreoClient := tt.setupMock()
+			testClients := wiring.TestClients{
+				OpenChoreoSvcClient: openChoreoClient,
+			}
+
+			app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+			reqBody := new(bytes.Buffer)
+			if tt.payload != nil {
+				err := json.NewEncoder(reqBody).Encode(tt.payload)
+				require.NoError(t, err)
+			}
+
+			url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments/%s/promote",
+				promoteTestOrgName, promoteTestProjName, promoteTestAgentName, tt.sourceEnv)
+			req := httptest.NewRequest(http.MethodPost, url, reqBody)
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			body, err := io.ReadAll(rr.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), tt.wantErrMsg)
+		})
+	}
+}
+
+func setUpPromoteTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, promoteTestOrgId, promoteTestUserIdpId, promoteTestOrgName)
+	_ = apitestutils.CreateProject(t, promoteTestProjId, promoteTestOrgId, promoteTestProjName)
+	_ = apitestutils.CreateAgent(t, promoteTestAgentId, promoteTestOrgId, promoteTestProjId, promoteTestAgentName, string(utils.InternalAgent))
+}
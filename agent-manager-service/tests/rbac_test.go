@@ -0,0 +1,297 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	rbacTestOrgId         = uuid.New()
+	rbacTestProjId        = uuid.New()
+	rbacTestOtherProjId   = uuid.New()
+	rbacTestOwnerIdpId    = uuid.New()
+	rbacTestViewerIdpId   = uuid.New()
+	rbacTestDevIdpId      = uuid.New()
+	rbacTestOutsiderIdpId = uuid.New()
+	rbacTestOrgName       = fmt.Sprintf("rbac-org-%s", uuid.New().String()[:5])
+	rbacTestProjName      = fmt.Sprintf("rbac-project-%s", uuid.New().String()[:5])
+	rbacTestOtherProjName = fmt.Sprintf("rbac-other-%s", uuid.New().String()[:5])
+	rbacTestAgentName     = fmt.Sprintf("rbac-agent-%s", uuid.New().String()[:5])
+)
+
+func TestRoleBasedAccessControl(t *testing.T) {
+	setUpRBACTest(t)
+
+	ownerAuth := jwtassertion.NewMockMiddleware(t, rbacTestOrgId, rbacTestOwnerIdpId)
+	viewerAuth := jwtassertion.NewMockMiddleware(t, rbacTestOrgId, rbacTestViewerIdpId)
+	devAuth := jwtassertion.NewMockMiddleware(t, rbacTestOrgId, rbacTestDevIdpId)
+	outsiderAuth := jwtassertion.NewMockMiddleware(t, rbacTestOrgId, rbacTestOutsiderIdpId)
+
+	setMemberRole(t, ownerAuth, fmt.Sprintf("/api/v1/orgs/%s/members/%s", rbacTestOrgName, rbacTestViewerIdpId), utils.RoleViewer, http.StatusOK)
+	setMemberRole(t, ownerAuth, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/members/%s", rbacTestOrgName, rbacTestProjName, rbacTestDevIdpId), utils.RoleDeveloper, http.StatusOK)
+
+	deployURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments", rbacTestOrgName, rbacTestProjName, rbacTestAgentName)
+	tracesURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/traces?environment=Development", rbacTestOrgName, rbacTestProjName, rbacTestAgentName)
+
+	t.Run("Listing organization members should include the owner and members", func(t *testing.T) {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, viewerAuth)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/members", rbacTestOrgName), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response spec.MemberListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Members, 2)
+		require.Equal(t, rbacTestOwnerIdpId.String(), response.Members[0].UserIdpId)
+		require.Equal(t, string(utils.RoleAdmin), response.Members[0].Role)
+		require.True(t, response.Members[0].GetIsOwner())
+		require.Equal(t, rbacTestViewerIdpId.String(), response.Members[1].UserIdpId)
+		require.Equal(t, string(utils.RoleViewer), response.Members[1].Role)
+	})
+
+	t.Run("Viewer should be able to list traces", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClient()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, viewerAuth)
+		req := httptest.NewRequest(http.MethodGet, tracesURL, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Len(t, traceObserverClient.ListTracesCalls(), 1)
+	})
+
+	t.Run("Viewer should not be able to deploy an agent", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForDeploy()
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: openChoreoClient}, viewerAuth)
+		body, _ := json.Marshal(map[string]interface{}{"imageId": "registry.example.com/myapp:v1.0.0"})
+		req := httptest.NewRequest(http.MethodPost, deployURL, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+		require.Len(t, openChoreoClient.DeployAgentComponentCalls(), 0)
+	})
+
+	t.Run("Viewer and developer should not be able to delete a project", func(t *testing.T) {
+		for _, authMiddleware := range []jwtassertion.Middleware{viewerAuth, devAuth} {
+			openChoreoClient := createMockOpenChoreoClientForProjectDelete()
+			app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: openChoreoClient}, authMiddleware)
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/orgs/%s/projects/%s", rbacTestOrgName, rbacTestProjName), nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusForbidden, rr.Code)
+			require.Len(t, openChoreoClient.DeleteProjectCalls(), 0)
+		}
+	})
+
+	t.Run("Project developer should be able to deploy an agent in the project", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForDeploy()
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: openChoreoClient}, devAuth)
+		body, _ := json.Marshal(map[string]interface{}{"imageId": "registry.example.com/myapp:v1.0.0"})
+		req := httptest.NewRequest(http.MethodPost, deployURL, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		require.Len(t, openChoreoClient.DeployAgentComponentCalls(), 1)
+	})
+
+	t.Run("Project developer should not be able to access other projects", func(t *testing.T) {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, devAuth)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents", rbacTestOrgName, rbacTestOtherProjName), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Project members should only see the projects they belong to", func(t *testing.T) {
+		for _, tt := range []struct {
+			authMiddleware jwtassertion.Middleware
+			wantProjects   []string
+		}{
+			{authMiddleware: viewerAuth, wantProjects: []string{rbacTestProjName, rbacTestOtherProjName}},
+			{authMiddleware: devAuth, wantProjects: []string{rbacTestProjName}},
+		} {
+			openChoreoClient := createMockOpenChoreoClient()
+			openChoreoClient.ListProjectsFunc = func(ctx context.Context, orgName string) ([]*models.ProjectResponse, error) {
+				return []*models.ProjectResponse{{Name: rbacTestProjName}, {Name: rbacTestOtherProjName}}, nil
+			}
+			app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: openChoreoClient}, tt.authMiddleware)
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/projects", rbacTestOrgName), nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			var response spec.ProjectListResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			require.Equal(t, int32(len(tt.wantProjects)), response.Total)
+			var names []string
+			for _, project := range response.Projects {
+				names = append(names, project.Name)
+			}
+			require.Equal(t, tt.wantProjects, names)
+		}
+	})
+
+	t.Run("Users without a membership should not see the organization", func(t *testing.T) {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, outsiderAuth)
+		req := httptest.NewRequest(http.MethodGet, tracesURL, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Removed members should lose access", func(t *testing.T) {
+		memberIdpId := uuid.New()
+		memberURL := fmt.Sprintf("/api/v1/orgs/%s/members/%s", rbacTestOrgName, memberIdpId)
+		setMemberRole(t, ownerAuth, memberURL, utils.RoleViewer, http.StatusOK)
+
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, ownerAuth)
+		req := httptest.NewRequest(http.MethodDelete, memberURL, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		memberAuth := jwtassertion.NewMockMiddleware(t, rbacTestOrgId, memberIdpId)
+		app = apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, memberAuth)
+		req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/members", rbacTestOrgName), nil)
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	validationTests := []struct {
+		name           string
+		authMiddleware jwtassertion.Middleware
+		url            string
+		role           utils.Role
+		wantStatus     int
+		wantErrMsg     string
+	}{
+		{
+			name:           "return 403 when a viewer manages organization members",
+			authMiddleware: viewerAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/members/%s", rbacTestOrgName, uuid.New()),
+			role:           utils.RoleViewer,
+			wantStatus:     http.StatusForbidden,
+			wantErrMsg:     "Insufficient permissions to perform this action",
+		},
+		{
+			name:           "return 403 when a project developer manages project members",
+			authMiddleware: devAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/projects/%s/members/%s", rbacTestOrgName, rbacTestProjName, uuid.New()),
+			role:           utils.RoleViewer,
+			wantStatus:     http.StatusForbidden,
+			wantErrMsg:     "Insufficient permissions to perform this action",
+		},
+		{
+			name:           "return 400 on invalid organization role",
+			authMiddleware: ownerAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/members/%s", rbacTestOrgName, uuid.New()),
+			role:           utils.Role("owner"),
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "owner is not a valid organization role",
+		},
+		{
+			name:           "return 400 when granting admin on a project",
+			authMiddleware: ownerAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/projects/%s/members/%s", rbacTestOrgName, rbacTestProjName, uuid.New()),
+			role:           utils.RoleAdmin,
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "admin is not a valid project role",
+		},
+		{
+			name:           "return 400 when changing the role of the organization owner",
+			authMiddleware: ownerAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/members/%s", rbacTestOrgName, rbacTestOwnerIdpId),
+			role:           utils.RoleViewer,
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "Cannot change the role of the organization owner",
+		},
+		{
+			name:           "return 400 on invalid user ID",
+			authMiddleware: ownerAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/members/not-a-uuid", rbacTestOrgName),
+			role:           utils.RoleViewer,
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "Invalid user ID",
+		},
+		{
+			name:           "return 404 on project not found",
+			authMiddleware: ownerAuth,
+			url:            fmt.Sprintf("/api/v1/orgs/%s/projects/nonexistent-project/members/%s", rbacTestOrgName, uuid.New()),
+			role:           utils.RoleViewer,
+			wantStatus:     http.StatusNotFound,
+			wantErrMsg:     "Project not found",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := setMemberRole(t, tt.authMiddleware, tt.url, tt.role, tt.wantStatus)
+
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
+
+func setMemberRole(t *testing.T, authMiddleware jwtassertion.Middleware, url string, role utils.Role, wantStatus int) *httptest.ResponseRecorder {
+	t.Helper()
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, authMiddleware)
+	body, err := json.Marshal(spec.UpdateMemberRoleRequest{Role: string(role)})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	require.Equal(t, wantStatus, rr.Code, rr.Body.String())
+	return rr
+}
+
+func setUpRBACTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, rbacTestOrgId, rbacTestOwnerIdpId, rbacTestOrgName)
+	_ = apitestutils.CreateProject(t, rbacTestProjId, rbacTestOrgId, rbacTestProjName)
+	_ = apitestutils.CreateProject(t, rbacTestOtherProjId, rbacTestOrgId, rbacTestOtherProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), rbacTestOrgId, rbacTestProjId, rbacTestAgentName, string(utils.InternalAgent))
+}
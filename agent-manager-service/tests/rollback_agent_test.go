@@ -0,0 +1,321 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	rollbackTestOrgId     = uuid.New()
+	rollbackTestUserIdpId = uuid.New()
+	rollbackTestProjId    = uuid.New()
+	rollbackTestOrgName   = fmt.Sprintf("rollback-test-org-%s", uuid.New().String()[:5])
+	rollbackTestProjName  = fmt.Sprintf("rollback-test-project-%s", uuid.New().String()[:5])
+	rollbackTestAgentName = fmt.Sprintf("rollback-test-agent-%s", uuid.New().String()[:5])
+)
+
+func createMockOpenChoreoClientForRollback() *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetProjectFunc: func(ctx context.Context, projectName string, orgName string) (*models.ProjectResponse, error) {
+			return &models.ProjectResponse{
+				Name:               projectName,
+				DisplayName:        projectName,
+				OrgName:            orgName,
+				DeploymentPipeline: "default",
+				CreatedAt:          time.Now(),
+			}, nil
+		},
+		GetDeploymentPipelineFunc: func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
+			return &models.DeploymentPipelineResponse{
+				Name:      deploymentPipelineName,
+				OrgName:   orgName,
+				CreatedAt: time.Now(),
+				PromotionPaths: []models.PromotionPath{
+					{
+						SourceEnvironmentRef:  "development",
+						TargetEnvironmentRefs: []models.TargetEnvironmentRef{{Name: "production"}},
+					},
+				},
+			}, nil
+		},
+		DeployAgentComponentFunc: func(ctx context.Context, orgName string, projName string, componentName string, req *spec.DeployAgentRequest) error {
+			return nil
+		},
+		GetEnvironmentFunc: func(ctx context.Context, orgName string, environmentName string) (*models.EnvironmentResponse, error) {
+			return &models.EnvironmentResponse{
+				Name:        environmentName,
+				DisplayName: environmentName,
+				CreatedAt:   time.Now(),
+			}, nil
+		},
+	}
+}
+
+func TestRollbackAgent(t *testing.T) {
+	setUpRollbackTest(t)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, rollbackTestOrgId, rollbackTestUserIdpId)
+	baseUrl := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments",
+		rollbackTestOrgName, rollbackTestProjName, rollbackTestAgentName)
+
+	deploy := func(t *testing.T, imageId string, env []map[string]string) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForRollback(),
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"imageId": imageId,
+			"env":     env,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, baseUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	}
+
+	deploy(t, "registry/agent:v1", []map[string]string{{"key": "MODE", "value": "stable"}})
+	deploy(t, "registry/agent:v2", []map[string]string{{"key": "MODE", "value": "broken"}})
+
+	t.Run("Listing deployment history should return revisions newest first", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClientForRollback(),
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, baseUrl+"/development/history", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response spec.DeploymentHistoryResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, int32(2), response.Total)
+		require.Equal(t, int32(2), response.Revisions[0].Revision)
+		require.Equal(t, "registry/agent:v2", response.Revisions[0].ImageId)
+		require.Equal(t, int32(1), response.Revisions[1].Revision)
+		require.Equal(t, string(utils.DeploymentActionDeploy), response.Revisions[1].Action)
+		require.Equal(t, rollbackTestUserIdpId.String(), response.Revisions[1].DeployedBy)
+	})
+
+	t.Run("Rolling back to a previous revision should redeploy its image and env and return 202", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForRollback()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{"revision": 1})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, baseUrl+"/development/rollback", reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		var response spec.DeploymentRevision
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, int32(3), response.Revision)
+		require.Equal(t, string(utils.DeploymentActionRollback), response.Action)
+		require.NotNil(t, response.SourceRevision)
+		require.Equal(t, int32(1), *response.SourceRevision)
+
+		require.Len(t, openChoreoClient.DeployAgentComponentCalls(), 1)
+		deployCall := openChoreoClient.DeployAgentComponentCalls()[0]
+		require.Equal(t, rollbackTestAgentName, deployCall.ComponentName)
+		require.Equal(t, "registry/agent:v1", deployCall.Req.ImageId)
+		require.Equal(t, []spec.EnvironmentVariable{{Key: "MODE", Value: "stable"}}, deployCall.Req.Env)
+	})
+
+	validationTests := []struct {
+		name        string
+		environment string
+		payload     map[string]interface{}
+		wantStatus  int
+		wantErrMsg  string
+	}{
+		{
+			name:        "return 400 when revision is missing",
+			environment: "development",
+			payload:     map[string]interface{}{},
+			wantStatus:  400,
+			wantErrMsg:  "Invalid request body",
+		},
+		{
+			name:        "return 404 when revision does not exist",
+			environment: "development",
+			payload:     map[string]interface{}{"revision": 99},
+			wantStatus:  404,
+			wantErrMsg:  "Deployment revision 99 not found in environment development",
+		},
+		{
+			name:        "return 404 when environment has no history",
+			environment: "production",
+			payload:     map[string]interface{}{"revision": 1},
+			wantStatus:  404,
+			wantErrMsg:  "Deployment revision 1 not found in environment production",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			openChoreoClient := createMockOpenChoreoClientForRollback()
+			testClients := wiring.TestClients{
+				OpenChoreoSvcClient: openChoreoClient,
+			}
+			app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+			reqBody := new(bytes.Buffer)
+			err := json.NewEncoder(reqBody).Encode(tt.payload)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, baseUrl+"/"+tt.environment+"/rollback", reqBody)
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			body, err := io.ReadAll(rr.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), tt.wantErrMsg)
+			require.Empty(t, openChoreoClient.DeployAgentComponentCalls())
+		})
+	}
+
+	promote := func(t *testing.T, releaseName string) {
+		openChoreoClient := createMockOpenChoreoClientForRollback()
+		openChoreoClient.PromoteAgentComponentFunc = func(ctx context.Context, orgName string, projName string, componentName string, sourceEnv string, targetEnv string, promotedBy string) (*models.PromotionResponse, error) {
+			return &models.PromotionResponse{
+				AgentName:         componentName,
+				ProjectName:       projName,
+				SourceEnvironment: sourceEnv,
+				TargetEnvironment: targetEnv,
+				ReleaseName:       releaseName,
+				PromotedBy:        promotedBy,
+				PromotedAt:        time.Now(),
+			}, nil
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodPost, baseUrl+"/development/promote", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusAccepted, rr.Code)
+	}
+
+	promote(t, "rollback-test-release-1")
+	promote(t, "rollback-test-release-2")
+
+	t.Run("Rolling back a promoted environment should bind the release of the revision and return 202", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForRollback()
+		openChoreoClient.BindAgentReleaseFunc = func(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error {
+			return nil
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{"revision": 1})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, baseUrl+"/production/rollback", reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		var response spec.DeploymentRevision
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, int32(3), response.Revision)
+		require.Equal(t, "production", response.Environment)
+		require.Equal(t, string(utils.DeploymentActionRollback), response.Action)
+		require.NotNil(t, response.SourceRevision)
+		require.Equal(t, int32(1), *response.SourceRevision)
+
+		require.Empty(t, openChoreoClient.DeployAgentComponentCalls())
+		require.Len(t, openChoreoClient.BindAgentReleaseCalls(), 1)
+		bindCall := openChoreoClient.BindAgentReleaseCalls()[0]
+		require.Equal(t, rollbackTestAgentName, bindCall.ComponentName)
+		require.Equal(t, "production", bindCall.Environment)
+		require.Equal(t, "rollback-test-release-1", bindCall.ReleaseName)
+	})
+
+	t.Run("Rolling back a promoted environment the agent is no longer deployed in should return 404", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForRollback()
+		openChoreoClient.BindAgentReleaseFunc = func(ctx context.Context, orgName string, projName string, componentName string, environment string, releaseName string) error {
+			return utils.ErrDeploymentNotFound
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{"revision": 2})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, baseUrl+"/production/rollback", reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		body, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(body), "Agent is not deployed in environment production")
+	})
+}
+
+func setUpRollbackTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, rollbackTestOrgId, rollbackTestUserIdpId, rollbackTestOrgName)
+	_ = apitestutils.CreateProject(t, rollbackTestProjId, rollbackTestOrgId, rollbackTestProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), rollbackTestOrgId, rollbackTestProjId, rollbackTestAgentName, string(utils.InternalAgent))
+}
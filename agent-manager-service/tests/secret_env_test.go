@@ -0,0 +1,311 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	secretTestOrgId     = uuid.New()
+	secretTestUserIdpId = uuid.New()
+	secretTestProjId    = uuid.New()
+	secretTestAgentId   = uuid.New()
+	secretTestOrgName   = fmt.Sprintf("secret-test-org-%s", uuid.New().String()[:5])
+	secretTestProjName  = fmt.Sprintf("secret-test-project-%s", uuid.New().String()[:5])
+	secretTestAgentName = fmt.Sprintf("secret-test-agent-%s", uuid.New().String()[:5])
+)
+
+func createMockOpenChoreoClientForSecrets() *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetProjectFunc: func(ctx context.Context, projectName string, orgName string) (*models.ProjectResponse, error) {
+			return &models.ProjectResponse{
+				Name:               projectName,
+				OrgName:            orgName,
+				DeploymentPipeline: "default",
+				CreatedAt:          time.Now(),
+			}, nil
+		},
+		GetDeploymentPipelineFunc: func(ctx context.Context, orgName string, deploymentPipelineName string) (*models.DeploymentPipelineResponse, error) {
+			return &models.DeploymentPipelineResponse{
+				Name:      deploymentPipelineName,
+				OrgName:   orgName,
+				CreatedAt: time.Now(),
+				PromotionPaths: []models.PromotionPath{
+					{
+						SourceEnvironmentRef: "Default",
+					},
+				},
+			}, nil
+		},
+		UpsertAgentSecretsFunc: func(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error {
+			return nil
+		},
+		DeployAgentComponentFunc: func(ctx context.Context, orgName string, projName string, componentName string, req *spec.DeployAgentRequest) error {
+			return nil
+		},
+	}
+}
+
+func TestSecretEnvironmentVariables(t *testing.T) {
+	setUpSecretTest(t)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, secretTestOrgId, secretTestUserIdpId)
+	baseUrl := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments",
+		secretTestOrgName, secretTestProjName, secretTestAgentName)
+
+	deploy := func(t *testing.T, openChoreoClient *clientmocks.OpenChoreoSvcClientMock, env []map[string]interface{}) *httptest.ResponseRecorder {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"imageId": "registry/agent:v1",
+			"env":     env,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, baseUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Deploying with a secret should store it encrypted and reference it from the workload", func(t *testing.T) {
+		setSecretsEncryptionKey(t)
+		openChoreoClient := createMockOpenChoreoClientForSecrets()
+
+		rr := deploy(t, openChoreoClient, []map[string]interface{}{
+			{"key": "LOG_LEVEL", "value": "debug"},
+			{"key": "OPENAI_API_KEY", "value": "sk-test-123", "isSecret": true},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		// The plaintext value is only handed to the Kubernetes Secret
+		require.Len(t, openChoreoClient.UpsertAgentSecretsCalls(), 1)
+		require.Equal(t, map[string]string{"OPENAI_API_KEY": "sk-test-123"}, openChoreoClient.UpsertAgentSecretsCalls()[0].Data)
+
+		require.Len(t, openChoreoClient.DeployAgentComponentCalls(), 1)
+		require.Equal(t, []spec.EnvironmentVariable{
+			{Key: "LOG_LEVEL", Value: "debug"},
+			{Key: "OPENAI_API_KEY", IsSecret: spec.PtrBool(true)},
+		}, openChoreoClient.DeployAgentComponentCalls()[0].Req.Env)
+
+		var secret models.AgentSecret
+		require.NoError(t, db.DB(context.Background()).Where("agent_id = ? AND key = ?", secretTestAgentId, "OPENAI_API_KEY").First(&secret).Error)
+		require.NotEmpty(t, secret.EncryptedDataKey)
+		require.NotContains(t, string(secret.Ciphertext), "sk-test-123")
+	})
+
+	t.Run("Deployment history should mask secret values", func(t *testing.T) {
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: createMockOpenChoreoClientForSecrets()}, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, baseUrl+"/Default/history", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		body, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+		require.NotContains(t, string(body), "sk-test-123")
+
+		var response spec.DeploymentHistoryResponse
+		require.NoError(t, json.Unmarshal(body, &response))
+		require.NotEmpty(t, response.Revisions)
+		require.Contains(t, response.Revisions[0].Env, spec.EnvironmentVariable{
+			Key:      "OPENAI_API_KEY",
+			Value:    utils.MaskedSecretValue,
+			IsSecret: spec.PtrBool(true),
+		})
+	})
+
+	t.Run("Redeploying with the masked value should keep the stored secret", func(t *testing.T) {
+		setSecretsEncryptionKey(t)
+		openChoreoClient := createMockOpenChoreoClientForSecrets()
+
+		rr := deploy(t, openChoreoClient, []map[string]interface{}{
+			{"key": "OPENAI_API_KEY", "value": utils.MaskedSecretValue, "isSecret": true},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		require.Len(t, openChoreoClient.UpsertAgentSecretsCalls(), 1)
+		require.Equal(t, map[string]string{"OPENAI_API_KEY": "sk-test-123"}, openChoreoClient.UpsertAgentSecretsCalls()[0].Data)
+	})
+
+	validationTests := []struct {
+		name       string
+		withKey    bool
+		env        []map[string]interface{}
+		wantErrMsg string
+	}{
+		{
+			name:       "Deploying a new secret without a value should return 400",
+			withKey:    true,
+			env:        []map[string]interface{}{{"key": "NEW_SECRET", "value": "", "isSecret": true}},
+			wantErrMsg: "secret value is required: NEW_SECRET",
+		},
+		{
+			name:       "Deploying a secret with an invalid key should return 400",
+			withKey:    true,
+			env:        []map[string]interface{}{{"key": "INVALID KEY", "value": "value", "isSecret": true}},
+			wantErrMsg: "secret environment variable key INVALID KEY must consist of",
+		},
+		{
+			name:       "Deploying a secret when no encryption key is configured should return 400",
+			withKey:    false,
+			env:        []map[string]interface{}{{"key": "ANOTHER_SECRET", "value": "value", "isSecret": true}},
+			wantErrMsg: "Secret environment variables are not enabled",
+		},
+	}
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.withKey {
+				setSecretsEncryptionKey(t)
+			}
+			openChoreoClient := createMockOpenChoreoClientForSecrets()
+
+			rr := deploy(t, openChoreoClient, tt.env)
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+
+			body, err := io.ReadAll(rr.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), tt.wantErrMsg)
+			require.Empty(t, openChoreoClient.DeployAgentComponentCalls())
+		})
+	}
+
+	t.Run("Redeploying without the secret should delete it and clear the Kubernetes Secret", func(t *testing.T) {
+		setSecretsEncryptionKey(t)
+		openChoreoClient := createMockOpenChoreoClientForSecrets()
+
+		rr := deploy(t, openChoreoClient, []map[string]interface{}{
+			{"key": "LOG_LEVEL", "value": "info"},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		require.Len(t, openChoreoClient.UpsertAgentSecretsCalls(), 1)
+		require.Empty(t, openChoreoClient.UpsertAgentSecretsCalls()[0].Data)
+
+		var count int64
+		require.NoError(t, db.DB(context.Background()).Model(&models.AgentSecret{}).Where("agent_id = ?", secretTestAgentId).Count(&count).Error)
+		require.Zero(t, count)
+	})
+}
+
+func TestSecretsEncryptionKeyRotation(t *testing.T) {
+	setSecretsEncryptionKey(t)
+	cfg := config.GetConfig()
+	oldKey := cfg.Secrets.EncryptionKey
+	newKey := base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+
+	oldEncryptor, err := secrets.NewEnvelopeEncryptor()
+	require.NoError(t, err)
+	encrypted, err := oldEncryptor.Encrypt("s3cr3t")
+	require.NoError(t, err)
+
+	t.Run("Secrets encrypted with a previous key should be decrypted after a rotation", func(t *testing.T) {
+		cfg.Secrets = config.SecretsConfig{
+			EncryptionKey:          newKey,
+			EncryptionKeyID:        "rotated",
+			PreviousEncryptionKeys: "test=" + oldKey,
+		}
+		encryptor, err := secrets.NewEnvelopeEncryptor()
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(encrypted)
+		require.NoError(t, err)
+		require.Equal(t, "s3cr3t", plaintext)
+
+		reencrypted, err := encryptor.Encrypt("s3cr3t")
+		require.NoError(t, err)
+		require.Equal(t, "rotated", reencrypted.KeyID)
+	})
+
+	t.Run("Secrets encrypted with a key that is no longer configured should fail to decrypt", func(t *testing.T) {
+		cfg.Secrets = config.SecretsConfig{
+			EncryptionKey:   newKey,
+			EncryptionKeyID: "rotated",
+		}
+		encryptor, err := secrets.NewEnvelopeEncryptor()
+		require.NoError(t, err)
+
+		_, err = encryptor.Decrypt(encrypted)
+		require.ErrorContains(t, err, "unknown key")
+	})
+
+	invalidPreviousKeys := []struct {
+		name         string
+		previousKeys string
+	}{
+		{name: "missing key ID", previousKeys: oldKey},
+		{name: "duplicate key ID", previousKeys: "rotated=" + oldKey},
+		{name: "short key", previousKeys: "test=" + base64.StdEncoding.EncodeToString([]byte("short"))},
+	}
+	for _, tt := range invalidPreviousKeys {
+		t.Run("Invalid previous keys should be rejected: "+tt.name, func(t *testing.T) {
+			cfg.Secrets = config.SecretsConfig{
+				EncryptionKey:          newKey,
+				EncryptionKeyID:        "rotated",
+				PreviousEncryptionKeys: tt.previousKeys,
+			}
+			_, err := secrets.NewEnvelopeEncryptor()
+			require.Error(t, err)
+		})
+	}
+}
+
+// setSecretsEncryptionKey configures the test encryption key for the duration of the test
+func setSecretsEncryptionKey(t *testing.T) {
+	cfg := config.GetConfig()
+	previous := cfg.Secrets
+	t.Cleanup(func() {
+		cfg.Secrets = previous
+	})
+	cfg.Secrets = config.SecretsConfig{
+		EncryptionKey:   base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef")),
+		EncryptionKeyID: "test",
+	}
+}
+
+func setUpSecretTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, secretTestOrgId, secretTestUserIdpId, secretTestOrgName)
+	_ = apitestutils.CreateProject(t, secretTestProjId, secretTestOrgId, secretTestProjName)
+	_ = apitestutils.CreateAgent(t, secretTestAgentId, secretTestOrgId, secretTestProjId, secretTestAgentName, string(utils.InternalAgent))
+}
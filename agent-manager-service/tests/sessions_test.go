@@ -0,0 +1,279 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func createMockTraceObserverClientForSessions() *clientmocks.TraceObserverClientMock {
+	return &clientmocks.TraceObserverClientMock{
+		ListSessionsFunc: func(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionListResponse, error) {
+			return &traceobserversvc.SessionListResponse{
+				Sessions: []traceobserversvc.SessionOverview{
+					{
+						SessionID:  "session-1",
+						StartTime:  "2025-12-16T10:00:00Z",
+						EndTime:    "2025-12-16T10:05:00Z",
+						TurnCount:  2,
+						ErrorCount: 1,
+						TokenUsage: traceobserversvc.TokenUsage{InputTokens: 3000, OutputTokens: 600, TotalTokens: 3600},
+					},
+				},
+				TotalCount: 3,
+			}, nil
+		},
+		GetSessionFunc: func(ctx context.Context, params traceobserversvc.GetSessionParams) (*traceobserversvc.SessionResponse, error) {
+			if params.SessionID != "session-1" {
+				return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotFound, Message: "Session not found"}
+			}
+			return &traceobserversvc.SessionResponse{
+				SessionOverview: traceobserversvc.SessionOverview{
+					SessionID:  "session-1",
+					StartTime:  "2025-12-16T10:00:00Z",
+					EndTime:    "2025-12-16T10:05:00Z",
+					TurnCount:  2,
+					ErrorCount: 1,
+					TokenUsage: traceobserversvc.TokenUsage{InputTokens: 3000, OutputTokens: 600, TotalTokens: 3600},
+				},
+				Turns: []traceobserversvc.TraceOverview{
+					{
+						TraceID:   "trace-id-1",
+						StartTime: "2025-12-16T10:00:00Z",
+						EndTime:   "2025-12-16T10:01:00Z",
+						ModelUsage: []traceobserversvc.ModelTokenUsage{
+							{Model: "gpt-4o", InputTokens: 2000, OutputTokens: 400, TotalTokens: 2400},
+						},
+					},
+					{
+						TraceID:   "trace-id-2",
+						StartTime: "2025-12-16T10:04:00Z",
+						EndTime:   "2025-12-16T10:05:00Z",
+						Status:    &traceobserversvc.TraceStatus{ErrorCount: 1},
+						ModelUsage: []traceobserversvc.ModelTokenUsage{
+							{Model: "gpt-4o", InputTokens: 1000, OutputTokens: 200, TotalTokens: 1200},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func TestSessions(t *testing.T) {
+	orgId := uuid.New()
+	userIdpId := uuid.New()
+	projId := uuid.New()
+	orgName := fmt.Sprintf("sessions-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("sessions-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("sessions-agent-%s", uuid.New().String()[:5])
+
+	_ = apitestutils.CreateOrganization(t, orgId, userIdpId, orgName)
+	_ = apitestutils.CreateProject(t, projId, orgId, projName)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, orgId, userIdpId)
+
+	sessionsURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/sessions", orgName, projName, agentName)
+
+	t.Run("Listing sessions should return the sessions of the agent", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForSessions()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := sessionsURL + "?environment=Development&startTime=2025-12-16T00:00:00Z&endTime=2025-12-17T00:00:00Z&limit=1&offset=1"
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.SessionListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, 3, response.TotalCount)
+		require.Len(t, response.Sessions, 1)
+		require.Equal(t, "session-1", response.Sessions[0].SessionID)
+		require.Equal(t, 2, response.Sessions[0].TurnCount)
+		require.Equal(t, 1, response.Sessions[0].ErrorCount)
+		require.Equal(t, 3600, response.Sessions[0].TokenUsage.TotalTokens)
+
+		// Validate the trace observer was queried with the component and environment UIDs
+		require.Len(t, traceObserverClient.ListSessionsCalls(), 1)
+		params := traceObserverClient.ListSessionsCalls()[0].Params
+		require.Equal(t, "component-uid-123", params.ComponentUid)
+		require.Equal(t, "environment-uid-123", params.EnvironmentUid)
+		require.Equal(t, "2025-12-16T00:00:00Z", params.StartTime)
+		require.Equal(t, "2025-12-17T00:00:00Z", params.EndTime)
+		require.Equal(t, 1, params.Limit)
+		require.Equal(t, 1, params.Offset)
+	})
+
+	t.Run("Listing sessions should report when the trace observer truncated them", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientForSessions()
+		traceObserverClient.ListSessionsFunc = func(ctx context.Context, params traceobserversvc.ListSessionsParams) (*traceobserversvc.SessionListResponse, error) {
+			return &traceobserversvc.SessionListResponse{
+				Sessions:   []traceobserversvc.SessionOverview{},
+				TotalCount: 0,
+				Truncated:  true,
+			}, nil
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, sessionsURL+"?environment=Development", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.SessionListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.True(t, response.Truncated)
+	})
+
+	t.Run("Getting a session should return its turns with estimated cost", func(t *testing.T) {
+		effectiveFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		createModelPrice(t, authMiddleware, orgName, spec.ModelPriceRequest{
+			Model:            "gpt-4o",
+			InputPricePer1k:  0.0025,
+			OutputPricePer1k: 0.01,
+			EffectiveFrom:    &effectiveFrom,
+		})
+
+		traceObserverClient := createMockTraceObserverClientForSessions()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, sessionsURL+"/session-1?environment=Development", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.SessionResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, "session-1", response.SessionID)
+		require.Equal(t, 2, response.TurnCount)
+		require.Len(t, response.Turns, 2)
+		require.Equal(t, "trace-id-1", response.Turns[0].TraceID)
+		require.NotNil(t, response.Turns[0].EstimatedCost)
+		require.Equal(t, 0.009, response.Turns[0].EstimatedCost.TotalCost)
+		require.NotNil(t, response.Turns[1].Status)
+		require.Equal(t, 1, response.Turns[1].Status.ErrorCount)
+		require.NotNil(t, response.EstimatedCost)
+		require.Equal(t, 0.0075, response.EstimatedCost.InputCost)
+		require.Equal(t, 0.006, response.EstimatedCost.OutputCost)
+		require.Equal(t, 0.0135, response.EstimatedCost.TotalCost)
+
+		require.Len(t, traceObserverClient.GetSessionCalls(), 1)
+		params := traceObserverClient.GetSessionCalls()[0].Params
+		require.Equal(t, "session-1", params.SessionID)
+		require.Equal(t, "component-uid-123", params.ComponentUid)
+		require.Empty(t, params.StartTime)
+	})
+
+	t.Run("Getting an unknown session should return 404", func(t *testing.T) {
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: createMockOpenChoreoClient(),
+			TraceObserverClient: createMockTraceObserverClientForSessions(),
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		req := httptest.NewRequest(http.MethodGet, sessionsURL+"/session-2?environment=Development", nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "Session not found")
+	})
+
+	validationTests := []struct {
+		name       string
+		url        string
+		wantErrMsg string
+	}{
+		{
+			name:       "missing environment",
+			url:        sessionsURL + "?startTime=2025-12-16T00:00:00Z&endTime=2025-12-17T00:00:00Z",
+			wantErrMsg: "environment is required",
+		},
+		{
+			name:       "missing time range",
+			url:        sessionsURL + "?environment=Development",
+			wantErrMsg: "Invalid startTime",
+		},
+		{
+			name:       "startTime after endTime",
+			url:        sessionsURL + "?environment=Development&startTime=2025-12-17T00:00:00Z&endTime=2025-12-16T00:00:00Z",
+			wantErrMsg: "startTime must be before endTime",
+		},
+		{
+			name:       "invalid limit",
+			url:        sessionsURL + "?environment=Development&startTime=2025-12-16T00:00:00Z&endTime=2025-12-17T00:00:00Z&limit=500",
+			wantErrMsg: "Invalid limit parameter",
+		},
+		{
+			name:       "only startTime for a session",
+			url:        sessionsURL + "/session-1?environment=Development&startTime=2025-12-16T00:00:00Z",
+			wantErrMsg: "Invalid endTime",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(fmt.Sprintf("Sessions with %s should return 400", tt.name), func(t *testing.T) {
+			traceObserverClient := createMockTraceObserverClientForSessions()
+			testClients := wiring.TestClients{
+				OpenChoreoSvcClient: createMockOpenChoreoClient(),
+				TraceObserverClient: traceObserverClient,
+			}
+			app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusBadRequest, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+			require.Len(t, traceObserverClient.ListSessionsCalls(), 0)
+			require.Len(t, traceObserverClient.GetSessionCalls(), 0)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func callTraceAnnotationAPI(t *testing.T, testClients wiring.TestClients, authMiddleware jwtassertion.Middleware, method string, url string, payload interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+	var body bytes.Buffer
+	if payload != nil {
+		require.NoError(t, json.NewEncoder(&body).Encode(payload))
+	}
+	req := httptest.NewRequest(method, url, &body)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	return rr
+}
+
+func callTraceFeedbackAPI(t *testing.T, testClients wiring.TestClients, authMiddleware jwtassertion.Middleware, url string, headers map[string]string, payload models.TraceFeedbackRequest) *httptest.ResponseRecorder {
+	t.Helper()
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestTraceAnnotations(t *testing.T) {
+	orgId := uuid.New()
+	ownerIdpId := uuid.New()
+	viewerIdpId := uuid.New()
+	projId := uuid.New()
+	orgName := fmt.Sprintf("annotation-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("annotation-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("annotation-agent-%s", uuid.New().String()[:5])
+	otherAgentName := fmt.Sprintf("annotation-agent-%s", uuid.New().String()[:5])
+	_ = apitestutils.CreateOrganization(t, orgId, ownerIdpId, orgName)
+	_ = apitestutils.CreateProject(t, projId, orgId, projName)
+	ownerAuth := jwtassertion.NewMockMiddleware(t, orgId, ownerIdpId)
+	viewerAuth := jwtassertion.NewMockMiddleware(t, orgId, viewerIdpId)
+	setMemberRole(t, ownerAuth, fmt.Sprintf("/api/v1/orgs/%s/members/%s", orgName, viewerIdpId), utils.RoleViewer, http.StatusOK)
+
+	testClients := wiring.TestClients{OpenChoreoSvcClient: createMockOpenChoreoClient()}
+	agentURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", orgName, projName, agentName)
+	annotationsURL := agentURL + "/traces/trace-id-1/annotations"
+	feedbackURL := fmt.Sprintf("/ingest/orgs/%s/projects/%s/agents/%s/feedback", orgName, projName, agentName)
+	otherFeedbackURL := fmt.Sprintf("/ingest/orgs/%s/projects/%s/agents/%s/feedback", orgName, projName, otherAgentName)
+	positive := utils.TraceRatingPositive
+	negative := utils.TraceRatingNegative
+	score := 0.9
+
+	var created models.TraceAnnotationResponse
+	t.Run("Annotating a trace should return the annotation", func(t *testing.T) {
+		rr := callTraceAnnotationAPI(t, testClients, ownerAuth, http.MethodPost, annotationsURL, models.TraceAnnotationRequest{
+			SpanID: "span-1",
+			Rating: &positive,
+			Score:  &score,
+			Note:   " Correct answer ",
+			Tags:   []string{"accuracy"},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		require.Equal(t, "trace-id-1", created.TraceID)
+		require.Equal(t, "span-1", created.SpanID)
+		require.Equal(t, positive, *created.Rating)
+		require.Equal(t, "Correct answer", created.Note)
+		require.Equal(t, utils.TraceAnnotationSourceUser, created.Source)
+		require.Equal(t, ownerIdpId.String(), created.CreatedBy)
+	})
+
+	t.Run("Invalid annotations should return 400", func(t *testing.T) {
+		rr := callTraceAnnotationAPI(t, testClients, ownerAuth, http.MethodPost, annotationsURL, models.TraceAnnotationRequest{})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+
+		invalidRating := "neutral"
+		rr = callTraceAnnotationAPI(t, testClients, ownerAuth, http.MethodPost, annotationsURL, models.TraceAnnotationRequest{Rating: &invalidRating})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Viewer should not be allowed to annotate a trace", func(t *testing.T) {
+		rr := callTraceAnnotationAPI(t, testClients, viewerAuth, http.MethodPost, annotationsURL, models.TraceAnnotationRequest{Rating: &negative})
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+	})
+
+	issueFeedbackKey := func(t *testing.T, authMiddleware jwtassertion.Middleware, name string) *httptest.ResponseRecorder {
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/feedback-key", orgName, projName, name)
+		return callTraceAnnotationAPI(t, testClients, authMiddleware, http.MethodPost, url, nil)
+	}
+	var feedbackKey, otherFeedbackKey models.AgentFeedbackKeyResponse
+	t.Run("Issuing feedback keys should return a key per agent", func(t *testing.T) {
+		rr := issueFeedbackKey(t, viewerAuth, agentName)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+
+		rr = issueFeedbackKey(t, ownerAuth, agentName)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &feedbackKey))
+		require.Equal(t, agentName, feedbackKey.AgentName)
+		require.NotEmpty(t, feedbackKey.Key)
+
+		rr = issueFeedbackKey(t, ownerAuth, otherAgentName)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &otherFeedbackKey))
+		require.NotEqual(t, feedbackKey.Key, otherFeedbackKey.Key)
+	})
+
+	feedback := models.TraceFeedbackRequest{
+		TraceID:                "trace-id-1",
+		EndUserID:              "end-user-1",
+		TraceAnnotationRequest: models.TraceAnnotationRequest{Rating: &negative, Note: "Not helpful"},
+	}
+
+	t.Run("Feedback without the agent's key should be rejected", func(t *testing.T) {
+		rr := callTraceFeedbackAPI(t, testClients, ownerAuth, feedbackURL, nil, feedback)
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+
+		// The platform API key authorizes internal callbacks only
+		rr = callTraceFeedbackAPI(t, testClients, ownerAuth, feedbackURL, map[string]string{
+			config.GetConfig().APIKeyHeader: config.GetConfig().APIKeyValue,
+		}, feedback)
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+
+		// Another agent's key must not be accepted on this agent's path
+		rr = callTraceFeedbackAPI(t, testClients, ownerAuth, feedbackURL, map[string]string{
+			utils.AgentFeedbackKeyHeader: otherFeedbackKey.Key,
+		}, feedback)
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+
+		rr = callTraceFeedbackAPI(t, testClients, ownerAuth, otherFeedbackURL, map[string]string{
+			utils.AgentFeedbackKeyHeader: feedbackKey.Key,
+		}, feedback)
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+
+		// Feedback is not served on the internal API
+		rr = callTraceFeedbackAPI(t, testClients, ownerAuth, "/internal"+feedbackURL[len("/ingest"):], map[string]string{
+			config.GetConfig().APIKeyHeader: config.GetConfig().APIKeyValue,
+		}, feedback)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("End-user feedback reported by the agent should be listed with the annotations", func(t *testing.T) {
+		rr := callTraceFeedbackAPI(t, testClients, ownerAuth, feedbackURL, map[string]string{
+			utils.AgentFeedbackKeyHeader: feedbackKey.Key,
+		}, feedback)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var feedback models.TraceAnnotationResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &feedback))
+		require.Equal(t, utils.TraceAnnotationSourceEndUser, feedback.Source)
+		require.Equal(t, "end-user-1", feedback.EndUserID)
+		require.Empty(t, feedback.CreatedBy)
+
+		rr = callTraceAnnotationAPI(t, testClients, viewerAuth, http.MethodGet, annotationsURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.TraceAnnotationListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Annotations, 2)
+		require.Equal(t, created.ID, list.Annotations[0].ID)
+		require.Equal(t, feedback.ID, list.Annotations[1].ID)
+		require.Equal(t, 2, list.Summary.Count)
+		require.Equal(t, 1, list.Summary.PositiveCount)
+		require.Equal(t, 1, list.Summary.NegativeCount)
+		require.NotNil(t, list.Summary.AverageScore)
+		require.Equal(t, 0.9, *list.Summary.AverageScore)
+	})
+
+	t.Run("Reissuing a feedback key should revoke the previous key", func(t *testing.T) {
+		rr := issueFeedbackKey(t, ownerAuth, otherAgentName)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var reissued models.AgentFeedbackKeyResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &reissued))
+
+		rr = callTraceFeedbackAPI(t, testClients, ownerAuth, otherFeedbackURL, map[string]string{
+			utils.AgentFeedbackKeyHeader: otherFeedbackKey.Key,
+		}, feedback)
+		require.Equal(t, http.StatusUnauthorized, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Trace overviews should include annotation counts and filter by rating", func(t *testing.T) {
+		var receivedTraceIDs []string
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+				receivedTraceIDs = params.TraceIDs
+				return &traceobserversvc.TraceOverviewResponse{
+					Traces: []traceobserversvc.TraceOverview{
+						{TraceID: "trace-id-1", StartTime: "2025-12-16T10:00:00Z"},
+						{TraceID: "trace-id-2", StartTime: "2025-12-16T10:00:00Z"},
+					},
+					TotalCount: 2,
+				}, nil
+			},
+		}
+		clients := wiring.TestClients{OpenChoreoSvcClient: createMockOpenChoreoClient(), TraceObserverClient: traceObserverClient}
+
+		rr := callTraceAnnotationAPI(t, clients, viewerAuth, http.MethodGet, agentURL+"/traces?environment=Development", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.TraceOverviewResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Traces, 2)
+		require.Empty(t, receivedTraceIDs)
+		require.NotNil(t, response.Traces[0].Annotations)
+		require.Equal(t, 2, response.Traces[0].Annotations.Count)
+		require.Equal(t, 1, response.Traces[0].Annotations.NegativeCount)
+		require.Nil(t, response.Traces[1].Annotations)
+
+		rr = callTraceAnnotationAPI(t, clients, viewerAuth, http.MethodGet, agentURL+"/traces?environment=Development&rating=negative", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, []string{"trace-id-1"}, receivedTraceIDs)
+
+		rr = callTraceAnnotationAPI(t, clients, viewerAuth, http.MethodGet, agentURL+"/traces?environment=Development&rating=neutral", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Deleting an annotation should remove it", func(t *testing.T) {
+		rr := callTraceAnnotationAPI(t, testClients, viewerAuth, http.MethodDelete, annotationsURL+"/"+created.ID, nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+
+		rr = callTraceAnnotationAPI(t, testClients, ownerAuth, http.MethodDelete, annotationsURL+"/"+created.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = callTraceAnnotationAPI(t, testClients, ownerAuth, http.MethodDelete, annotationsURL+"/"+created.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		rr = callTraceAnnotationAPI(t, testClients, ownerAuth, http.MethodDelete, annotationsURL+"/not-a-uuid", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+}
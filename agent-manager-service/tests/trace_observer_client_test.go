@@ -0,0 +1,148 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+func TestTraceObserverClientCredentials(t *testing.T) {
+	var received *http.Request
+	observer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"traces":[],"totalCount":0}`))
+	}))
+	defer observer.Close()
+
+	cfg := config.GetConfig()
+	previousTraceObserverConfig := cfg.TraceObserver
+	t.Cleanup(func() {
+		cfg.TraceObserver = previousTraceObserverConfig
+	})
+
+	listTraces := func(t *testing.T, params traceobserversvc.ListTracesParams) {
+		received = nil
+		client := traceobserversvc.NewTraceObserverClient()
+		_, err := client.ListTraces(context.Background(), params)
+		require.NoError(t, err)
+		require.NotNil(t, received)
+	}
+
+	t.Run("Sending no credentials when none are configured", func(t *testing.T) {
+		cfg.TraceObserver = config.TraceObserverConfig{URL: observer.URL, APIKeyHeader: "X-API-Key"}
+
+		listTraces(t, traceobserversvc.ListTracesParams{ComponentUid: "component-uid", EnvironmentUid: "environment-uid"})
+
+		require.Empty(t, received.Header.Get("X-API-Key"))
+		require.Empty(t, received.Header.Get("Authorization"))
+	})
+
+	t.Run("Sending the API key", func(t *testing.T) {
+		cfg.TraceObserver = config.TraceObserverConfig{URL: observer.URL, APIKeyHeader: "X-API-Key", APIKey: "observer-key"}
+
+		listTraces(t, traceobserversvc.ListTracesParams{ComponentUid: "component-uid", EnvironmentUid: "environment-uid"})
+
+		require.Equal(t, "observer-key", received.Header.Get("X-API-Key"))
+		require.Empty(t, received.Header.Get("Authorization"))
+	})
+
+	t.Run("Sending a token scoped to the component and environment", func(t *testing.T) {
+		cfg.TraceObserver = config.TraceObserverConfig{
+			URL:             observer.URL,
+			APIKeyHeader:    "X-API-Key",
+			APIKey:          "observer-key",
+			JWTSecret:       "observer-secret",
+			JWTIssuer:       "agent-manager-service",
+			JWTAudience:     "traces-observer-service",
+			TokenTTLSeconds: 60,
+		}
+
+		listTraces(t, traceobserversvc.ListTracesParams{ComponentUid: "component-uid", EnvironmentUid: "environment-uid"})
+
+		require.Empty(t, received.Header.Get("X-API-Key"))
+		claims := verifyObserverToken(t, received.Header.Get("Authorization"), "observer-secret")
+		require.Equal(t, "agent-manager-service", claims["iss"])
+		require.Equal(t, "traces-observer-service", claims["aud"])
+		require.Equal(t, []interface{}{"component-uid"}, claims["componentUids"])
+		require.Equal(t, []interface{}{"environment-uid"}, claims["environmentUids"])
+		expiresAt := time.Unix(int64(claims["exp"].(float64)), 0)
+		require.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, 5*time.Second)
+	})
+
+	t.Run("Sending a token for all environments when no environment is queried", func(t *testing.T) {
+		cfg.TraceObserver = config.TraceObserverConfig{URL: observer.URL, JWTSecret: "observer-secret", TokenTTLSeconds: 60}
+
+		listTraces(t, traceobserversvc.ListTracesParams{ComponentUid: "component-uid"})
+
+		claims := verifyObserverToken(t, received.Header.Get("Authorization"), "observer-secret")
+		require.Equal(t, []interface{}{"component-uid"}, claims["componentUids"])
+		require.NotContains(t, claims, "environmentUids")
+	})
+
+	t.Run("Sending each component and a token scoped to all of them when several are queried", func(t *testing.T) {
+		cfg.TraceObserver = config.TraceObserverConfig{URL: observer.URL, JWTSecret: "observer-secret", TokenTTLSeconds: 60}
+
+		listTraces(t, traceobserversvc.ListTracesParams{
+			ComponentUids:  []string{"component-uid-a", "component-uid-b"},
+			EnvironmentUid: "environment-uid",
+		})
+
+		require.Equal(t, []string{"component-uid-a", "component-uid-b"}, received.URL.Query()["componentUid"])
+		claims := verifyObserverToken(t, received.Header.Get("Authorization"), "observer-secret")
+		require.Equal(t, []interface{}{"component-uid-a", "component-uid-b"}, claims["componentUids"])
+	})
+}
+
+// verifyObserverToken checks the HS256 signature of a bearer token and returns its claims
+func verifyObserverToken(t *testing.T, authorization string, secret string) map[string]interface{} {
+	t.Helper()
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	require.True(t, found, "expected a bearer token, got %q", authorization)
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	var header map[string]interface{}
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(headerJSON, &header))
+	require.Equal(t, "HS256", header["alg"])
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	require.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+
+	var claims map[string]interface{}
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(payloadJSON, &claims))
+	return claims
+}
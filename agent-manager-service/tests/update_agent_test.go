@@ -0,0 +1,358 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	updateTestOrgId             = uuid.New()
+	updateTestUserIdpId         = uuid.New()
+	updateTestProjId            = uuid.New()
+	updateTestAgentId           = uuid.New()
+	updateTestOrgName           = fmt.Sprintf("update-test-org-%s", uuid.New().String()[:5])
+	updateTestProjName          = fmt.Sprintf("update-test-project-%s", uuid.New().String()[:5])
+	updateTestAgentName         = fmt.Sprintf("update-test-agent-%s", uuid.New().String()[:5])
+	updateTestExternalAgentName = fmt.Sprintf("update-test-ext-%s", uuid.New().String()[:5])
+)
+
+func createMockOpenChoreoClientForUpdate() *clientmocks.OpenChoreoSvcClientMock {
+	return &clientmocks.OpenChoreoSvcClientMock{
+		GetAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string) (*openchoreosvc.AgentComponent, error) {
+			return &openchoreosvc.AgentComponent{
+				UUID:        "component-uid-123",
+				Name:        agentName,
+				DisplayName: "Updated Agent",
+				ProjectName: projName,
+				CreatedAt:   time.Now(),
+				Provisioning: openchoreosvc.Provisioning{
+					Type: string(utils.InternalAgent),
+				},
+				Type: openchoreosvc.AgentType{
+					Type:    string(utils.AgentTypeAPI),
+					SubType: string(utils.AgentSubTypeCustomAPI),
+				},
+				Language: string(utils.LanguagePython),
+			}, nil
+		},
+		UpdateAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+			return nil
+		},
+		RestoreAgentComponentFunc: func(ctx context.Context, orgName string, projName string, agentName string, previous *openchoreosvc.AgentComponent) error {
+			return nil
+		},
+	}
+}
+
+func TestUpdateAgent(t *testing.T) {
+	setUpUpdateTest(t)
+	authMiddleware := jwtassertion.NewMockMiddleware(t, updateTestOrgId, updateTestUserIdpId)
+	agentUrl := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", updateTestOrgName, updateTestProjName, updateTestAgentName)
+
+	t.Run("Updating agent metadata and runtime configuration should return 200", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForUpdate()
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"displayName": "Updated Agent",
+			"description": "Updated description",
+			"runtimeConfigs": map[string]interface{}{
+				"env":             []map[string]string{{"key": "LOG_LEVEL", "value": "debug"}},
+				"runCommand":      "python main.py",
+				"languageVersion": "3.12",
+			},
+			"inputInterface": map[string]interface{}{
+				"type":     "HTTP",
+				"port":     9090,
+				"basePath": "/v2",
+				"schema":   map[string]string{"path": "/openapi.yaml"},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, agentUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response spec.AgentResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, updateTestAgentName, response.Name)
+		require.Equal(t, "Updated Agent", response.DisplayName)
+
+		require.Len(t, openChoreoClient.UpdateAgentComponentCalls(), 1)
+		updateCall := openChoreoClient.UpdateAgentComponentCalls()[0]
+		require.Equal(t, updateTestAgentName, updateCall.AgentName)
+		require.Equal(t, "python main.py", updateCall.Req.RuntimeConfigs.GetRunCommand())
+		require.Equal(t, int32(9090), updateCall.Req.InputInterface.Port)
+
+		var agent models.Agent
+		err = db.DB(context.Background()).Preload("AgentDetails").Where("id = ?", updateTestAgentId).First(&agent).Error
+		require.NoError(t, err)
+		require.Equal(t, "Updated Agent", agent.DisplayName)
+		require.Equal(t, "Updated description", agent.Description)
+		require.NotNil(t, agent.AgentDetails)
+		require.Equal(t, []interface{}{map[string]interface{}{"key": "LOG_LEVEL", "value": "debug"}}, agent.AgentDetails.WorkloadSpec["envVars"])
+		endpoints, ok := agent.AgentDetails.WorkloadSpec["endpoints"].([]interface{})
+		require.True(t, ok)
+		require.Len(t, endpoints, 1)
+		require.Equal(t, float64(9090), endpoints[0].(map[string]interface{})["port"])
+	})
+
+	t.Run("Failed OpenChoreo update should roll back database changes and return 500", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForUpdate()
+		openChoreoClient.UpdateAgentComponentFunc = func(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+			return fmt.Errorf("kubernetes api unavailable")
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"displayName": "Should Not Persist",
+			"runtimeConfigs": map[string]interface{}{
+				"env": []map[string]string{{"key": "LOG_LEVEL", "value": "trace"}},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, agentUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+
+		var agent models.Agent
+		err = db.DB(context.Background()).Preload("AgentDetails").Where("id = ?", updateTestAgentId).First(&agent).Error
+		require.NoError(t, err)
+		require.Equal(t, "Updated Agent", agent.DisplayName)
+		require.Equal(t, []interface{}{map[string]interface{}{"key": "LOG_LEVEL", "value": "debug"}}, agent.AgentDetails.WorkloadSpec["envVars"])
+	})
+
+	t.Run("Failed database commit should restore the OpenChoreo component and return 500", func(t *testing.T) {
+		openChoreoClient := createMockOpenChoreoClientForUpdate()
+		openChoreoClient.UpdateAgentComponentFunc = func(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+			// Drop the connection of the open update transaction so that it fails to commit
+			return db.DB(context.Background()).Exec(
+				"SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = current_database() AND pid <> pg_backend_pid() AND state = 'idle in transaction'",
+			).Error
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoSvcClient: openChoreoClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"displayName": "Should Not Persist",
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, agentUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Len(t, openChoreoClient.UpdateAgentComponentCalls(), 1)
+		require.Len(t, openChoreoClient.RestoreAgentComponentCalls(), 1)
+		restoreCall := openChoreoClient.RestoreAgentComponentCalls()[0]
+		require.Equal(t, updateTestAgentName, restoreCall.AgentName)
+		require.Equal(t, "Updated Agent", restoreCall.Previous.DisplayName)
+
+		var agent models.Agent
+		err = db.DB(context.Background()).Where("id = ?", updateTestAgentId).First(&agent).Error
+		require.NoError(t, err)
+		require.Equal(t, "Updated Agent", agent.DisplayName)
+	})
+
+	t.Run("Failed OpenChoreo update should not sync agent secrets", func(t *testing.T) {
+		setSecretsEncryptionKey(t)
+		openChoreoClient := createMockOpenChoreoClientForUpdate()
+		openChoreoClient.UpdateAgentComponentFunc = func(ctx context.Context, orgName string, projName string, agentName string, req *spec.UpdateAgentRequest) error {
+			return fmt.Errorf("kubernetes api unavailable")
+		}
+		openChoreoClient.UpsertAgentSecretsFunc = func(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error {
+			return nil
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: openChoreoClient}, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"runtimeConfigs": map[string]interface{}{
+				"env": []map[string]interface{}{{"key": "OPENAI_API_KEY", "value": "sk-should-not-persist", "isSecret": true}},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, agentUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Empty(t, openChoreoClient.UpsertAgentSecretsCalls())
+	})
+
+	t.Run("Failed database commit should restore the agent secrets and return 500", func(t *testing.T) {
+		setSecretsEncryptionKey(t)
+		openChoreoClient := createMockOpenChoreoClientForUpdate()
+		openChoreoClient.UpsertAgentSecretsFunc = func(ctx context.Context, orgName string, projName string, agentName string, data map[string]string) error {
+			if _, ok := data["OPENAI_API_KEY"]; !ok {
+				return nil
+			}
+			// Drop the connection of the open update transaction so that it fails to commit
+			return db.DB(context.Background()).Exec(
+				"SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = current_database() AND pid <> pg_backend_pid() AND state = 'idle in transaction'",
+			).Error
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoSvcClient: openChoreoClient}, authMiddleware)
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(map[string]interface{}{
+			"runtimeConfigs": map[string]interface{}{
+				"env": []map[string]interface{}{{"key": "OPENAI_API_KEY", "value": "sk-should-not-persist", "isSecret": true}},
+			},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPatch, agentUrl, reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+		require.Len(t, openChoreoClient.RestoreAgentComponentCalls(), 1)
+		upsertCalls := openChoreoClient.UpsertAgentSecretsCalls()
+		require.Len(t, upsertCalls, 2)
+		require.Equal(t, map[string]string{"OPENAI_API_KEY": "sk-should-not-persist"}, upsertCalls[0].Data)
+		require.Empty(t, upsertCalls[1].Data)
+	})
+
+	validationTests := []struct {
+		name       string
+		agentName  string
+		payload    map[string]interface{}
+		wantStatus int
+		wantErrMsg string
+	}{
+		{
+			name:       "return 400 when no fields are provided",
+			agentName:  updateTestAgentName,
+			payload:    map[string]interface{}{},
+			wantStatus: 400,
+			wantErrMsg: "at least one field must be provided for update",
+		},
+		{
+			name:       "return 400 when display name is empty",
+			agentName:  updateTestAgentName,
+			payload:    map[string]interface{}{"displayName": ""},
+			wantStatus: 400,
+			wantErrMsg: "invalid agent display name",
+		},
+		{
+			name:      "return 400 when language version is not supported",
+			agentName: updateTestAgentName,
+			payload: map[string]interface{}{
+				"runtimeConfigs": map[string]interface{}{"languageVersion": "2.7"},
+			},
+			wantStatus: 400,
+			wantErrMsg: "unsupported language version",
+		},
+		{
+			name:      "return 400 when updating runtime configuration of an external agent",
+			agentName: updateTestExternalAgentName,
+			payload: map[string]interface{}{
+				"runtimeConfigs": map[string]interface{}{"runCommand": "python app.py"},
+			},
+			wantStatus: 400,
+			wantErrMsg: "can only be updated for internal agents",
+		},
+		{
+			name:       "return 404 when agent does not exist",
+			agentName:  "non-existent-agent",
+			payload:    map[string]interface{}{"displayName": "Renamed"},
+			wantStatus: 404,
+			wantErrMsg: "Agent not found",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			openChoreoClient := createMockOpenChoreoClientForUpdate()
+			testClients := wiring.TestClients{
+				OpenChoreoSvcClient: openChoreoClient,
+			}
+			app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+			reqBody := new(bytes.Buffer)
+			err := json.NewEncoder(reqBody).Encode(tt.payload)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", updateTestOrgName, updateTestProjName, tt.agentName)
+			req := httptest.NewRequest(http.MethodPatch, url, reqBody)
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatus, rr.Code)
+
+			body, err := io.ReadAll(rr.Body)
+			require.NoError(t, err)
+			require.Contains(t, string(body), tt.wantErrMsg)
+			require.Empty(t, openChoreoClient.UpdateAgentComponentCalls())
+		})
+	}
+}
+
+func setUpUpdateTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, updateTestOrgId, updateTestUserIdpId, updateTestOrgName)
+	_ = apitestutils.CreateProject(t, updateTestProjId, updateTestOrgId, updateTestProjName)
+	_ = apitestutils.CreateAgent(t, updateTestAgentId, updateTestOrgId, updateTestProjId, updateTestAgentName, string(utils.InternalAgent))
+	_ = apitestutils.CreateAgent(t, uuid.New(), updateTestOrgId, updateTestProjId, updateTestExternalAgentName, string(utils.ExternalAgent))
+}
@@ -0,0 +1,508 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	webhookTestOrgId          = uuid.New()
+	webhookTestProjId         = uuid.New()
+	webhookTestOtherProjId    = uuid.New()
+	webhookTestOwnerIdpId     = uuid.New()
+	webhookTestDeveloperIdpId = uuid.New()
+	webhookTestOrgName        = fmt.Sprintf("webhook-org-%s", uuid.New().String()[:5])
+	webhookTestProjName       = fmt.Sprintf("webhook-project-%s", uuid.New().String()[:5])
+	webhookTestOtherProjName  = fmt.Sprintf("webhook-other-%s", uuid.New().String()[:5])
+	webhookTestAgentName      = fmt.Sprintf("webhook-agent-%s", uuid.New().String()[:5])
+)
+
+const webhookTestSecret = "webhook-test-secret-value"
+
+// webhookReceiver records the requests posted to it and fails them until accepting is set
+type webhookReceiver struct {
+	mu        sync.Mutex
+	accepting bool
+	requests  []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, receivedWebhook{header: req.Header.Clone(), body: body})
+	if !r.accepting {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.requests...)
+}
+
+func (r *webhookReceiver) accept() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accepting = true
+	r.requests = nil
+}
+
+func TestWebhooks(t *testing.T) {
+	setSecretsEncryptionKey(t)
+	setUpWebhooksTest(t)
+	ownerAuth := jwtassertion.NewMockMiddleware(t, webhookTestOrgId, webhookTestOwnerIdpId)
+	developerAuth := jwtassertion.NewMockMiddleware(t, webhookTestOrgId, webhookTestDeveloperIdpId)
+	setMemberRole(t, ownerAuth, fmt.Sprintf("/api/v1/orgs/%s/members/%s", webhookTestOrgName, webhookTestDeveloperIdpId), utils.RoleDeveloper, http.StatusOK)
+
+	cfg := config.GetConfig()
+	previousWebhooksConfig := cfg.Webhooks
+	t.Cleanup(func() {
+		cfg.Webhooks = previousWebhooksConfig
+	})
+	// The test receiver listens on the loopback address
+	cfg.Webhooks.AllowPrivateNetworks = true
+
+	receiver := &webhookReceiver{}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	orgWebhook := createWebhook(t, ownerAuth, spec.CreateWebhookRequest{
+		Url:        server.URL,
+		Secret:     webhookTestSecret,
+		EventTypes: []string{utils.WebhookEventBuildSucceeded, utils.WebhookEventBuildFailed, utils.WebhookEventDeploymentReady},
+	})
+	otherProjectWebhook := createWebhook(t, ownerAuth, spec.CreateWebhookRequest{
+		Url:         server.URL,
+		Secret:      webhookTestSecret,
+		EventTypes:  []string{utils.WebhookEventBuildSucceeded},
+		ProjectName: spec.PtrString(webhookTestOtherProjName),
+	})
+
+	t.Run("Webhook responses should not expose the secret", func(t *testing.T) {
+		rr := callWebhookAPI(t, ownerAuth, http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/webhooks", webhookTestOrgName), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.NotContains(t, rr.Body.String(), webhookTestSecret)
+
+		var response spec.WebhookListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Len(t, response.Webhooks, 2)
+		require.Equal(t, webhookTestOtherProjName, otherProjectWebhook.GetProjectName())
+		require.Empty(t, orgWebhook.GetProjectName())
+	})
+
+	// Builds and deployments reported by OpenChoreo, changed between polls
+	buildStatus := "BuildRunning"
+	buildResourceVersion := ""
+	includeOldBuild := true
+	now := time.Now()
+	openChoreoClient := &clientmocks.OpenChoreoSvcClientMock{
+		ListOrgComponentWorkflowsFunc: func(ctx context.Context, orgName string) ([]*models.BuildResponse, error) {
+			if orgName != webhookTestOrgName {
+				return nil, nil
+			}
+			oldBuildEnd := now.Add(-24 * time.Hour)
+			builds := []*models.BuildResponse{
+				{
+					Name:        fmt.Sprintf("%s-build-2", webhookTestAgentName),
+					UUID:        uuid.NewSHA1(uuid.NameSpaceURL, []byte(webhookTestOrgName+"-build-2")).String(),
+					AgentName:   webhookTestAgentName,
+					ProjectName: webhookTestProjName,
+					CommitID:    "abc123def",
+					Status:      buildStatus,
+					StartedAt:   now,
+					EndedAt:     &now,
+
+					ResourceVersion: buildResourceVersion,
+				},
+			}
+			if includeOldBuild {
+				builds = append(builds, &models.BuildResponse{
+					Name:        fmt.Sprintf("%s-build-1", webhookTestAgentName),
+					UUID:        uuid.NewSHA1(uuid.NameSpaceURL, []byte(webhookTestOrgName+"-build-1")).String(),
+					AgentName:   webhookTestAgentName,
+					ProjectName: webhookTestProjName,
+					Status:      "BuildSucceeded",
+					StartedAt:   oldBuildEnd.Add(-time.Minute),
+					EndedAt:     &oldBuildEnd,
+				})
+			}
+			return builds, nil
+		},
+		ListOrgDeploymentStatusesFunc: func(ctx context.Context, orgName string) ([]*models.EnvironmentDeploymentStatus, error) {
+			if orgName != webhookTestOrgName {
+				return nil, nil
+			}
+			return []*models.EnvironmentDeploymentStatus{
+				{
+					UID:         uuid.NewSHA1(uuid.NameSpaceURL, []byte(webhookTestOrgName+"-binding")).String(),
+					Generation:  1,
+					ProjectName: webhookTestProjName,
+					AgentName:   webhookTestAgentName,
+					Environment: "Development",
+					Status:      "active",
+					UpdatedAt:   now,
+				},
+			}, nil
+		},
+	}
+	encryptor, err := secrets.NewEnvelopeEncryptor()
+	require.NoError(t, err)
+	dispatcher := services.NewWebhookDispatcher(openChoreoClient, repositories.NewWebhookRepository(), encryptor, slog.Default())
+
+	cfg.Webhooks.RetryBaseDelaySeconds = 0
+
+	listDeliveries := func(t *testing.T, webhookId string, query string) spec.WebhookDeliveryListResponse {
+		rr := callWebhookAPI(t, ownerAuth, http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/webhooks/%s/deliveries%s", webhookTestOrgName, webhookId, query), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response spec.WebhookDeliveryListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	t.Run("Status transitions should queue events for subscribed webhooks only", func(t *testing.T) {
+		ctx := context.Background()
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		// A running build does not match any subscription, and the old build is not replayed
+		response := listDeliveries(t, orgWebhook.Id, "")
+		require.Equal(t, int32(1), response.Total)
+		require.Equal(t, utils.WebhookEventDeploymentReady, response.Deliveries[0].EventType)
+
+		buildStatus = "BuildSucceeded"
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		// Polling again without changes does not queue duplicates
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+
+		response = listDeliveries(t, orgWebhook.Id, "?status=pending")
+		require.Equal(t, int32(2), response.Total)
+		require.Equal(t, utils.WebhookEventBuildSucceeded, response.Deliveries[0].EventType)
+
+		response = listDeliveries(t, otherProjectWebhook.Id, "")
+		require.Equal(t, int32(0), response.Total)
+	})
+
+	t.Run("Failed deliveries should be retried and requests should be signed", func(t *testing.T) {
+		ctx := context.Background()
+		require.NoError(t, dispatcher.DeliverPendingEvents(ctx))
+		require.Len(t, receiver.received(), 2)
+
+		response := listDeliveries(t, orgWebhook.Id, "?status=pending")
+		require.Equal(t, int32(2), response.Total)
+		for _, delivery := range response.Deliveries {
+			require.Equal(t, int32(1), delivery.Attempts)
+			require.Equal(t, int32(http.StatusServiceUnavailable), delivery.GetLastStatusCode())
+			require.NotNil(t, delivery.NextAttemptAt)
+		}
+
+		receiver.accept()
+		require.NoError(t, dispatcher.DeliverPendingEvents(ctx))
+		received := receiver.received()
+		require.Len(t, received, 2)
+
+		eventTypes := make([]string, 0, len(received))
+		for _, request := range received {
+			timestamp := request.header.Get(utils.WebhookHeaderTimestamp)
+			mac := hmac.New(sha256.New, []byte(webhookTestSecret))
+			mac.Write([]byte(timestamp + "."))
+			mac.Write(request.body)
+			require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), request.header.Get(utils.WebhookHeaderSignature))
+
+			var payload models.WebhookEventPayload
+			require.NoError(t, json.Unmarshal(request.body, &payload))
+			require.Equal(t, request.header.Get(utils.WebhookHeaderEvent), payload.Type)
+			require.Equal(t, webhookTestOrgName, payload.OrgName)
+			require.Equal(t, webhookTestProjName, payload.ProjectName)
+			require.Equal(t, webhookTestAgentName, payload.AgentName)
+			if payload.Type == utils.WebhookEventBuildSucceeded {
+				require.Equal(t, fmt.Sprintf("%s-build-2", webhookTestAgentName), payload.Build.Name)
+			} else {
+				require.Equal(t, "Development", payload.Deployment.Environment)
+			}
+			eventTypes = append(eventTypes, payload.Type)
+		}
+		require.ElementsMatch(t, []string{utils.WebhookEventBuildSucceeded, utils.WebhookEventDeploymentReady}, eventTypes)
+
+		response = listDeliveries(t, orgWebhook.Id, "?status=succeeded")
+		require.Equal(t, int32(2), response.Total)
+		for _, delivery := range response.Deliveries {
+			require.Equal(t, int32(2), delivery.Attempts)
+			require.NotNil(t, delivery.DeliveredAt)
+			require.Nil(t, delivery.NextAttemptAt)
+		}
+	})
+
+	t.Run("Deliveries should fail after the maximum number of attempts", func(t *testing.T) {
+		ctx := context.Background()
+		cfg.Webhooks.MaxDeliveryAttempts = 1
+		receiver.mu.Lock()
+		receiver.accepting = false
+		receiver.mu.Unlock()
+
+		buildStatus = "BuildFailed"
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		require.NoError(t, dispatcher.DeliverPendingEvents(ctx))
+
+		response := listDeliveries(t, orgWebhook.Id, "?status=failed")
+		require.Equal(t, int32(1), response.Total)
+		require.Equal(t, utils.WebhookEventBuildFailed, response.Deliveries[0].EventType)
+		require.Equal(t, int32(1), response.Deliveries[0].Attempts)
+		require.NotEmpty(t, response.Deliveries[0].GetLastError())
+	})
+
+	t.Run("Deliveries that cannot be prepared should be recorded as failed attempts", func(t *testing.T) {
+		ctx := context.Background()
+		cfg.Webhooks.MaxDeliveryAttempts = 2
+		failingDispatcher := services.NewWebhookDispatcher(openChoreoClient, repositories.NewWebhookRepository(), failingEncryptor{encryptor}, slog.Default())
+
+		buildStatus = "BuildSucceeded"
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		require.NoError(t, failingDispatcher.DeliverPendingEvents(ctx))
+
+		response := listDeliveries(t, orgWebhook.Id, "?status=pending")
+		require.Equal(t, int32(1), response.Total)
+		require.Equal(t, int32(1), response.Deliveries[0].Attempts)
+		require.Contains(t, response.Deliveries[0].GetLastError(), "failed to decrypt webhook secret")
+
+		require.NoError(t, failingDispatcher.DeliverPendingEvents(ctx))
+		response = listDeliveries(t, orgWebhook.Id, "?status=pending")
+		require.Equal(t, int32(0), response.Total)
+		response = listDeliveries(t, orgWebhook.Id, "?status=failed")
+		require.Equal(t, int32(2), response.Total)
+	})
+
+	t.Run("Deliveries should not connect to internal addresses", func(t *testing.T) {
+		ctx := context.Background()
+		cfg.Webhooks.MaxDeliveryAttempts = 3
+		cfg.Webhooks.AllowPrivateNetworks = false
+		t.Cleanup(func() {
+			cfg.Webhooks.AllowPrivateNetworks = true
+		})
+		receiver.accept()
+
+		// A new dispatcher, so that no connection to the receiver is reused
+		guardedDispatcher := services.NewWebhookDispatcher(openChoreoClient, repositories.NewWebhookRepository(), encryptor, slog.Default())
+
+		buildStatus = "BuildFailed"
+		require.NoError(t, guardedDispatcher.PollStatusChanges(ctx))
+		require.NoError(t, guardedDispatcher.DeliverPendingEvents(ctx))
+		require.Empty(t, receiver.received())
+
+		response := listDeliveries(t, orgWebhook.Id, "?status=pending")
+		require.Equal(t, int32(1), response.Total)
+		require.Equal(t, int32(1), response.Deliveries[0].Attempts)
+		require.Contains(t, response.Deliveries[0].GetLastError(), "refusing to connect to 127.0.0.1")
+	})
+
+	t.Run("Unchanged resources should be skipped and deleted ones pruned", func(t *testing.T) {
+		ctx := context.Background()
+		pendingDeliveries := func() int32 {
+			return listDeliveries(t, orgWebhook.Id, "?status=pending").Total
+		}
+		buildResourceVersion = "1"
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		pending := pendingDeliveries()
+
+		// A status change that is not reflected in the resource version is not looked at
+		buildStatus = "BuildSucceeded"
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		require.Equal(t, pending, pendingDeliveries())
+
+		buildResourceVersion = "2"
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		require.Equal(t, pending+1, pendingDeliveries())
+
+		oldBuildKey := fmt.Sprintf("build/%s/%s", webhookTestOrgName, uuid.NewSHA1(uuid.NameSpaceURL, []byte(webhookTestOrgName+"-build-1")))
+		countEventStates := func() int64 {
+			var count int64
+			require.NoError(t, db.DB(ctx).Model(&models.WebhookEventState{}).Where("resource_key = ?", oldBuildKey).Count(&count).Error)
+			return count
+		}
+		require.Equal(t, int64(1), countEventStates())
+		includeOldBuild = false
+		require.NoError(t, dispatcher.PollStatusChanges(ctx))
+		require.Equal(t, int64(0), countEventStates())
+	})
+
+	t.Run("Webhooks should not be created for internal addresses", func(t *testing.T) {
+		cfg.Webhooks.AllowPrivateNetworks = false
+		t.Cleanup(func() {
+			cfg.Webhooks.AllowPrivateNetworks = true
+		})
+		for _, webhookURL := range []string{
+			server.URL,
+			"http://localhost:8080/hook",
+			"http://169.254.169.254/latest/meta-data/",
+			"http://10.0.0.12/hook",
+			"http://192.168.1.10/hook",
+			"http://100.64.0.1/hook",
+			"http://[::1]:8080/hook",
+			"http://[fd00:ec2::254]/hook",
+			"http://0.0.0.0/hook",
+		} {
+			rr := callWebhookAPI(t, ownerAuth, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/webhooks", webhookTestOrgName), spec.CreateWebhookRequest{
+				Url:        webhookURL,
+				Secret:     webhookTestSecret,
+				EventTypes: []string{utils.WebhookEventBuildFailed},
+			})
+			require.Equal(t, http.StatusBadRequest, rr.Code, webhookURL)
+			require.Contains(t, rr.Body.String(), "url must resolve to public addresses", webhookURL)
+		}
+	})
+
+	t.Run("Deleting a webhook should remove it", func(t *testing.T) {
+		url := fmt.Sprintf("/api/v1/orgs/%s/webhooks/%s", webhookTestOrgName, otherProjectWebhook.Id)
+		rr := callWebhookAPI(t, ownerAuth, http.MethodDelete, url, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = callWebhookAPI(t, ownerAuth, http.MethodGet, url, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "Webhook not found")
+	})
+
+	validationTests := []struct {
+		name           string
+		authMiddleware jwtassertion.Middleware
+		payload        spec.CreateWebhookRequest
+		wantStatus     int
+		wantErrMsg     string
+	}{
+		{
+			name:           "return 403 when a developer creates a webhook",
+			authMiddleware: developerAuth,
+			payload:        spec.CreateWebhookRequest{Url: server.URL, Secret: webhookTestSecret, EventTypes: []string{utils.WebhookEventBuildFailed}},
+			wantStatus:     http.StatusForbidden,
+			wantErrMsg:     "Insufficient permissions to perform this action",
+		},
+		{
+			name:           "return 400 on a non-http url",
+			authMiddleware: ownerAuth,
+			payload:        spec.CreateWebhookRequest{Url: "ftp://example.com/hook", Secret: webhookTestSecret, EventTypes: []string{utils.WebhookEventBuildFailed}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "url must be an absolute http or https URL",
+		},
+		{
+			name:           "return 400 on a short secret",
+			authMiddleware: ownerAuth,
+			payload:        spec.CreateWebhookRequest{Url: server.URL, Secret: "short", EventTypes: []string{utils.WebhookEventBuildFailed}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "secret must be at least",
+		},
+		{
+			name:           "return 400 on an unsupported event type",
+			authMiddleware: ownerAuth,
+			payload:        spec.CreateWebhookRequest{Url: server.URL, Secret: webhookTestSecret, EventTypes: []string{"build.queued"}},
+			wantStatus:     http.StatusBadRequest,
+			wantErrMsg:     "unsupported event type: build.queued",
+		},
+		{
+			name:           "return 404 on an unknown project",
+			authMiddleware: ownerAuth,
+			payload:        spec.CreateWebhookRequest{Url: server.URL, Secret: webhookTestSecret, EventTypes: []string{utils.WebhookEventBuildFailed}, ProjectName: spec.PtrString("missing-project")},
+			wantStatus:     http.StatusNotFound,
+			wantErrMsg:     "Project not found",
+		},
+	}
+
+	for _, tt := range validationTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := callWebhookAPI(t, tt.authMiddleware, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/webhooks", webhookTestOrgName), tt.payload)
+			require.Equal(t, tt.wantStatus, rr.Code)
+			require.Contains(t, rr.Body.String(), tt.wantErrMsg)
+		})
+	}
+}
+
+// failingEncryptor fails to decrypt every value, as when the key of a webhook secret is no longer configured
+type failingEncryptor struct {
+	secrets.Encryptor
+}
+
+func (failingEncryptor) Decrypt(value *secrets.EncryptedValue) (string, error) {
+	return "", fmt.Errorf("unknown key ID %q", value.KeyID)
+}
+
+func createWebhook(t *testing.T, authMiddleware jwtassertion.Middleware, payload spec.CreateWebhookRequest) spec.WebhookResponse {
+	t.Helper()
+	rr := callWebhookAPI(t, authMiddleware, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/webhooks", webhookTestOrgName), payload)
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	require.NotContains(t, rr.Body.String(), webhookTestSecret)
+
+	var response spec.WebhookResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	return response
+}
+
+func callWebhookAPI(t *testing.T, authMiddleware jwtassertion.Middleware, method string, url string, payload interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{}, authMiddleware)
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		body = bytes.NewReader(data)
+	}
+	req := httptest.NewRequest(method, url, body)
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, req)
+	return rr
+}
+
+func setUpWebhooksTest(t *testing.T) {
+	_ = apitestutils.CreateOrganization(t, webhookTestOrgId, webhookTestOwnerIdpId, webhookTestOrgName)
+	_ = apitestutils.CreateProject(t, webhookTestProjId, webhookTestOrgId, webhookTestProjName)
+	_ = apitestutils.CreateProject(t, webhookTestOtherProjId, webhookTestOrgId, webhookTestOtherProjName)
+	_ = apitestutils.CreateAgent(t, uuid.New(), webhookTestOrgId, webhookTestProjId, webhookTestAgentName, string(utils.InternalAgent))
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+// Audit event actions recorded for mutating API operations
+const (
+	AuditActionAgentCreate            = "agent.create"
+	AuditActionAgentUpdate            = "agent.update"
+	AuditActionAgentDelete            = "agent.delete"
+	AuditActionAgentBuild             = "agent.build"
+	AuditActionAgentDeploy            = "agent.deploy"
+	AuditActionAgentPromote           = "agent.promote"
+	AuditActionAgentRollback          = "agent.rollback"
+	AuditActionProjectCreate          = "project.create"
+	AuditActionProjectDelete          = "project.delete"
+	AuditActionOrgMemberSetRole       = "org.member.set-role"
+	AuditActionOrgMemberRemove        = "org.member.remove"
+	AuditActionProjectMemberSetRole   = "project.member.set-role"
+	AuditActionProjectMemberRemove    = "project.member.remove"
+	AuditActionWebhookCreate          = "webhook.create"
+	AuditActionWebhookDelete          = "webhook.delete"
+	AuditActionModelPriceCreate       = "model-price.create"
+	AuditActionModelPriceUpdate       = "model-price.update"
+	AuditActionModelPriceDelete       = "model-price.delete"
+	AuditActionTraceAnnotationCreate  = "trace-annotation.create"
+	AuditActionTraceAnnotationDelete  = "trace-annotation.delete"
+	AuditActionAgentFeedbackKeyCreate = "agent.feedback-key.create"
+)
+
+// Audit event results
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
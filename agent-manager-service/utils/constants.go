@@ -16,6 +16,8 @@
 
 package utils
 
+import "time"
+
 type EndpointType string
 
 const (
@@ -30,6 +32,22 @@ const (
 	ResourceTypeProject ResourceType = "project"
 )
 
+type DeploymentAction string
+
+const (
+	DeploymentActionDeploy   DeploymentAction = "deploy"
+	DeploymentActionPromote  DeploymentAction = "promote"
+	DeploymentActionRollback DeploymentAction = "rollback"
+)
+
+// Secret environment variable constants
+const (
+	// MaskedSecretValue is returned in place of secret environment variable values
+	MaskedSecretValue = "********"
+	// AgentSecretNameSuffix is appended to the agent name to form the name of the Kubernetes Secret
+	AgentSecretNameSuffix = "-env-secrets"
+)
+
 // Name generation constants
 const (
 	MaxResourceNameLength     = 25
@@ -41,11 +59,17 @@ const (
 
 // Path parameter names used in HTTP routes
 const (
-	PathParamOrgName   = "orgName"
-	PathParamProjName  = "projName"
-	PathParamAgentName = "agentName"
-	PathParamBuildName = "buildName"
-	PathParamTraceId   = "traceId"
+	PathParamOrgName      = "orgName"
+	PathParamProjName     = "projName"
+	PathParamAgentName    = "agentName"
+	PathParamBuildName    = "buildName"
+	PathParamTraceId      = "traceId"
+	PathParamEnvironment  = "environment"
+	PathParamUserIdpId    = "userIdpId"
+	PathParamWebhookId    = "webhookId"
+	PathParamPriceId      = "priceId"
+	PathParamSessionId    = "sessionId"
+	PathParamAnnotationId = "annotationId"
 )
 
 // Pagination constants
@@ -56,3 +80,41 @@ const (
 	DefaultOffset = 0
 	MinOffset     = 0
 )
+
+// Build log pagination constants
+const (
+	DefaultBuildLogsLimit = 500
+	MaxBuildLogsLimit     = 1000
+)
+
+// Agent runtime log constants
+const (
+	DefaultAgentLogsLimit = 100
+	MaxAgentLogsLimit     = 1000
+	// DefaultAgentLogsLookback is the time range searched for runtime logs when no start time is given
+	DefaultAgentLogsLookback = time.Hour
+)
+
+// Agent runtime log levels
+var AgentLogLevels = []string{"ERROR", "WARN", "INFO", "DEBUG"}
+
+// Build and agent runtime log stream event types
+const (
+	LogStreamEventLog       = "log"
+	LogStreamEventHeartbeat = "heartbeat"
+	LogStreamEventComplete  = "complete"
+)
+
+// Agent metrics constants
+const (
+	MinMetricsInterval = time.Minute
+	MaxMetricsBuckets  = 1000
+)
+
+// LLM cost estimation constants
+const (
+	// ModelPriceCurrency is the currency of model prices and estimated costs
+	ModelPriceCurrency = "USD"
+	// MaxCostRangeDays is the maximum number of days covered by a cost rollup
+	MaxCostRangeDays = 366
+)
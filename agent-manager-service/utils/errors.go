@@ -29,4 +29,23 @@ var (
 	ErrProjectAlreadyExists       = errors.New("project already exists")
 	ErrDeploymentPipelineNotFound = errors.New("deployment pipeline not found")
 	ErrProjectHasAssociatedAgents = errors.New("project has associated agents")
+	ErrDeploymentNotFound         = errors.New("deployment not found")
+	ErrInvalidPromotionPath       = errors.New("invalid promotion path")
+	ErrDeploymentRevisionNotFound = errors.New("deployment revision not found")
+	ErrRollbackNotSupported       = errors.New("rollback is not supported for environment")
+	ErrInvalidAgentUpdate         = errors.New("invalid agent update")
+	ErrSecretsNotConfigured       = errors.New("secret environment variables are not enabled")
+	ErrSecretValueRequired        = errors.New("secret value is required")
+	ErrPermissionDenied           = errors.New("permission denied")
+	ErrInvalidRole                = errors.New("invalid role")
+	ErrCannotModifyOrgOwner       = errors.New("cannot modify the organization owner")
+	ErrWebhookNotFound            = errors.New("webhook not found")
+	ErrWebhookURLNotAllowed       = errors.New("webhook url must resolve to public addresses")
+	ErrInvalidCursor              = errors.New("invalid cursor")
+	ErrInvalidTraceFilter         = errors.New("invalid filter parameter")
+	ErrModelPriceNotFound         = errors.New("model price not found")
+	ErrModelPriceConflict         = errors.New("model price overlaps an existing price of the model")
+	ErrInvalidModelPriceRange     = errors.New("effectiveTo must be after effectiveFrom")
+	ErrTraceAnnotationNotFound    = errors.New("trace annotation not found")
+	ErrInvalidAgentFeedbackKey    = errors.New("invalid agent feedback key")
 )
@@ -188,6 +188,82 @@ func ConvertToDeploymentDetailsResponse(deploymentDetails []*models.DeploymentRe
 	return result
 }
 
+func ConvertToPromoteAgentResponse(promotion *models.PromotionResponse) spec.PromoteAgentResponse {
+	return spec.PromoteAgentResponse{
+		AgentName:         promotion.AgentName,
+		ProjectName:       promotion.ProjectName,
+		SourceEnvironment: promotion.SourceEnvironment,
+		TargetEnvironment: promotion.TargetEnvironment,
+		ReleaseName:       promotion.ReleaseName,
+		PromotedBy:        promotion.PromotedBy,
+		PromotedAt:        promotion.PromotedAt,
+	}
+}
+
+func ConvertToDeploymentRevisionResponse(revision *models.DeploymentRevisionResponse) spec.DeploymentRevision {
+	env := make([]spec.EnvironmentVariable, 0, len(revision.Env))
+	for _, envVar := range revision.Env {
+		if envVar.IsSecret {
+			env = append(env, maskedEnvironmentVariable(envVar.Key))
+			continue
+		}
+		env = append(env, spec.EnvironmentVariable{
+			Key:   envVar.Key,
+			Value: envVar.Value,
+		})
+	}
+	var sourceRevision *int32
+	if revision.SourceRevision != nil {
+		v := int32(*revision.SourceRevision)
+		sourceRevision = &v
+	}
+	return spec.DeploymentRevision{
+		Revision:       int32(revision.Revision),
+		Environment:    revision.Environment,
+		ImageId:        revision.ImageId,
+		Env:            env,
+		Action:         revision.Action,
+		SourceRevision: sourceRevision,
+		DeployedBy:     revision.DeployedBy,
+		DeployedAt:     revision.DeployedAt,
+	}
+}
+
+// MaskSecretEnvironmentVariables returns a copy of the environment variables with secret values masked
+func MaskSecretEnvironmentVariables(env []spec.EnvironmentVariable) []spec.EnvironmentVariable {
+	if env == nil {
+		return nil
+	}
+	masked := make([]spec.EnvironmentVariable, 0, len(env))
+	for _, envVar := range env {
+		if envVar.GetIsSecret() {
+			masked = append(masked, maskedEnvironmentVariable(envVar.Key))
+			continue
+		}
+		masked = append(masked, envVar)
+	}
+	return masked
+}
+
+func maskedEnvironmentVariable(key string) spec.EnvironmentVariable {
+	return spec.EnvironmentVariable{
+		Key:      key,
+		Value:    MaskedSecretValue,
+		IsSecret: spec.PtrBool(true),
+	}
+}
+
+func ConvertToDeploymentHistoryResponse(revisions []*models.DeploymentRevisionResponse) spec.DeploymentHistoryResponse {
+	responses := make([]spec.DeploymentRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		responses = append(responses, ConvertToDeploymentRevisionResponse(revision))
+	}
+	return spec.DeploymentHistoryResponse{
+		Revisions: responses,
+		Total:     int32(len(responses)),
+	}
+}
+
 func ConvertToAgentEndpointResponse(endpointDetails map[string]models.EndpointsResponse) map[string]spec.EndpointConfiguration {
 	result := make(map[string]spec.EndpointConfiguration)
 
@@ -361,20 +437,158 @@ func ConvertToProjectListResponse(projects []*models.ProjectResponse) []spec.Pro
 	return responses
 }
 
+func ConvertToMemberResponse(member *models.MemberResponse) spec.MemberResponse {
+	if member == nil {
+		return spec.MemberResponse{}
+	}
+
+	response := spec.MemberResponse{
+		UserIdpId: member.UserIdpId,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}
+	if member.IsOwner {
+		response.IsOwner = &member.IsOwner
+	}
+	return response
+}
+
+func ConvertToMemberListResponse(members []*models.MemberResponse) spec.MemberListResponse {
+	responses := make([]spec.MemberResponse, len(members))
+	for i, member := range members {
+		responses[i] = ConvertToMemberResponse(member)
+	}
+
+	return spec.MemberListResponse{
+		Members: responses,
+	}
+}
+
+func ConvertToAuditEventResponse(event *models.AuditEventResponse) spec.AuditEvent {
+	if event == nil {
+		return spec.AuditEvent{}
+	}
+
+	response := spec.AuditEvent{
+		Id:            event.ID,
+		Actor:         event.Actor,
+		Action:        event.Action,
+		Target:        event.Target,
+		CorrelationId: event.CorrelationID,
+		Result:        event.Result,
+		StatusCode:    int32(event.StatusCode),
+		CreatedAt:     event.CreatedAt,
+	}
+	if event.ProjectName != "" {
+		response.ProjectName = &event.ProjectName
+	}
+	if event.AgentName != "" {
+		response.AgentName = &event.AgentName
+	}
+	return response
+}
+
+func ConvertToAuditEventListResponse(events []*models.AuditEventResponse, total int32, limit int32, offset int32) spec.AuditEventListResponse {
+	responses := make([]spec.AuditEvent, len(events))
+	for i, event := range events {
+		responses[i] = ConvertToAuditEventResponse(event)
+	}
+
+	return spec.AuditEventListResponse{
+		Events: responses,
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+func ConvertToWebhookResponse(webhook *models.WebhookResponse) spec.WebhookResponse {
+	if webhook == nil {
+		return spec.WebhookResponse{}
+	}
+
+	response := spec.WebhookResponse{
+		Id:         webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		Enabled:    webhook.Enabled,
+		CreatedBy:  webhook.CreatedBy,
+		CreatedAt:  webhook.CreatedAt,
+	}
+	if webhook.ProjectName != "" {
+		response.ProjectName = &webhook.ProjectName
+	}
+	return response
+}
+
+func ConvertToWebhookListResponse(webhooks []*models.WebhookResponse) spec.WebhookListResponse {
+	responses := make([]spec.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		responses[i] = ConvertToWebhookResponse(webhook)
+	}
+
+	return spec.WebhookListResponse{
+		Webhooks: responses,
+	}
+}
+
+func ConvertToWebhookDeliveryResponse(delivery *models.WebhookDeliveryResponse) spec.WebhookDelivery {
+	if delivery == nil {
+		return spec.WebhookDelivery{}
+	}
+
+	response := spec.WebhookDelivery{
+		Id:            delivery.ID,
+		EventId:       delivery.EventID,
+		EventType:     delivery.EventType,
+		Status:        delivery.Status,
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+		DeliveredAt:   delivery.DeliveredAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+	if delivery.LastStatusCode != nil {
+		response.LastStatusCode = spec.PtrInt32(int32(*delivery.LastStatusCode))
+	}
+	return response
+}
+
+func ConvertToWebhookDeliveryListResponse(deliveries []*models.WebhookDeliveryResponse, total int32, limit int32, offset int32) spec.WebhookDeliveryListResponse {
+	responses := make([]spec.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = ConvertToWebhookDeliveryResponse(delivery)
+	}
+
+	return spec.WebhookDeliveryListResponse{
+		Deliveries: responses,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+	}
+}
+
+func ConvertToLogEntry(logEntry models.LogEntry) spec.LogEntry {
+	return spec.LogEntry{
+		Timestamp: logEntry.Timestamp,
+		Log:       logEntry.Log,
+		LogLevel:  logEntry.LogLevel,
+	}
+}
+
 func ConvertToBuildLogsResponse(buildLogs models.BuildLogsResponse) spec.BuildLogsResponse {
 	logEntries := make([]spec.LogEntry, len(buildLogs.Logs))
 	for i, logEntry := range buildLogs.Logs {
-		logEntries[i] = spec.LogEntry{
-			Timestamp: logEntry.Timestamp,
-			Log:       logEntry.Log,
-			LogLevel:  logEntry.LogLevel,
-		}
+		logEntries[i] = ConvertToLogEntry(logEntry)
 	}
 	responses := spec.BuildLogsResponse{
 		Logs:       logEntries,
 		TotalCount: buildLogs.TotalCount,
 		TookMs:     buildLogs.TookMs,
 	}
+	if buildLogs.NextCursor != "" {
+		responses.NextCursor = &buildLogs.NextCursor
+	}
 
 	return responses
 }
@@ -397,3 +611,37 @@ func ConvertToDataPlaneListResponse(dataPlanes []*models.DataPlaneResponse) []sp
 
 	return responses
 }
+
+func ConvertToModelPriceResponse(price *models.ModelPriceResponse) spec.ModelPriceResponse {
+	if price == nil {
+		return spec.ModelPriceResponse{}
+	}
+
+	response := spec.ModelPriceResponse{
+		Id:               price.ID,
+		Model:            price.Model,
+		InputPricePer1k:  price.InputPricePer1K,
+		OutputPricePer1k: price.OutputPricePer1K,
+		Currency:         price.Currency,
+		EffectiveFrom:    price.EffectiveFrom,
+		EffectiveTo:      price.EffectiveTo,
+		CreatedBy:        price.CreatedBy,
+		CreatedAt:        price.CreatedAt,
+		UpdatedAt:        price.UpdatedAt,
+	}
+	if price.Vendor != "" {
+		response.Vendor = &price.Vendor
+	}
+	return response
+}
+
+func ConvertToModelPriceListResponse(prices []*models.ModelPriceResponse) spec.ModelPriceListResponse {
+	responses := make([]spec.ModelPriceResponse, len(prices))
+	for i, price := range prices {
+		responses[i] = ConvertToModelPriceResponse(price)
+	}
+
+	return spec.ModelPriceListResponse{
+		Prices: responses,
+	}
+}
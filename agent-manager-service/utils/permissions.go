@@ -0,0 +1,114 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+// Role is a membership role granted to a user on an organization or a project
+type Role string
+
+const (
+	// RoleAdmin has full access to an organization, including membership management
+	RoleAdmin Role = "admin"
+	// RoleDeveloper can build, deploy and manage agents
+	RoleDeveloper Role = "developer"
+	// RoleViewer has read-only access, including traces
+	RoleViewer Role = "viewer"
+)
+
+// Permission is an action that a route requires the caller to be allowed to perform
+type Permission string
+
+const (
+	PermissionOrgRead              Permission = "org:read"
+	PermissionOrgManageMembers     Permission = "org:manage-members"
+	PermissionProjectCreate        Permission = "project:create"
+	PermissionProjectRead          Permission = "project:read"
+	PermissionProjectDelete        Permission = "project:delete"
+	PermissionProjectManageMembers Permission = "project:manage-members"
+	PermissionAgentWrite           Permission = "agent:write"
+	PermissionAgentDeploy          Permission = "agent:deploy"
+	PermissionTraceRead            Permission = "trace:read"
+	PermissionTraceAnnotate        Permission = "trace:annotate"
+	PermissionAuditRead            Permission = "audit:read"
+	PermissionWebhookManage        Permission = "webhook:manage"
+	PermissionModelPriceManage     Permission = "model-price:manage"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {
+		PermissionOrgRead,
+		PermissionOrgManageMembers,
+		PermissionProjectCreate,
+		PermissionProjectRead,
+		PermissionProjectDelete,
+		PermissionProjectManageMembers,
+		PermissionAgentWrite,
+		PermissionAgentDeploy,
+		PermissionTraceRead,
+		PermissionTraceAnnotate,
+		PermissionAuditRead,
+		PermissionWebhookManage,
+		PermissionModelPriceManage,
+	},
+	RoleDeveloper: {
+		PermissionOrgRead,
+		PermissionProjectRead,
+		PermissionAgentWrite,
+		PermissionAgentDeploy,
+		PermissionTraceRead,
+		PermissionTraceAnnotate,
+	},
+	RoleViewer: {
+		PermissionOrgRead,
+		PermissionProjectRead,
+		PermissionTraceRead,
+	},
+}
+
+// roleRank orders roles so that the most privileged of several memberships wins
+var roleRank = map[Role]int{
+	RoleViewer:    1,
+	RoleDeveloper: 2,
+	RoleAdmin:     3,
+}
+
+// HasPermission reports whether the role grants the given permission
+func (r Role) HasPermission(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// HigherRole returns the more privileged of the two roles. An empty role ranks lowest.
+func HigherRole(a Role, b Role) Role {
+	if roleRank[b] > roleRank[a] {
+		return b
+	}
+	return a
+}
+
+// IsValidOrgRole reports whether the role can be assigned on an organization
+func IsValidOrgRole(role Role) bool {
+	return role == RoleAdmin || role == RoleDeveloper || role == RoleViewer
+}
+
+// IsValidProjectRole reports whether the role can be assigned on a project
+func IsValidProjectRole(role Role) bool {
+	return role == RoleDeveloper || role == RoleViewer
+}
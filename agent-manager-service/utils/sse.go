@@ -0,0 +1,82 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SSEWriter writes server-sent events, flushing each event to the client as it is written
+type SSEWriter struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// NewSSEWriter starts an event stream response. The server's write timeout is lifted for the stream,
+// since streams stay open for longer than regular requests.
+func NewSSEWriter(w http.ResponseWriter) (*SSEWriter, error) {
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return nil, fmt.Errorf("failed to clear write deadline: %w", err)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disable response buffering in nginx based ingress controllers
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	sse := &SSEWriter{w: w, rc: rc}
+	return sse, sse.flush()
+}
+
+// WriteEvent writes an event with the JSON encoded data. The id is sent back by clients in the
+// Last-Event-ID header when they reconnect, and is omitted when empty.
+func (s *SSEWriter) WriteEvent(id string, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event data: %w", err)
+	}
+	var b strings.Builder
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	fmt.Fprintf(&b, "event: %s\ndata: %s\n\n", event, payload)
+	if _, err := s.w.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+// WriteComment writes a comment line, which clients ignore. It keeps idle connections from being closed by proxies.
+func (s *SSEWriter) WriteComment(comment string) error {
+	if _, err := fmt.Fprintf(s.w, ": %s\n\n", comment); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+func (s *SSEWriter) flush() error {
+	if err := s.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
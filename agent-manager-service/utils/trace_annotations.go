@@ -0,0 +1,102 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// Trace annotation ratings
+const (
+	TraceRatingPositive = "positive"
+	TraceRatingNegative = "negative"
+)
+
+// Trace annotation sources
+const (
+	TraceAnnotationSourceUser    = "user"     // Annotated by a console user
+	TraceAnnotationSourceEndUser = "end-user" // Feedback of an end user, reported by the agent
+)
+
+// Agent feedback keys
+const (
+	// AgentFeedbackKeyHeader carries the key that a deployed agent presents to report end-user feedback
+	AgentFeedbackKeyHeader = "X-AMP-Agent-Key"
+	AgentFeedbackKeyPrefix = "amp_fk_"
+	AgentFeedbackKeyBytes  = 32
+)
+
+// Trace annotation limits
+const (
+	MaxTraceAnnotationNoteLength = 4000
+	MaxTraceAnnotationTags       = 20
+	MaxTraceAnnotationTagLength  = 64
+	MaxTraceIdLength             = 64
+	MaxSpanIdLength              = 32
+	MaxEndUserIdLength           = 255
+	// MaxRatedTraceFilter is the number of most recently rated traces that the rating filter of a trace list selects from
+	MaxRatedTraceFilter = 500
+)
+
+func IsValidTraceRating(rating string) bool {
+	return rating == TraceRatingPositive || rating == TraceRatingNegative
+}
+
+// ValidateTraceAnnotationPayload checks that an annotation carries a rating, score, note or tags, and that they are valid
+func ValidateTraceAnnotationPayload(payload models.TraceAnnotationRequest) error {
+	if payload.Rating == nil && payload.Score == nil && strings.TrimSpace(payload.Note) == "" && len(payload.Tags) == 0 {
+		return fmt.Errorf("at least one of rating, score, note or tags must be provided")
+	}
+	if payload.Rating != nil && !IsValidTraceRating(*payload.Rating) {
+		return fmt.Errorf("rating must be '%s' or '%s'", TraceRatingPositive, TraceRatingNegative)
+	}
+	if len(payload.SpanID) > MaxSpanIdLength {
+		return fmt.Errorf("spanId must not exceed %d characters", MaxSpanIdLength)
+	}
+	if len(payload.Note) > MaxTraceAnnotationNoteLength {
+		return fmt.Errorf("note must not exceed %d characters", MaxTraceAnnotationNoteLength)
+	}
+	if len(payload.Tags) > MaxTraceAnnotationTags {
+		return fmt.Errorf("at most %d tags can be provided", MaxTraceAnnotationTags)
+	}
+	for _, tag := range payload.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("tags cannot be empty")
+		}
+		if len(tag) > MaxTraceAnnotationTagLength {
+			return fmt.Errorf("tags must not exceed %d characters", MaxTraceAnnotationTagLength)
+		}
+	}
+	return nil
+}
+
+// ValidateTraceFeedbackPayload checks the end-user feedback that an agent reports for a trace
+func ValidateTraceFeedbackPayload(payload models.TraceFeedbackRequest) error {
+	if strings.TrimSpace(payload.TraceID) == "" {
+		return fmt.Errorf("traceId is required")
+	}
+	if len(payload.TraceID) > MaxTraceIdLength {
+		return fmt.Errorf("traceId must not exceed %d characters", MaxTraceIdLength)
+	}
+	if len(payload.EndUserID) > MaxEndUserIdLength {
+		return fmt.Errorf("endUserId must not exceed %d characters", MaxEndUserIdLength)
+	}
+	return ValidateTraceAnnotationPayload(payload.TraceAnnotationRequest)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -53,6 +54,36 @@ func ValidateAgentCreatePayload(payload spec.CreateAgentRequest) error {
 	return nil
 }
 
+// ValidateAgentUpdatePayload validates the fields present in an UpdateAgentRequest.
+// Checks that depend on the existing agent (provisioning type, subtype, language) are done by the service.
+func ValidateAgentUpdatePayload(payload spec.UpdateAgentRequest) error {
+	if payload.DisplayName == nil && payload.Description == nil && payload.RuntimeConfigs == nil && payload.InputInterface == nil {
+		return fmt.Errorf("at least one field must be provided for update")
+	}
+	if payload.DisplayName != nil {
+		if err := ValidateResourceDisplayName(*payload.DisplayName, "agent"); err != nil {
+			return fmt.Errorf("invalid agent display name: %w", err)
+		}
+	}
+	if payload.RuntimeConfigs != nil {
+		if err := ValidateEnvironmentVariables(payload.RuntimeConfigs.Env); err != nil {
+			return fmt.Errorf("invalid runtimeConfigs: %w", err)
+		}
+	}
+	if payload.InputInterface != nil {
+		customAPI := spec.AgentType{Type: string(AgentTypeAPI), SubType: spec.PtrString(string(AgentSubTypeCustomAPI))}
+		if err := validateInputInterface(customAPI, payload.InputInterface); err != nil {
+			return fmt.Errorf("invalid inputInterface: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateLanguageVersion validates a language version change against the supported buildpacks
+func ValidateLanguageVersion(language string, languageVersion string) error {
+	return validateLanguage(language, &languageVersion)
+}
+
 // validateInternalAgent performs validations specific to internal agents
 func validateInternalAgent(payload spec.CreateAgentRequest) error {
 	// Validate Agent Type
@@ -74,10 +105,32 @@ func validateInternalAgent(payload spec.CreateAgentRequest) error {
 	if err := validateLanguage(payload.RuntimeConfigs.Language, payload.RuntimeConfigs.LanguageVersion); err != nil {
 		return fmt.Errorf("invalid language: %w", err)
 	}
+	if err := ValidateEnvironmentVariables(payload.RuntimeConfigs.Env); err != nil {
+		return fmt.Errorf("invalid runtimeConfigs: %w", err)
+	}
 
 	return nil
 }
 
+// ValidateEnvironmentVariables validates environment variable keys.
+// Secret keys are also used as Kubernetes Secret data keys and must be valid as such.
+func ValidateEnvironmentVariables(env []spec.EnvironmentVariable) error {
+	seen := make(map[string]bool, len(env))
+	for _, envVar := range env {
+		if envVar.Key == "" {
+			return fmt.Errorf("environment variable key cannot be empty")
+		}
+		if seen[envVar.Key] {
+			return fmt.Errorf("duplicate environment variable key: %s", envVar.Key)
+		}
+		seen[envVar.Key] = true
+		if envVar.GetIsSecret() && !regexp.MustCompile(`^[-._a-zA-Z0-9]+$`).MatchString(envVar.Key) {
+			return fmt.Errorf("secret environment variable key %s must consist of alphanumeric characters, '-', '_' or '.'", envVar.Key)
+		}
+	}
+	return nil
+}
+
 func validateAgentType(agentType spec.AgentType) error {
 	if agentType.Type != string(AgentTypeAPI) {
 		return fmt.Errorf("unsupported agent type: %s", agentType.Type)
@@ -280,6 +333,44 @@ func ValidateResourceNameRequest(payload spec.ResourceNameRequest) error {
 	return nil
 }
 
+func ValidateWebhookCreatePayload(payload spec.CreateWebhookRequest) error {
+	webhookURL, err := url.Parse(payload.Url)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if len(payload.Secret) < MinWebhookSecretLength {
+		return fmt.Errorf("secret must be at least %d characters long", MinWebhookSecretLength)
+	}
+	if len(payload.EventTypes) == 0 {
+		return fmt.Errorf("at least one event type must be provided")
+	}
+	for _, eventType := range payload.EventTypes {
+		if !IsValidWebhookEventType(eventType) {
+			return fmt.Errorf("unsupported event type: %s", eventType)
+		}
+	}
+	if payload.ProjectName != nil && *payload.ProjectName == "" {
+		return fmt.Errorf("projectName cannot be empty")
+	}
+	return nil
+}
+
+func ValidateModelPricePayload(payload spec.ModelPriceRequest) error {
+	if strings.TrimSpace(payload.Model) == "" {
+		return fmt.Errorf("model is required")
+	}
+	if payload.Vendor != nil && strings.TrimSpace(*payload.Vendor) == "" {
+		return fmt.Errorf("vendor cannot be empty")
+	}
+	if payload.InputPricePer1k < 0 || payload.OutputPricePer1k < 0 {
+		return fmt.Errorf("prices cannot be negative")
+	}
+	if payload.EffectiveTo != nil && payload.EffectiveFrom != nil && !payload.EffectiveTo.After(*payload.EffectiveFrom) {
+		return fmt.Errorf("effectiveTo must be after effectiveFrom")
+	}
+	return nil
+}
+
 // WriteSuccessResponse writes a successful API response
 func WriteSuccessResponse[T any](w http.ResponseWriter, statusCode int, data T) {
 	w.Header().Set("Content-Type", "application/json")
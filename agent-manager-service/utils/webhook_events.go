@@ -0,0 +1,70 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import "net/netip"
+
+// Webhook event types emitted on build and deployment status transitions
+const (
+	WebhookEventBuildStarted     = "build.started"
+	WebhookEventBuildSucceeded   = "build.succeeded"
+	WebhookEventBuildFailed      = "build.failed"
+	WebhookEventDeploymentReady  = "deployment.ready"
+	WebhookEventDeploymentFailed = "deployment.failed"
+)
+
+// Webhook delivery statuses
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// Webhook request headers sent with every delivery
+const (
+	WebhookHeaderEvent     = "X-AMP-Event"
+	WebhookHeaderDelivery  = "X-AMP-Delivery"
+	WebhookHeaderTimestamp = "X-AMP-Timestamp"
+	WebhookHeaderSignature = "X-AMP-Signature"
+)
+
+// MinWebhookSecretLength is the minimum length of the secret used to sign webhook payloads
+const MinWebhookSecretLength = 16
+
+func IsValidWebhookEventType(eventType string) bool {
+	switch eventType {
+	case WebhookEventBuildStarted, WebhookEventBuildSucceeded, WebhookEventBuildFailed,
+		WebhookEventDeploymentReady, WebhookEventDeploymentFailed:
+		return true
+	}
+	return false
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not routable on the internet
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicWebhookAddress reports whether webhooks may be delivered to the address. Loopback, link-local
+// (including the 169.254.169.254 cloud metadata address), private, shared, multicast and unspecified
+// addresses are rejected so that webhooks cannot be used to reach internal services.
+func IsPublicWebhookAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsPrivate() || addr.IsUnspecified() {
+		return false
+	}
+	return !sharedAddressSpace.Contains(addr)
+}
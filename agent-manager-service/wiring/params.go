@@ -17,20 +17,31 @@
 package wiring
 
 import (
+	"time"
+
 	observabilitysvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/observabilitysvc"
 	clients "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 )
 
 type AppParams struct {
-	AuthMiddleware          jwtassertion.Middleware
-	AgentController         controllers.AgentController
-	InfraResourceController controllers.InfraResourceController
-	BuildCIController       controllers.BuildCIController
-	ObservabilityController controllers.ObservabilityController
+	AuthMiddleware            jwtassertion.Middleware
+	AgentController           controllers.AgentController
+	InfraResourceController   controllers.InfraResourceController
+	BuildCIController         controllers.BuildCIController
+	ObservabilityController   controllers.ObservabilityController
+	AccessControlController   controllers.AccessControlController
+	AccessControlManager      services.AccessControlManager
+	AuditController           controllers.AuditController
+	AuditManager              services.AuditManager
+	WebhookController         controllers.WebhookController
+	WebhookDispatcher         services.WebhookDispatcher
+	ModelPriceController      controllers.ModelPriceController
+	TraceAnnotationController controllers.TraceAnnotationController
 }
 
 // TestClients contains all mock clients needed for testing
@@ -45,5 +56,15 @@ func ProvideConfigFromPtr(config *config.Config) config.Config {
 }
 
 func ProvideAuthMiddleware(config config.Config) jwtassertion.Middleware {
+	if config.JWT.VerificationEnabled {
+		verifier := jwtassertion.NewVerifier(jwtassertion.VerifierConfig{
+			JWKSURL:   config.JWT.JWKSURL,
+			Issuer:    config.JWT.Issuer,
+			Audience:  config.JWT.Audience,
+			CacheTTL:  time.Duration(config.JWT.JWKSCacheTTLSeconds) * time.Second,
+			ClockSkew: time.Duration(config.JWT.ClockSkewSeconds) * time.Second,
+		})
+		return jwtassertion.JWTVerificationMiddleware(config.AuthHeader, verifier)
+	}
 	return jwtassertion.JWTAuthMiddleware(config.AuthHeader)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 )
 
@@ -43,6 +44,18 @@ var repositoryProviderSet = wire.NewSet(
 	repositories.NewAgentRepository,
 	repositories.NewProjectRepository,
 	repositories.NewInternalAgentRepository,
+	repositories.NewDeploymentRevisionRepository,
+	repositories.NewAgentSecretRepository,
+	repositories.NewMembershipRepository,
+	repositories.NewAuditEventRepository,
+	repositories.NewWebhookRepository,
+	repositories.NewModelPriceRepository,
+	repositories.NewTraceAnnotationRepository,
+	repositories.NewAgentFeedbackKeyRepository,
+)
+
+var secretsProviderSet = wire.NewSet(
+	secrets.NewEnvelopeEncryptor,
 )
 
 var clientProviderSet = wire.NewSet(
@@ -56,6 +69,12 @@ var serviceProviderSet = wire.NewSet(
 	services.NewBuildCIManager,
 	services.NewInfraResourceManager,
 	services.NewObservabilityManager,
+	services.NewAccessControlManager,
+	services.NewAuditManager,
+	services.NewWebhookManager,
+	services.NewWebhookDispatcher,
+	services.NewModelPriceManager,
+	services.NewTraceAnnotationManager,
 )
 
 var controllerProviderSet = wire.NewSet(
@@ -63,6 +82,11 @@ var controllerProviderSet = wire.NewSet(
 	controllers.NewBuildCIController,
 	controllers.NewInfraResourceController,
 	controllers.NewObservabilityController,
+	controllers.NewAccessControlController,
+	controllers.NewAuditController,
+	controllers.NewWebhookController,
+	controllers.NewModelPriceController,
+	controllers.NewTraceAnnotationController,
 )
 
 var testClientProviderSet = wire.NewSet(
@@ -100,6 +124,7 @@ func InitializeAppParams(cfg *config.Config) (*AppParams, error) {
 		configProviderSet,
 		repositoryProviderSet,
 		clientProviderSet,
+		secretsProviderSet,
 		loggerProviderSet,
 		serviceProviderSet,
 		controllerProviderSet,
@@ -113,6 +138,7 @@ func InitializeTestAppParamsWithClientMocks(cfg *config.Config, authMiddleware j
 	wire.Build(
 		repositoryProviderSet,
 		testClientProviderSet,
+		secretsProviderSet,
 		loggerProviderSet,
 		serviceProviderSet,
 		controllerProviderSet,
@@ -18,6 +18,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/repositories"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/secrets"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 )
 
@@ -30,27 +31,58 @@ func InitializeAppParams(cfg *config.Config) (*AppParams, error) {
 	projectRepository := repositories.NewProjectRepository()
 	agentRepository := repositories.NewAgentRepository()
 	internalAgentRepository := repositories.NewInternalAgentRepository()
+	deploymentRevisionRepository := repositories.NewDeploymentRevisionRepository()
+	agentSecretRepository := repositories.NewAgentSecretRepository()
 	openChoreoSvcClient, err := openchoreosvc.NewOpenChoreoSvcClient()
 	if err != nil {
 		return nil, err
 	}
 	observabilitySvcClient := observabilitysvc.NewObservabilitySvcClient()
+	encryptor, err := secrets.NewEnvelopeEncryptor()
+	if err != nil {
+		return nil, err
+	}
 	logger := ProvideLogger()
-	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, openChoreoSvcClient, observabilitySvcClient, logger)
+	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, deploymentRevisionRepository, agentSecretRepository, openChoreoSvcClient, observabilitySvcClient, encryptor, logger)
 	agentController := controllers.NewAgentController(agentManagerService)
-	infraResourceManager := services.NewInfraResourceManager(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, logger)
-	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
 	buildCIManagerService := services.NewBuildCIManager(openChoreoSvcClient, logger, organizationRepository, projectRepository, agentRepository)
 	buildCIController := controllers.NewBuildCIController(buildCIManagerService)
 	traceObserverClient := traceobserversvc.NewTraceObserverClient()
-	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, openChoreoSvcClient, logger)
+	modelPriceRepository := repositories.NewModelPriceRepository()
+	traceAnnotationRepository := repositories.NewTraceAnnotationRepository()
+	agentFeedbackKeyRepository := repositories.NewAgentFeedbackKeyRepository()
+	membershipRepository := repositories.NewMembershipRepository()
+	accessControlManager := services.NewAccessControlManager(organizationRepository, projectRepository, membershipRepository, logger)
+	infraResourceManager := services.NewInfraResourceManager(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, accessControlManager, logger)
+	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
+	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, observabilitySvcClient, openChoreoSvcClient, organizationRepository, projectRepository, modelPriceRepository, traceAnnotationRepository, accessControlManager, logger)
 	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
+	accessControlController := controllers.NewAccessControlController(accessControlManager)
+	auditEventRepository := repositories.NewAuditEventRepository()
+	auditManager := services.NewAuditManager(organizationRepository, auditEventRepository, logger)
+	auditController := controllers.NewAuditController(auditManager)
+	webhookRepository := repositories.NewWebhookRepository()
+	webhookManager := services.NewWebhookManager(organizationRepository, projectRepository, webhookRepository, encryptor, logger)
+	webhookController := controllers.NewWebhookController(webhookManager)
+	webhookDispatcher := services.NewWebhookDispatcher(openChoreoSvcClient, webhookRepository, encryptor, logger)
+	modelPriceManager := services.NewModelPriceManager(organizationRepository, modelPriceRepository, logger)
+	modelPriceController := controllers.NewModelPriceController(modelPriceManager)
+	traceAnnotationManager := services.NewTraceAnnotationManager(organizationRepository, projectRepository, traceAnnotationRepository, agentFeedbackKeyRepository, openChoreoSvcClient, logger)
+	traceAnnotationController := controllers.NewTraceAnnotationController(traceAnnotationManager)
 	appParams := &AppParams{
-		AuthMiddleware:          middleware,
-		AgentController:         agentController,
-		InfraResourceController: infraResourceController,
-		BuildCIController:       buildCIController,
-		ObservabilityController: observabilityController,
+		AuthMiddleware:            middleware,
+		AgentController:           agentController,
+		InfraResourceController:   infraResourceController,
+		BuildCIController:         buildCIController,
+		ObservabilityController:   observabilityController,
+		AccessControlController:   accessControlController,
+		AccessControlManager:      accessControlManager,
+		AuditController:           auditController,
+		AuditManager:              auditManager,
+		WebhookController:         webhookController,
+		WebhookDispatcher:         webhookDispatcher,
+		ModelPriceController:      modelPriceController,
+		TraceAnnotationController: traceAnnotationController,
 	}
 	return appParams, nil
 }
@@ -60,24 +92,55 @@ func InitializeTestAppParamsWithClientMocks(cfg *config.Config, authMiddleware j
 	projectRepository := repositories.NewProjectRepository()
 	agentRepository := repositories.NewAgentRepository()
 	internalAgentRepository := repositories.NewInternalAgentRepository()
+	deploymentRevisionRepository := repositories.NewDeploymentRevisionRepository()
+	agentSecretRepository := repositories.NewAgentSecretRepository()
 	openChoreoSvcClient := ProvideTestOpenChoreoSvcClient(testClients)
 	observabilitySvcClient := ProvideTestObservabilitySvcClient(testClients)
+	encryptor, err := secrets.NewEnvelopeEncryptor()
+	if err != nil {
+		return nil, err
+	}
 	logger := ProvideLogger()
-	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, openChoreoSvcClient, observabilitySvcClient, logger)
+	agentManagerService := services.NewAgentManagerService(organizationRepository, projectRepository, agentRepository, internalAgentRepository, deploymentRevisionRepository, agentSecretRepository, openChoreoSvcClient, observabilitySvcClient, encryptor, logger)
 	agentController := controllers.NewAgentController(agentManagerService)
-	infraResourceManager := services.NewInfraResourceManager(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, logger)
-	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
 	buildCIManagerService := services.NewBuildCIManager(openChoreoSvcClient, logger, organizationRepository, projectRepository, agentRepository)
 	buildCIController := controllers.NewBuildCIController(buildCIManagerService)
 	traceObserverClient := ProvideTestTraceObserverClient(testClients)
-	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, openChoreoSvcClient, logger)
+	modelPriceRepository := repositories.NewModelPriceRepository()
+	traceAnnotationRepository := repositories.NewTraceAnnotationRepository()
+	agentFeedbackKeyRepository := repositories.NewAgentFeedbackKeyRepository()
+	membershipRepository := repositories.NewMembershipRepository()
+	accessControlManager := services.NewAccessControlManager(organizationRepository, projectRepository, membershipRepository, logger)
+	infraResourceManager := services.NewInfraResourceManager(organizationRepository, projectRepository, agentRepository, openChoreoSvcClient, accessControlManager, logger)
+	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
+	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, observabilitySvcClient, openChoreoSvcClient, organizationRepository, projectRepository, modelPriceRepository, traceAnnotationRepository, accessControlManager, logger)
 	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
+	accessControlController := controllers.NewAccessControlController(accessControlManager)
+	auditEventRepository := repositories.NewAuditEventRepository()
+	auditManager := services.NewAuditManager(organizationRepository, auditEventRepository, logger)
+	auditController := controllers.NewAuditController(auditManager)
+	webhookRepository := repositories.NewWebhookRepository()
+	webhookManager := services.NewWebhookManager(organizationRepository, projectRepository, webhookRepository, encryptor, logger)
+	webhookController := controllers.NewWebhookController(webhookManager)
+	webhookDispatcher := services.NewWebhookDispatcher(openChoreoSvcClient, webhookRepository, encryptor, logger)
+	modelPriceManager := services.NewModelPriceManager(organizationRepository, modelPriceRepository, logger)
+	modelPriceController := controllers.NewModelPriceController(modelPriceManager)
+	traceAnnotationManager := services.NewTraceAnnotationManager(organizationRepository, projectRepository, traceAnnotationRepository, agentFeedbackKeyRepository, openChoreoSvcClient, logger)
+	traceAnnotationController := controllers.NewTraceAnnotationController(traceAnnotationManager)
 	appParams := &AppParams{
-		AuthMiddleware:          authMiddleware,
-		AgentController:         agentController,
-		InfraResourceController: infraResourceController,
-		BuildCIController:       buildCIController,
-		ObservabilityController: observabilityController,
+		AuthMiddleware:            authMiddleware,
+		AgentController:           agentController,
+		InfraResourceController:   infraResourceController,
+		BuildCIController:         buildCIController,
+		ObservabilityController:   observabilityController,
+		AccessControlController:   accessControlController,
+		AccessControlManager:      accessControlManager,
+		AuditController:           auditController,
+		AuditManager:              auditManager,
+		WebhookController:         webhookController,
+		WebhookDispatcher:         webhookDispatcher,
+		ModelPriceController:      modelPriceController,
+		TraceAnnotationController: traceAnnotationController,
 	}
 	return appParams, nil
 }
@@ -88,13 +151,15 @@ var configProviderSet = wire.NewSet(
 	ProvideConfigFromPtr,
 )
 
-var repositoryProviderSet = wire.NewSet(repositories.NewOrganizationRepository, repositories.NewAgentRepository, repositories.NewProjectRepository, repositories.NewInternalAgentRepository)
+var repositoryProviderSet = wire.NewSet(repositories.NewOrganizationRepository, repositories.NewAgentRepository, repositories.NewProjectRepository, repositories.NewInternalAgentRepository, repositories.NewDeploymentRevisionRepository, repositories.NewAgentSecretRepository, repositories.NewMembershipRepository, repositories.NewAuditEventRepository, repositories.NewWebhookRepository, repositories.NewModelPriceRepository, repositories.NewTraceAnnotationRepository, repositories.NewAgentFeedbackKeyRepository)
+
+var secretsProviderSet = wire.NewSet(secrets.NewEnvelopeEncryptor)
 
 var clientProviderSet = wire.NewSet(openchoreosvc.NewOpenChoreoSvcClient, observabilitysvc.NewObservabilitySvcClient, traceobserversvc.NewTraceObserverClient)
 
-var serviceProviderSet = wire.NewSet(services.NewAgentManagerService, services.NewBuildCIManager, services.NewInfraResourceManager, services.NewObservabilityManager)
+var serviceProviderSet = wire.NewSet(services.NewAgentManagerService, services.NewBuildCIManager, services.NewInfraResourceManager, services.NewObservabilityManager, services.NewAccessControlManager, services.NewAuditManager, services.NewWebhookManager, services.NewWebhookDispatcher, services.NewModelPriceManager, services.NewTraceAnnotationManager)
 
-var controllerProviderSet = wire.NewSet(controllers.NewAgentController, controllers.NewBuildCIController, controllers.NewInfraResourceController, controllers.NewObservabilityController)
+var controllerProviderSet = wire.NewSet(controllers.NewAgentController, controllers.NewBuildCIController, controllers.NewInfraResourceController, controllers.NewObservabilityController, controllers.NewAccessControlController, controllers.NewAuditController, controllers.NewWebhookController, controllers.NewModelPriceController, controllers.NewTraceAnnotationController)
 
 var testClientProviderSet = wire.NewSet(
 	ProvideTestOpenChoreoSvcClient,
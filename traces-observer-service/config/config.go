@@ -20,25 +20,104 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config holds all configuration for the tracing service
 type Config struct {
 	Server     ServerConfig
+	Store      StoreConfig
 	OpenSearch OpenSearchConfig
+	Sessions   SessionConfig
+	Query      QueryConfig
+	Auth       AuthConfig
+	Redaction  RedactionConfig
 	LogLevel   string
 }
 
+// Trace store backends
+const (
+	StoreBackendOpenSearch = "opensearch"
+	StoreBackendMemory     = "memory"
+)
+
+// StoreConfig holds configuration for the trace store
+type StoreConfig struct {
+	// Backend is the store that traces are read from: opensearch, or memory for local development
+	Backend string
+	// SeedFile is an optional JSON file of spans loaded into the memory store at startup
+	SeedFile string
+}
+
 // ServerConfig holds HTTP server configuration
 type ServerConfig struct {
 	Port int
 }
 
+// Trace index granularities
+const (
+	IndexGranularityDaily  = "daily"
+	IndexGranularityHourly = "hourly"
+	IndexGranularityNone   = "none" // A single data stream or alias
+)
+
 // OpenSearchConfig holds OpenSearch connection configuration
 type OpenSearchConfig struct {
 	Address  string
 	Username string
 	Password string
+	// IndexPattern names the trace indices, with the {yyyy}, {MM}, {dd} and {HH} UTC date
+	// tokens of the granularity, or the data stream or alias name when the granularity is none
+	IndexPattern string
+	// IndexGranularity is how often the trace indices roll over: daily, hourly or none
+	IndexGranularity string
+}
+
+// SessionConfig holds configuration for grouping traces into sessions
+type SessionConfig struct {
+	// IDAttribute is an additional span attribute that identifies the session of a trace,
+	// checked before session.id and gen_ai.conversation.id
+	IDAttribute string
+}
+
+// QueryConfig holds defaults for trace queries
+type QueryConfig struct {
+	// LookbackDays is the number of days searched for a trace or session when no time range is given
+	LookbackDays int
+}
+
+// Authentication modes
+const (
+	AuthModeNone   = "none"
+	AuthModeAPIKey = "api-key"
+	AuthModeJWT    = "jwt"
+)
+
+// AuthConfig holds authentication configuration for the query API
+type AuthConfig struct {
+	// Mode is how callers authenticate: jwt (the default) for HS256 tokens scoped to the components and
+	// environments they list, api-key for a shared key granting access to all components, or none, which
+	// is only allowed with the memory store for local development
+	Mode string
+	// APIKeyHeader is the request header carrying the API key
+	APIKeyHeader string
+	APIKey       string
+	// JWTSecret is the key shared with the token issuer
+	JWTSecret string
+	// JWTIssuer is the expected "iss" claim; not checked when empty
+	JWTIssuer string
+	// JWTAudience is the expected "aud" claim; not checked when empty
+	JWTAudience string
+	// ClockSkewSeconds is the allowed clock skew when checking "exp" and "nbf"
+	ClockSkewSeconds int
+}
+
+// RedactionConfig holds configuration for redacting sensitive values from returned spans
+type RedactionConfig struct {
+	// PolicyFile is an optional JSON file with the default and per-organization redaction policies
+	PolicyFile string
+	// Detectors are the built-in detectors of the default policy when there is no policy file
+	Detectors []string
 }
 
 // Load loads configuration from environment variables with defaults
@@ -47,10 +126,36 @@ func Load() (*Config, error) {
 		Server: ServerConfig{
 			Port: getEnvAsInt("TRACES_OBSERVER_PORT", 9098),
 		},
+		Store: StoreConfig{
+			Backend:  getEnv("TRACE_STORE", StoreBackendOpenSearch),
+			SeedFile: getEnv("TRACE_STORE_SEED_FILE", ""),
+		},
 		OpenSearch: OpenSearchConfig{
 			Address:  getEnv("OPENSEARCH_ADDRESS", "https://localhost:9200"),
 			Username: getEnv("OPENSEARCH_USERNAME", ""),
 			Password: getEnv("OPENSEARCH_PASSWORD", ""),
+
+			IndexPattern:     getEnv("OPENSEARCH_INDEX_PATTERN", "otel-traces-{yyyy}-{MM}-{dd}"),
+			IndexGranularity: getEnv("OPENSEARCH_INDEX_GRANULARITY", IndexGranularityDaily),
+		},
+		Sessions: SessionConfig{
+			IDAttribute: getEnv("SESSION_ID_ATTRIBUTE", ""),
+		},
+		Query: QueryConfig{
+			LookbackDays: getEnvAsInt("TRACE_LOOKBACK_DAYS", 7),
+		},
+		Auth: AuthConfig{
+			Mode:             getEnv("AUTH_MODE", AuthModeJWT),
+			APIKeyHeader:     getEnv("AUTH_API_KEY_HEADER", "X-API-Key"),
+			APIKey:           getEnv("AUTH_API_KEY", ""),
+			JWTSecret:        getEnv("AUTH_JWT_SECRET", ""),
+			JWTIssuer:        getEnv("AUTH_JWT_ISSUER", ""),
+			JWTAudience:      getEnv("AUTH_JWT_AUDIENCE", ""),
+			ClockSkewSeconds: getEnvAsInt("AUTH_JWT_CLOCK_SKEW_SECONDS", 60),
+		},
+		Redaction: RedactionConfig{
+			PolicyFile: getEnv("REDACTION_POLICY_FILE", ""),
+			Detectors:  getEnvAsList("REDACTION_DETECTORS"),
 		},
 		LogLevel: getEnv("LOG_LEVEL", "INFO"),
 	}
@@ -64,14 +169,47 @@ func Load() (*Config, error) {
 }
 
 func (c *Config) validate() error {
-	if c.OpenSearch.Username == "" || c.OpenSearch.Password == "" {
-		return fmt.Errorf("opensearch username and password are required")
-	}
 	if c.Server.Port <= 0 || c.Server.Port > 65535 {
 		return fmt.Errorf("invalid server port: %d", c.Server.Port)
 	}
-	if c.OpenSearch.Address == "" {
-		return fmt.Errorf("opensearch address is required")
+	if c.Query.LookbackDays <= 0 {
+		return fmt.Errorf("invalid trace lookback days: %d", c.Query.LookbackDays)
+	}
+	switch c.Auth.Mode {
+	case AuthModeNone:
+		if c.Store.Backend != StoreBackendMemory {
+			return fmt.Errorf("auth mode %s is only allowed with the %s trace store", AuthModeNone, StoreBackendMemory)
+		}
+	case AuthModeAPIKey:
+		if c.Auth.APIKey == "" {
+			return fmt.Errorf("auth api key is required when auth mode is %s", AuthModeAPIKey)
+		}
+	case AuthModeJWT:
+		if c.Auth.JWTSecret == "" {
+			return fmt.Errorf("auth jwt secret is required when auth mode is %s, set AUTH_JWT_SECRET", AuthModeJWT)
+		}
+		if c.Auth.ClockSkewSeconds < 0 {
+			return fmt.Errorf("invalid auth jwt clock skew seconds: %d", c.Auth.ClockSkewSeconds)
+		}
+	default:
+		return fmt.Errorf("invalid auth mode: %s", c.Auth.Mode)
+	}
+	switch c.Store.Backend {
+	case StoreBackendOpenSearch:
+		if c.OpenSearch.Username == "" || c.OpenSearch.Password == "" {
+			return fmt.Errorf("opensearch username and password are required")
+		}
+		if c.OpenSearch.Address == "" {
+			return fmt.Errorf("opensearch address is required")
+		}
+		switch c.OpenSearch.IndexGranularity {
+		case IndexGranularityDaily, IndexGranularityHourly, IndexGranularityNone:
+		default:
+			return fmt.Errorf("invalid opensearch index granularity: %s", c.OpenSearch.IndexGranularity)
+		}
+	case StoreBackendMemory:
+	default:
+		return fmt.Errorf("invalid trace store: %s", c.Store.Backend)
 	}
 	return nil
 }
@@ -84,6 +222,17 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvAsList splits a comma separated variable, ignoring empty items
+func getEnvAsList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func getEnvAsInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if intVal, err := strconv.Atoi(value); err == nil {
@@ -19,34 +19,53 @@ package controllers
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sort"
 	"time"
 
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
-	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/redaction"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
 )
 
 // ErrTraceNotFound is returned when a trace is not found
 var ErrTraceNotFound = errors.New("trace not found")
 
+// ErrSessionNotFound is returned when no traces of a session are found
+var ErrSessionNotFound = errors.New("session not found")
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+var ErrInvalidCursor = traces.ErrInvalidCursor
+
+const (
+	// maxFilteredTraces bounds the number of traces that span filters can select
+	maxFilteredTraces = 10000
+	// maxSessionTraces bounds the number of traces that can be grouped into sessions
+	maxSessionTraces = 10000
+)
+
 // TracingController provides tracing functionality
 type TracingController struct {
-	osClient *opensearch.Client
+	store             traces.TraceStore
+	sessionAttributes []string            // Span attributes that identify the session of a trace
+	redactor          *redaction.Redactor // Redacts sensitive values from the returned spans
 }
 
 // NewTracingController creates a new tracing service
-func NewTracingController(osClient *opensearch.Client) *TracingController {
+func NewTracingController(store traces.TraceStore, sessionAttributes []string, redactor *redaction.Redactor) *TracingController {
 	return &TracingController{
-		osClient: osClient,
+		store:             store,
+		sessionAttributes: sessionAttributes,
+		redactor:          redactor,
 	}
 }
 
-// GetTraceOverviews retrieves unique trace IDs with root span information
-func (s *TracingController) GetTraceOverviews(ctx context.Context, params opensearch.TraceQueryParams) (*opensearch.TraceOverviewResponse, error) {
+// GetTraceOverviews retrieves a page of traces with root span information.
+// Pages are taken over root spans, so each trace is counted once and the total is exact;
+// the spans of the traces on the page are then loaded to compute the trace summaries.
+func (s *TracingController) GetTraceOverviews(ctx context.Context, params traces.TraceQueryParams) (*traces.TraceOverviewResponse, error) {
 	log := logger.GetLogger(ctx)
 	log.Info("Getting trace overviews",
-		"component", params.ComponentUid,
+		"components", params.ComponentUids,
 		"environment", params.EnvironmentUid, "startTime", params.StartTime, "endTime", params.EndTime)
 
 	// Set defaults
@@ -57,183 +76,424 @@ func (s *TracingController) GetTraceOverviews(ctx context.Context, params opense
 		params.Offset = 0
 	}
 
-	// For trace overview, we need to fetch more spans to ensure we get complete traces
-	// Multiply limit by a factor to get enough spans (each trace typically has multiple spans)
-	originalLimit := params.Limit
-	originalOffset := params.Offset
-	params.Limit = params.Limit * 50 // Fetch more spans to capture complete traces
-	params.Offset = 0                // Start from beginning for grouping
-
-	// Use the existing BuildTraceQuery
-	query := opensearch.BuildTraceQuery(params)
+	// Resolve span filters to the traces that contain a matching span, among the requested traces if any.
+	// Only the first maxFilteredTraces matches are listed, which the response reports as truncated.
+	params.TraceIDs = params.Filters.TraceIDs
+	truncated := false
+	if params.Filters.HasSpanFilters() {
+		matches, err := s.store.FindTraceIDsBySpanFilters(ctx, params, maxFilteredTraces)
+		if err != nil {
+			return nil, err
+		}
+		traceIDs := matches.TraceIDs
+		truncated = matches.Truncated
+		if len(params.Filters.TraceIDs) > 0 {
+			traceIDs = intersectTraceIDs(traceIDs, params.Filters.TraceIDs)
+		}
+		if len(traceIDs) == 0 {
+			log.Info("No traces match the span filters", "truncated", truncated)
+			return &traces.TraceOverviewResponse{
+				Traces:     []traces.TraceOverview{},
+				TotalCount: 0,
+				Truncated:  truncated,
+			}, nil
+		}
+		params.TraceIDs = traceIDs
+	}
 
-	// Generate indices based on time range
-	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	page, err := s.store.FindRootSpans(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
+		return nil, err
 	}
-	log.Debug("Searching indices", "indices", indices)
+	rootSpans := page.Spans
 
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
+	traceIDs := make([]string, 0, len(rootSpans))
+	for _, rootSpan := range rootSpans {
+		traceIDs = append(traceIDs, rootSpan.TraceID)
+	}
+	// Traces with more spans than are loaded get incomplete summaries, which is also reported as truncated
+	traceSpans, err := s.store.GetTraceSpans(ctx, traceIDs, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search trace overviews: %w", err)
+		return nil, err
 	}
+	truncated = truncated || traceSpans.Truncated
 
-	// Parse all spans
-	spans := opensearch.ParseSpans(response)
-
-	// Group spans by traceId and find root spans
-	traceMap := make(map[string][]opensearch.Span)
-	for _, span := range spans {
-		traceMap[span.TraceID] = append(traceMap[span.TraceID], span)
+	// Redact before the overviews take their input and output from the root spans
+	policy := s.redactor.PolicyFor(params.OrgName)
+	policy.RedactSpans(rootSpans)
+	for _, spans := range traceSpans.Spans {
+		policy.RedactSpans(spans)
 	}
 
-	// Process each trace to find root span
-	allOverviews := []opensearch.TraceOverview{}
-	for traceID, traceSpans := range traceMap {
-		// Find root span (span with no parentSpanId)
-		var rootSpan *opensearch.Span
-
-		for i := range traceSpans {
-			if traceSpans[i].ParentSpanID == "" {
-				rootSpan = &traceSpans[i]
-				break
-			}
-		}
-
-		// Skip this trace if no root span found
-		if rootSpan == nil {
-			logger.GetLogger(ctx).Warn("No root span found for trace", "traceId", traceID)
+	overviews := make([]traces.TraceOverview, 0, len(rootSpans))
+	seen := make(map[string]bool, len(rootSpans))
+	for i := range rootSpans {
+		rootSpan := &rootSpans[i]
+		// A trace with more than one root span is listed once, under its first root span
+		if seen[rootSpan.TraceID] {
+			log.Warn("Multiple root spans found for trace", "traceId", rootSpan.TraceID)
 			continue
 		}
+		seen[rootSpan.TraceID] = true
 
-		// Extract token usage from GenAI spans
-		tokenUsage := opensearch.ExtractTokenUsage(traceSpans)
-
-		// Extract trace status and error information
-		traceStatus := opensearch.ExtractTraceStatus(traceSpans)
-
-		// Extract input and output from root span
-		// Check if this is a CrewAI workflow span and delegate to CrewAI processor
-		var input, output interface{}
-		if opensearch.IsCrewAISpan(rootSpan.Attributes) {
-			input, output = opensearch.ExtractCrewAIRootSpanInputOutput(rootSpan)
-		} else {
-			input, output = opensearch.ExtractRootSpanInputOutput(rootSpan)
+		spans := traceSpans.Spans[rootSpan.TraceID]
+		if len(spans) == 0 {
+			spans = []traces.Span{*rootSpan}
 		}
+		overviews = append(overviews, buildTraceOverview(rootSpan, spans))
+	}
 
-		// Add to overviews
-		allOverviews = append(allOverviews, opensearch.TraceOverview{
-			TraceID:         traceID,
-			RootSpanID:      rootSpan.SpanID,
-			RootSpanName:    rootSpan.Name,
-			RootSpanKind:    string(opensearch.DetermineSpanType(*rootSpan)),
-			StartTime:       rootSpan.StartTime.Format(time.RFC3339Nano),
-			EndTime:         rootSpan.EndTime.Format(time.RFC3339Nano),
-			DurationInNanos: rootSpan.DurationInNanos,
-			SpanCount:       len(traceSpans),
-			TokenUsage:      tokenUsage,
-			Status:          traceStatus,
-			Input:           input,
-			Output:          output,
-		})
-	}
-
-	// Sort by StartTime (descending) for consistent pagination
-	sort.Slice(allOverviews, func(i, j int) bool {
-		return allOverviews[i].StartTime > allOverviews[j].StartTime
-	})
-
-	// Apply pagination to the trace overviews
-	totalCount := len(allOverviews)
-	start := originalOffset
-	end := originalOffset + originalLimit
+	log.Info("Retrieved trace overviews",
+		"traces", len(overviews),
+		"total_count", page.TotalCount,
+		"has_more", page.NextCursor != "",
+		"truncated", truncated)
+
+	return &traces.TraceOverviewResponse{
+		Traces:     overviews,
+		TotalCount: page.TotalCount,
+		NextCursor: page.NextCursor,
+		Truncated:  truncated,
+	}, nil
+}
 
-	if start >= len(allOverviews) {
-		start = len(allOverviews)
+// intersectTraceIDs returns the trace IDs that are in both lists, in the order of the first
+func intersectTraceIDs(traceIDs []string, allowed []string) []string {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, traceID := range allowed {
+		allowedSet[traceID] = true
 	}
-	if end > len(allOverviews) {
-		end = len(allOverviews)
+	result := make([]string, 0, len(traceIDs))
+	for _, traceID := range traceIDs {
+		if allowedSet[traceID] {
+			result = append(result, traceID)
+		}
 	}
+	return result
+}
 
-	paginatedOverviews := allOverviews[start:end]
+// buildTraceOverview summarizes a trace from its root span and spans
+func buildTraceOverview(rootSpan *traces.Span, traceSpans []traces.Span) traces.TraceOverview {
+	// Extract token usage from GenAI spans
+	tokenUsage := traces.ExtractTokenUsage(traceSpans)
 
-	log.Info("Retrieved trace overviews",
-		"unique_traces", len(allOverviews),
-		"total_spans", len(spans),
-		"showing_start", start,
-		"showing_end", end,
-		"total_count", totalCount)
-
-	return &opensearch.TraceOverviewResponse{
-		Traces:     paginatedOverviews,
-		TotalCount: totalCount,
-	}, nil
+	// Extract trace status and error information
+	traceStatus := traces.ExtractTraceStatus(traceSpans)
+
+	// Extract input and output from root span
+	// Check if this is a CrewAI, LangGraph or OpenInference span and delegate to its processor
+	var input, output interface{}
+	switch {
+	case traces.IsCrewAISpan(rootSpan.Attributes):
+		input, output = traces.ExtractCrewAIRootSpanInputOutput(rootSpan)
+	case traces.IsLangGraphSpan(*rootSpan):
+		input, output = traces.ExtractLangGraphRootSpanInputOutput(rootSpan)
+	case traces.IsOpenInferenceSpan(rootSpan.Attributes):
+		input, output = traces.ExtractOpenInferenceRootSpanInputOutput(rootSpan)
+	default:
+		input, output = traces.ExtractRootSpanInputOutput(rootSpan)
+	}
+
+	return traces.TraceOverview{
+		TraceID:         rootSpan.TraceID,
+		RootSpanID:      rootSpan.SpanID,
+		RootSpanName:    rootSpan.Name,
+		RootSpanKind:    string(traces.DetermineSpanType(*rootSpan)),
+		StartTime:       rootSpan.StartTime.Format(time.RFC3339Nano),
+		EndTime:         rootSpan.EndTime.Format(time.RFC3339Nano),
+		DurationInNanos: rootSpan.DurationInNanos,
+		SpanCount:       len(traceSpans),
+		ComponentUid:    rootSpan.Service,
+		TokenUsage:      tokenUsage,
+		ModelUsage:      traces.ExtractModelTokenUsage(traceSpans),
+		Status:          traceStatus,
+		Input:           input,
+		Output:          output,
+		Redaction:       rootSpan.Redaction,
+	}
 }
 
-// GetTraceByIdAndService retrieves spans for a specific trace ID and component UID
-func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params opensearch.TraceByIdAndServiceParams) (*opensearch.TraceResponse, error) {
+// GetTraceByIdAndService retrieves the spans of a trace that belong to any of the given components
+func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params traces.TraceByIdAndServiceParams) (*traces.TraceResponse, error) {
 	log := logger.GetLogger(ctx)
 	log.Info("Getting trace by ID",
 		"traceId", params.TraceID,
-		"component", params.ComponentUid,
+		"components", params.ComponentUids,
 		"environment", params.EnvironmentUid)
 
-	// Build query
-	query := opensearch.BuildTraceByIdAndServiceQuery(params)
-
-	// For trace by ID queries, we need to search across a broader time range
-	// Use current day and previous 7 days as default
-	endTime := time.Now()
-	startTime := endTime.AddDate(0, 0, -7)
-	indices, err := opensearch.GetIndicesForTimeRange(
-		startTime.Format(time.RFC3339),
-		endTime.Format(time.RFC3339),
-	)
+	spans, err := s.store.GetTrace(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate indices: %w", err)
+		return nil, err
 	}
-	log.Debug("Searching indices for trace ID", "indices", indices)
-
-	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search traces: %w", err)
-	}
-
-	// Parse spans
-	spans := opensearch.ParseSpans(response)
 
 	if len(spans) == 0 {
 		log.Warn("No spans found for trace",
 			"traceId", params.TraceID,
-			"component", params.ComponentUid,
+			"components", params.ComponentUids,
 			"environment", params.EnvironmentUid)
 		return nil, ErrTraceNotFound
 	}
 
+	s.redactor.PolicyFor(params.OrgName).RedactSpans(spans)
+
 	// Extract token usage from GenAI spans
-	tokenUsage := opensearch.ExtractTokenUsage(spans)
+	tokenUsage := traces.ExtractTokenUsage(spans)
 
 	// Extract trace status and error information
-	traceStatus := opensearch.ExtractTraceStatus(spans)
+	traceStatus := traces.ExtractTraceStatus(spans)
 
 	log.Info("Retrieved trace spans",
 		"span_count", len(spans),
 		"traceId", params.TraceID,
-		"component", params.ComponentUid,
+		"components", params.ComponentUids,
 		"environment", params.EnvironmentUid)
 
-	return &opensearch.TraceResponse{
+	return &traces.TraceResponse{
 		Spans:      spans,
 		TotalCount: len(spans),
 		TokenUsage: tokenUsage,
+		ModelUsage: traces.ExtractModelTokenUsage(spans),
 		Status:     traceStatus,
 	}, nil
 }
 
+// GetMetrics retrieves time-bucketed request, error, latency and token metrics of a component
+func (s *TracingController) GetMetrics(ctx context.Context, params traces.MetricsQueryParams) (*traces.MetricsResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting metrics",
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime, "endTime", params.EndTime, "interval", params.Interval)
+
+	metrics, err := s.store.GetMetrics(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Retrieved metrics",
+		"buckets", len(metrics.Buckets),
+		"request_count", metrics.Summary.RequestCount,
+		"error_count", metrics.Summary.ErrorCount)
+
+	return metrics, nil
+}
+
+// GetTokenUsage retrieves the daily token usage of components per vendor and model
+func (s *TracingController) GetTokenUsage(ctx context.Context, params traces.TokenUsageQueryParams) (*traces.TokenUsageResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting token usage",
+		"components", len(params.ComponentUids),
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime, "endTime", params.EndTime)
+
+	usage, err := s.store.GetTokenUsage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Retrieved token usage", "entries", len(usage.Usage))
+
+	return usage, nil
+}
+
+// ListSessions retrieves a page of sessions, most recently active first.
+// Traces are grouped by the session ID of their spans; each trace is one turn of a session.
+func (s *TracingController) ListSessions(ctx context.Context, params traces.SessionQueryParams) (*traces.SessionListResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Listing sessions",
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid, "startTime", params.StartTime, "endTime", params.EndTime)
+
+	// Set defaults
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+	if params.Offset < 0 {
+		params.Offset = 0
+	}
+
+	sessions, truncated, err := s.findSessions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	traces.SortSessionsByActivity(sessions)
+
+	page := []*traces.SessionTraces{}
+	if params.Offset < len(sessions) {
+		page = sessions[params.Offset:min(params.Offset+params.Limit, len(sessions))]
+	}
+
+	traceIDs := []string{}
+	for _, session := range page {
+		traceIDs = append(traceIDs, session.TraceIDs...)
+	}
+	turns, turnsTruncated, err := s.getSessionTurns(ctx, traceIDs, params)
+	if err != nil {
+		return nil, err
+	}
+	truncated = truncated || turnsTruncated
+
+	overviews := make([]traces.SessionOverview, 0, len(page))
+	for _, session := range page {
+		overviews = append(overviews, traces.BuildSessionOverview(session.SessionID, sessionTurns(session, turns)))
+	}
+
+	log.Info("Retrieved sessions", "sessions", len(overviews), "total_count", len(sessions), "truncated", truncated)
+
+	return &traces.SessionListResponse{
+		Sessions:   overviews,
+		TotalCount: len(sessions),
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetSession retrieves a session with its turns ordered by start time
+func (s *TracingController) GetSession(ctx context.Context, params traces.SessionQueryParams) (*traces.SessionResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting session",
+		"sessionId", params.SessionID,
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid, "startTime", params.StartTime, "endTime", params.EndTime)
+
+	sessions, truncated, err := s.findSessions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		log.Warn("No traces found for session", "sessionId", params.SessionID, "component", params.ComponentUid)
+		return nil, ErrSessionNotFound
+	}
+
+	turns, turnsTruncated, err := s.getSessionTurns(ctx, sessions[0].TraceIDs, params)
+	if err != nil {
+		return nil, err
+	}
+	truncated = truncated || turnsTruncated
+	sessionTurns := sessionTurns(sessions[0], turns)
+
+	log.Info("Retrieved session", "sessionId", params.SessionID, "turns", len(sessionTurns), "truncated", truncated)
+
+	return &traces.SessionResponse{
+		SessionOverview: traces.BuildSessionOverview(params.SessionID, sessionTurns),
+		Turns:           sessionTurns,
+		Truncated:       truncated,
+	}, nil
+}
+
+// findSessions groups the traces that have spans with a session ID by session.
+// A trace belongs to the session of the first of its spans that has a session ID.
+// When params.SessionID is set, all traces with a span of that session are grouped under it.
+// It also reports whether a span or trace limit was reached, in which case sessions or turns may be missing.
+func (s *TracingController) findSessions(ctx context.Context, params traces.SessionQueryParams) ([]*traces.SessionTraces, bool, error) {
+	found, err := s.store.FindSessionSpans(ctx, params, s.sessionAttributes)
+	if err != nil {
+		return nil, false, err
+	}
+	truncated := found.Truncated
+
+	sessions := []*traces.SessionTraces{}
+	sessionsByID := make(map[string]*traces.SessionTraces)
+	traceSessions := make(map[string]*traces.SessionTraces)
+
+	for _, span := range found.Spans {
+		session, ok := traceSessions[span.TraceID]
+		if !ok {
+			if len(traceSessions) == maxSessionTraces {
+				truncated = true
+				continue
+			}
+			sessionID := params.SessionID
+			if sessionID == "" {
+				sessionID = traces.ExtractSessionID(span.Attributes, s.sessionAttributes)
+				if sessionID == "" {
+					continue
+				}
+			}
+			session, ok = sessionsByID[sessionID]
+			if !ok {
+				session = &traces.SessionTraces{SessionID: sessionID}
+				sessionsByID[sessionID] = session
+				sessions = append(sessions, session)
+			}
+			session.TraceIDs = append(session.TraceIDs, span.TraceID)
+			traceSessions[span.TraceID] = session
+		}
+		if span.StartTime.After(session.LastActivity) {
+			session.LastActivity = span.StartTime
+		}
+	}
+
+	if truncated {
+		logger.GetLogger(ctx).Warn("Limit reached while grouping sessions, results may be incomplete",
+			"max_traces", maxSessionTraces)
+	}
+	return sessions, truncated, nil
+}
+
+// getSessionTurns loads the spans of the given traces and summarizes each trace as a session turn.
+// It also reports whether the store stopped loading spans at its limit, leaving turns incomplete.
+func (s *TracingController) getSessionTurns(ctx context.Context, traceIDs []string, params traces.SessionQueryParams) (map[string]traces.TraceOverview, bool, error) {
+	traceSpans, err := s.store.GetTraceSpans(ctx, traceIDs, traces.TraceQueryParams{
+		ComponentUids:  []string{params.ComponentUid},
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.StartTime,
+		EndTime:        params.EndTime,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	policy := s.redactor.PolicyFor(params.OrgName)
+	turns := make(map[string]traces.TraceOverview, len(traceSpans.Spans))
+	for traceID, spans := range traceSpans.Spans {
+		policy.RedactSpans(spans)
+		// Spans are sorted by start time, so the first span stands in for a missing root span
+		rootSpan := &spans[0]
+		for i := range spans {
+			if spans[i].ParentSpanID == "" {
+				rootSpan = &spans[i]
+				break
+			}
+		}
+		turns[traceID] = buildTraceOverview(rootSpan, spans)
+	}
+	return turns, traceSpans.Truncated, nil
+}
+
+// sessionTurns returns the turns of a session ordered by start time
+func sessionTurns(session *traces.SessionTraces, turns map[string]traces.TraceOverview) []traces.TraceOverview {
+	result := make([]traces.TraceOverview, 0, len(session.TraceIDs))
+	for _, traceID := range session.TraceIDs {
+		if turn, ok := turns[traceID]; ok {
+			result = append(result, turn)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return parseOverviewTime(result[i].StartTime).Before(parseOverviewTime(result[j].StartTime))
+	})
+	return result
+}
+
+// parseOverviewTime parses a time of a trace overview, returning the zero time if it is invalid
+func parseOverviewTime(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, value)
+	return t
+}
+
+// WriteSpans stores spans sent by agents
+func (s *TracingController) WriteSpans(ctx context.Context, spans []traces.Span) error {
+	log := logger.GetLogger(ctx)
+	log.Info("Writing spans", "spans", len(spans))
+
+	if err := s.store.WriteSpans(ctx, spans); err != nil {
+		return err
+	}
+
+	log.Info("Wrote spans", "spans", len(spans))
+	return nil
+}
+
 // HealthCheck checks if the service is healthy
 func (s *TracingController) HealthCheck(ctx context.Context) error {
-	return s.osClient.HealthCheck(ctx)
+	return s.store.HealthCheck(ctx)
 }
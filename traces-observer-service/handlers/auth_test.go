@@ -0,0 +1,217 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/memory"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/redaction"
+)
+
+const testSecret = "test-secret"
+
+// newScopedHandler returns the routes of the service behind JWT authentication, backed by a memory store
+// and redacting with the policies of the configuration
+func newScopedHandler(t *testing.T, redactionConfig *redaction.Config) http.Handler {
+	t.Helper()
+	redactor, err := redaction.NewRedactor(redactionConfig)
+	if err != nil {
+		t.Fatalf("failed to create redactor: %v", err)
+	}
+	handler := NewHandler(controllers.NewTracingController(memory.NewStore(), nil, redactor), 24*time.Hour)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/traces", handler.GetTraceOverviews)
+	mux.HandleFunc("/api/v1/trace", handler.GetTraceByIdAndService)
+	mux.HandleFunc("/api/v1/metrics", handler.GetMetrics)
+	mux.HandleFunc("/v1/traces", handler.ReceiveTraces)
+	return auth.JWTMiddleware(auth.NewVerifier(auth.VerifierConfig{Secret: testSecret}))(mux)
+}
+
+// signToken creates an HS256 token granting the organization access to the components in the environments
+func signToken(t *testing.T, orgName string, componentUids []string, environmentUids []string) string {
+	t.Helper()
+	encode := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to encode token segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signingInput := encode(map[string]string{"alg": "HS256"}) + "." + encode(map[string]interface{}{
+		"exp":             time.Now().Add(time.Minute).Unix(),
+		"org":             orgName,
+		"componentUids":   componentUids,
+		"environmentUids": environmentUids,
+	})
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// otlpRequest returns an OTLP/JSON export request with one span of the component in the environment
+func otlpRequest(componentUid string, environmentUid string) string {
+	return `{"resourceSpans":[{"resource":{"attributes":[` +
+		`{"key":"openchoreo.dev/component-uid","value":{"stringValue":"` + componentUid + `"}},` +
+		`{"key":"openchoreo.dev/environment-uid","value":{"stringValue":"` + environmentUid + `"}}]},` +
+		`"scopeSpans":[{"spans":[{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174",` +
+		`"name":"invoke_agent","startTimeUnixNano":"1700000000000000000","endTimeUnixNano":"1700000001000000000",` +
+		`"attributes":[{"key":"input.value","value":{"stringValue":"mail jane@example.com"}}]}]}]}]}`
+}
+
+func TestHandlersEnforceTokenScope(t *testing.T) {
+	handler := newScopedHandler(t, nil)
+	token := signToken(t, "", []string{"component-1"}, []string{"env-1"})
+	timeRange := "&startTime=2025-01-01T00:00:00Z&endTime=2025-01-02T00:00:00Z"
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "traces of the token's component",
+			method:     http.MethodGet,
+			target:     "/api/v1/traces?componentUid=component-1&environmentUid=env-1",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "traces of another component",
+			method:     http.MethodGet,
+			target:     "/api/v1/traces?componentUid=component-2&environmentUid=env-1",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "traces of the token's component and another component",
+			method:     http.MethodGet,
+			target:     "/api/v1/traces?componentUid=component-1&componentUid=component-2&environmentUid=env-1",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "traces in another environment",
+			method:     http.MethodGet,
+			target:     "/api/v1/traces?componentUid=component-1&environmentUid=env-2",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "trace of another component",
+			method:     http.MethodGet,
+			target:     "/api/v1/trace?traceId=trace-1&componentUid=component-2&environmentUid=env-1",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "metrics of another component",
+			method:     http.MethodGet,
+			target:     "/api/v1/metrics?componentUid=component-2&environmentUid=env-1" + timeRange,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "spans of the token's component",
+			method:     http.MethodPost,
+			target:     "/v1/traces",
+			body:       otlpRequest("component-1", "env-1"),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "spans of another component",
+			method:     http.MethodPost,
+			target:     "/v1/traces",
+			body:       otlpRequest("component-2", "env-1"),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "spans in another environment",
+			method:     http.MethodPost,
+			target:     "/v1/traces",
+			body:       otlpRequest("component-1", "env-2"),
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandlersApplyRedactionPolicyOfToken(t *testing.T) {
+	handler := newScopedHandler(t, &redaction.Config{
+		Default: &redaction.PolicyConfig{Detectors: []string{redaction.DetectorEmail}},
+		Organizations: map[string]*redaction.PolicyConfig{
+			"acme": {Detectors: []string{redaction.DetectorEmail}},
+			"lax":  nil,
+		},
+	})
+	ingest := httptest.NewRequest(http.MethodPost, "/v1/traces", strings.NewReader(otlpRequest("component-1", "env-1")))
+	ingest.Header.Set("Authorization", "Bearer "+signToken(t, "acme", []string{"component-1"}, []string{"env-1"}))
+	ingest.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, ingest)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("ingest status = %d: %s", rr.Code, rr.Body.String())
+	}
+
+	tests := []struct {
+		name         string
+		orgName      string
+		wantRedacted bool
+	}{
+		{name: "policy of the token's organization", orgName: "acme", wantRedacted: true},
+		{name: "policy of the token's organization without redaction", orgName: "lax", wantRedacted: false},
+		{name: "token without an organization gets the default policy", orgName: "", wantRedacted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The orgName query parameter must not select a laxer policy
+			target := "/api/v1/trace?traceId=5b8efff798038103d269b633813fc60c&componentUid=component-1&environmentUid=env-1&orgName=lax"
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			req.Header.Set("Authorization", "Bearer "+signToken(t, tt.orgName, []string{"component-1"}, []string{"env-1"}))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", rr.Code, rr.Body.String())
+			}
+			if redacted := strings.Contains(rr.Body.String(), "[REDACTED:email]"); redacted != tt.wantRedacted {
+				t.Errorf("redacted = %v, want %v: %s", redacted, tt.wantRedacted, rr.Body.String())
+			}
+			if !tt.wantRedacted && !strings.Contains(rr.Body.String(), "jane@example.com") {
+				t.Errorf("response does not contain the span attribute: %s", rr.Body.String())
+			}
+		})
+	}
+}
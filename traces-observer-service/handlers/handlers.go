@@ -19,25 +19,32 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
-	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
 )
 
 // Handler handles HTTP requests for tracing
 type Handler struct {
 	controllers *controllers.TracingController
+	lookback    time.Duration // Time range searched for a trace or session when none is given
 }
 
 // NewHandler creates a new handler
-func NewHandler(controllers *controllers.TracingController) *Handler {
+func NewHandler(controllers *controllers.TracingController, lookback time.Duration) *Handler {
 	return &Handler{
 		controllers: controllers,
+		lookback:    lookback,
 	}
 }
 
@@ -74,9 +81,9 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 	// Parse query parameters
 	query := r.URL.Query()
 
-	componentUid := query.Get("componentUid")
-	if componentUid == "" {
-		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+	// componentUid may be repeated to query the traces of several components
+	componentUids, ok := h.parseComponentUids(w, query)
+	if !ok {
 		return
 	}
 
@@ -86,6 +93,10 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !h.authorize(w, r, componentUids, environmentUid) {
+		return
+	}
+
 	startTime := query.Get("startTime")
 	endTime := query.Get("endTime")
 
@@ -111,6 +122,13 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 		offset = parsedOffset
 	}
 
+	// Parse cursor for cursor-based pagination, which replaces offset
+	cursor := query.Get("cursor")
+	if cursor != "" && offset > 0 {
+		h.writeError(w, http.StatusBadRequest, "cursor and offset cannot be used together")
+		return
+	}
+
 	// Parse sortOrder (default: desc for traces - newest first)
 	sortOrder := query.Get("sortOrder")
 	if sortOrder == "" {
@@ -121,21 +139,35 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Parse optional trace filters
+	filters, err := parseTraceFilters(query)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	// Build query parameters
-	params := opensearch.TraceQueryParams{
-		ComponentUid:   componentUid,
+	params := traces.TraceQueryParams{
+		ComponentUids:  componentUids,
 		EnvironmentUid: environmentUid,
 		StartTime:      startTime,
 		EndTime:        endTime,
 		Limit:          limit,
 		Offset:         offset,
 		SortOrder:      sortOrder,
+		Cursor:         cursor,
+		Filters:        filters,
+		OrgName:        orgName(r),
 	}
 
 	// Execute query
 	ctx := r.Context()
 	result, err := h.controllers.GetTraceOverviews(ctx, params)
 	if err != nil {
+		if errors.Is(err, controllers.ErrInvalidCursor) {
+			h.writeError(w, http.StatusBadRequest, "cursor is invalid")
+			return
+		}
 		log.Error("Failed to get trace overviews", "error", err)
 		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve trace overviews")
 		return
@@ -159,9 +191,9 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	componentUid := query.Get("componentUid")
-	if componentUid == "" {
-		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+	// componentUid may be repeated to query the traces of several components
+	componentUids, ok := h.parseComponentUids(w, query)
+	if !ok {
 		return
 	}
 
@@ -171,6 +203,10 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if !h.authorize(w, r, componentUids, environmentUid) {
+		return
+	}
+
 	// Parse sortOrder (default: desc)
 	sortOrder := query.Get("sortOrder")
 	if sortOrder == "" {
@@ -192,13 +228,23 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 		limit = parsedLimit
 	}
 
+	// The time range is an optional hint of when the trace ran, the last days are searched by default
+	startTime, endTime, err := h.parseOptionalTimeRange(query)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	// Build query parameters
-	params := opensearch.TraceByIdAndServiceParams{
+	params := traces.TraceByIdAndServiceParams{
 		TraceID:        traceID,
-		ComponentUid:   componentUid,
+		ComponentUids:  componentUids,
 		EnvironmentUid: environmentUid,
+		StartTime:      startTime.UTC().Format(time.RFC3339),
+		EndTime:        endTime.UTC().Format(time.RFC3339),
 		SortOrder:      sortOrder,
 		Limit:          limit,
+		OrgName:        orgName(r),
 	}
 
 	// Execute query
@@ -220,6 +266,416 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 	h.writeJSON(w, http.StatusOK, result)
 }
 
+// GetMetrics handles GET /api/metrics with query parameters
+func (h *Handler) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	if !h.authorize(w, r, []string{componentUid}, environmentUid) {
+		return
+	}
+
+	startTime, err := time.Parse(time.RFC3339, query.Get("startTime"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "startTime is required and must be in RFC3339 format")
+		return
+	}
+
+	endTime, err := time.Parse(time.RFC3339, query.Get("endTime"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "endTime is required and must be in RFC3339 format")
+		return
+	}
+
+	if !startTime.Before(endTime) {
+		h.writeError(w, http.StatusBadRequest, "startTime must be before endTime")
+		return
+	}
+
+	// Parse interval (default: chosen from the time range)
+	interval := traces.DefaultMetricsInterval(startTime, endTime)
+	if intervalStr := query.Get("interval"); intervalStr != "" {
+		parsedInterval, err := time.ParseDuration(intervalStr)
+		if err != nil || parsedInterval < time.Minute || parsedInterval%time.Second != 0 {
+			h.writeError(w, http.StatusBadRequest, "interval must be a duration of at least 1m, such as 5m or 1h")
+			return
+		}
+		interval = parsedInterval
+	}
+	if endTime.Sub(startTime)/interval > traces.MaxMetricsBuckets {
+		h.writeError(w, http.StatusBadRequest,
+			fmt.Sprintf("interval is too small for the time range, at most %d buckets are allowed", traces.MaxMetricsBuckets))
+		return
+	}
+
+	params := traces.MetricsQueryParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Interval:       interval,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetMetrics(ctx, params)
+	if err != nil {
+		log.Error("Failed to get metrics", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve metrics")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetTokenUsage handles GET /api/v1/token-usage
+func (h *Handler) GetTokenUsage(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUids := query["componentUid"]
+	if len(componentUids) == 0 {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+	if len(componentUids) > traces.MaxTokenUsageComponents {
+		h.writeError(w, http.StatusBadRequest,
+			fmt.Sprintf("at most %d componentUid values are allowed", traces.MaxTokenUsageComponents))
+		return
+	}
+
+	if !h.authorize(w, r, componentUids, query.Get("environmentUid")) {
+		return
+	}
+
+	startTime, err := time.Parse(time.RFC3339, query.Get("startTime"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "startTime is required and must be in RFC3339 format")
+		return
+	}
+
+	endTime, err := time.Parse(time.RFC3339, query.Get("endTime"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "endTime is required and must be in RFC3339 format")
+		return
+	}
+
+	if !startTime.Before(endTime) {
+		h.writeError(w, http.StatusBadRequest, "startTime must be before endTime")
+		return
+	}
+	if endTime.Sub(startTime) > traces.MaxTokenUsageDays*24*time.Hour {
+		h.writeError(w, http.StatusBadRequest,
+			fmt.Sprintf("time range must not exceed %d days", traces.MaxTokenUsageDays))
+		return
+	}
+
+	params := traces.TokenUsageQueryParams{
+		ComponentUids:  componentUids,
+		EnvironmentUid: query.Get("environmentUid"),
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetTokenUsage(ctx, params)
+	if err != nil {
+		log.Error("Failed to get token usage", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve token usage")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// ListSessions handles GET /api/v1/sessions
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	if !h.authorize(w, r, []string{componentUid}, environmentUid) {
+		return
+	}
+
+	startTime, err := time.Parse(time.RFC3339, query.Get("startTime"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "startTime is required and must be in RFC3339 format")
+		return
+	}
+
+	endTime, err := time.Parse(time.RFC3339, query.Get("endTime"))
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "endTime is required and must be in RFC3339 format")
+		return
+	}
+
+	if !startTime.Before(endTime) {
+		h.writeError(w, http.StatusBadRequest, "startTime must be before endTime")
+		return
+	}
+
+	// Parse limit (default: 10)
+	limit := 10
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			h.writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	// Parse offset for pagination (default: 0)
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			h.writeError(w, http.StatusBadRequest, "offset must be a non-negative integer")
+			return
+		}
+		offset = parsedOffset
+	}
+
+	params := traces.SessionQueryParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime.UTC().Format(time.RFC3339),
+		EndTime:        endTime.UTC().Format(time.RFC3339),
+		Limit:          limit,
+		Offset:         offset,
+		OrgName:        orgName(r),
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.ListSessions(ctx, params)
+	if err != nil {
+		log.Error("Failed to list sessions", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve sessions")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetSession handles GET /api/v1/sessions/{sessionId}
+func (h *Handler) GetSession(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	sessionID := r.PathValue("sessionId")
+	if sessionID == "" {
+		h.writeError(w, http.StatusBadRequest, "sessionId is required")
+		return
+	}
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	if !h.authorize(w, r, []string{componentUid}, environmentUid) {
+		return
+	}
+
+	// The time range is optional, sessions of the last days are searched by default
+	startTime, endTime, err := h.parseOptionalTimeRange(query)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params := traces.SessionQueryParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime.UTC().Format(time.RFC3339),
+		EndTime:        endTime.UTC().Format(time.RFC3339),
+		SessionID:      sessionID,
+		OrgName:        orgName(r),
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetSession(ctx, params)
+	if err != nil {
+		if errors.Is(err, controllers.ErrSessionNotFound) {
+			h.writeError(w, http.StatusNotFound, "Session not found")
+			return
+		}
+		log.Error("Failed to get session", "sessionId", sessionID, "error", err)
+		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve session")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// authorize checks that the caller may read the traces of the components in the environment,
+// writing a forbidden response when it may not
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, componentUids []string, environmentUid string) bool {
+	if auth.GetScope(r.Context()).Allows(componentUids, environmentUid) {
+		return true
+	}
+	h.writeError(w, http.StatusForbidden, "not authorized to access the traces of the component in the environment")
+	return false
+}
+
+// parseComponentUids parses the componentUid query parameter, which may be repeated.
+// It writes an error response and returns false when it is missing or repeated too often.
+func (h *Handler) parseComponentUids(w http.ResponseWriter, query url.Values) ([]string, bool) {
+	componentUids := query["componentUid"]
+	if len(componentUids) == 0 || slices.Contains(componentUids, "") {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return nil, false
+	}
+	if len(componentUids) > traces.MaxTraceComponents {
+		h.writeError(w, http.StatusBadRequest,
+			fmt.Sprintf("at most %d componentUid values are allowed", traces.MaxTraceComponents))
+		return nil, false
+	}
+	return componentUids, true
+}
+
+// orgName returns the organization whose redaction policy applies to a request, which is the organization
+// of the caller's token. Callers choose no organization themselves, so that they cannot pick a laxer policy;
+// requests whose token names no organization get the default policy.
+func orgName(r *http.Request) string {
+	return auth.GetScope(r.Context()).OrgName
+}
+
+// parseOptionalTimeRange parses the optional startTime and endTime query parameters,
+// which default to the lookback period up to now
+func (h *Handler) parseOptionalTimeRange(query url.Values) (time.Time, time.Time, error) {
+	endTime := time.Now()
+	startTime := endTime.Add(-h.lookback)
+	if query.Get("startTime") == "" && query.Get("endTime") == "" {
+		return startTime, endTime, nil
+	}
+
+	startTime, err := time.Parse(time.RFC3339, query.Get("startTime"))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("startTime must be in RFC3339 format and set together with endTime")
+	}
+	endTime, err = time.Parse(time.RFC3339, query.Get("endTime"))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("endTime must be in RFC3339 format and set together with startTime")
+	}
+	if !startTime.Before(endTime) {
+		return time.Time{}, time.Time{}, fmt.Errorf("startTime must be before endTime")
+	}
+	return startTime, endTime, nil
+}
+
+// parseTraceFilters parses the optional trace filter query parameters
+func parseTraceFilters(query url.Values) (traces.TraceFilters, error) {
+	filters := traces.TraceFilters{
+		Model:    query.Get("model"),
+		ToolName: query.Get("toolName"),
+		Search:   strings.TrimSpace(query.Get("search")),
+	}
+
+	if spanKind := query.Get("spanKind"); spanKind != "" {
+		if !traces.IsValidSpanType(spanKind) {
+			return filters, fmt.Errorf("spanKind '%s' is not a valid span kind", spanKind)
+		}
+		filters.SpanKind = traces.SpanType(spanKind)
+	}
+
+	if errorsOnly := query.Get("errorsOnly"); errorsOnly != "" {
+		parsed, err := strconv.ParseBool(errorsOnly)
+		if err != nil {
+			return filters, fmt.Errorf("errorsOnly must be 'true' or 'false'")
+		}
+		filters.ErrorsOnly = parsed
+	}
+
+	for _, name := range []string{"minDuration", "maxDuration"} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration < 0 {
+			return filters, fmt.Errorf("%s must be a non-negative duration such as 500ms or 10s", name)
+		}
+		if name == "minDuration" {
+			filters.MinDuration = duration
+		} else {
+			filters.MaxDuration = duration
+		}
+	}
+	if filters.MaxDuration > 0 && filters.MinDuration > filters.MaxDuration {
+		return filters, fmt.Errorf("minDuration must not be greater than maxDuration")
+	}
+
+	for _, attribute := range query["attribute"] {
+		key, value, found := strings.Cut(attribute, "=")
+		if !found || key == "" {
+			return filters, fmt.Errorf("attribute must be in the form key=value")
+		}
+		if filters.Attributes == nil {
+			filters.Attributes = make(map[string]string)
+		}
+		filters.Attributes[key] = value
+	}
+
+	for _, traceID := range query["traceId"] {
+		if traceID = strings.TrimSpace(traceID); traceID != "" {
+			filters.TraceIDs = append(filters.TraceIDs, traceID)
+		}
+	}
+
+	return filters, nil
+}
+
 // Health handles GET /health
 func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	// Get logger from context
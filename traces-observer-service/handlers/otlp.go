@@ -0,0 +1,135 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/otlp"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// maxOTLPRequestBytes bounds the size of an OTLP export request, before and after decompression
+const maxOTLPRequestBytes = 16 << 20
+
+// ReceiveTraces handles POST /v1/traces, the OTLP/HTTP trace receiver.
+// Requests may be protobuf or JSON encoded and gzip compressed. Callers with a scoped token may only
+// write the spans of the components and environments of their token.
+func (h *Handler) ReceiveTraces(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.writeError(w, http.StatusMethodNotAllowed, "method must be POST")
+		return
+	}
+
+	mediaType, err := otlp.MediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		h.writeError(w, http.StatusUnsupportedMediaType, "content type must be application/x-protobuf or application/json")
+		return
+	}
+
+	body, status, err := readOTLPBody(w, r)
+	if err != nil {
+		h.writeError(w, status, err.Error())
+		return
+	}
+
+	request, err := otlp.DecodeTraces(body, mediaType)
+	if err != nil {
+		log.Warn("Failed to decode OTLP request", "error", err)
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	spans := otlp.ConvertSpans(request)
+	if !h.authorizeSpans(w, r, spans) {
+		log.Warn("Rejected OTLP request with spans outside the caller's scope")
+		return
+	}
+	if err := h.controllers.WriteSpans(r.Context(), spans); err != nil {
+		log.Error("Failed to write spans", "error", err)
+		// OTLP exporters retry on 503
+		h.writeError(w, http.StatusServiceUnavailable, "failed to write spans")
+		return
+	}
+
+	response, err := otlp.EncodeResponse(mediaType)
+	if err != nil {
+		log.Error("Failed to encode OTLP response", "error", err)
+		h.writeError(w, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(response); err != nil {
+		log.Error("Failed to write OTLP response", "error", err)
+	}
+}
+
+// authorizeSpans checks that the caller may write the spans, by the component and environment
+// resource attributes of each span, writing a forbidden response when it may not
+func (h *Handler) authorizeSpans(w http.ResponseWriter, r *http.Request, spans []traces.Span) bool {
+	scope := auth.GetScope(r.Context())
+	for _, span := range spans {
+		environmentUid, _ := span.Resource[otlp.EnvironmentUidResource].(string)
+		if !scope.Allows([]string{span.Service}, environmentUid) {
+			h.writeError(w, http.StatusForbidden, "not authorized to write the traces of the component in the environment")
+			return false
+		}
+	}
+	return true
+}
+
+// readOTLPBody reads a request body of at most maxOTLPRequestBytes, decompressing it when gzip encoded.
+// On failure it returns the status code to respond with.
+func readOTLPBody(w http.ResponseWriter, r *http.Request) ([]byte, int, error) {
+	var reader io.Reader = http.MaxBytesReader(w, r.Body, maxOTLPRequestBytes)
+	switch r.Header.Get("Content-Encoding") {
+	case "", "identity":
+	case "gzip":
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid gzip body")
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	default:
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("content encoding must be gzip or identity")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(reader, maxOTLPRequestBytes+1))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("request body must not exceed %d bytes", maxOTLPRequestBytes)
+		}
+		return nil, http.StatusBadRequest, fmt.Errorf("failed to read request body")
+	}
+	if len(body) > maxOTLPRequestBytes {
+		return nil, http.StatusRequestEntityTooLarge, fmt.Errorf("request body must not exceed %d bytes", maxOTLPRequestBytes)
+	}
+	return body, 0, nil
+}
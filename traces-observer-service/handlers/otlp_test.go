@@ -0,0 +1,127 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func gzipBody(t *testing.T, body string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(body)); err != nil {
+		t.Fatalf("failed to compress body: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("failed to compress body: %v", err)
+	}
+	return buf.String()
+}
+
+func TestReceiveTracesContentEncoding(t *testing.T) {
+	tests := []struct {
+		name            string
+		contentType     string
+		contentEncoding string
+		body            string
+		wantStatus      int
+		wantStored      bool
+	}{
+		{
+			name:        "uncompressed JSON",
+			contentType: "application/json",
+			body:        otlpRequest("component-1", "env-1"),
+			wantStatus:  http.StatusOK,
+			wantStored:  true,
+		},
+		{
+			name:            "gzip compressed JSON",
+			contentType:     "application/json",
+			contentEncoding: "gzip",
+			body:            gzipBody(t, otlpRequest("component-1", "env-1")),
+			wantStatus:      http.StatusOK,
+			wantStored:      true,
+		},
+		{
+			name:            "identity encoded JSON",
+			contentType:     "application/json; charset=utf-8",
+			contentEncoding: "identity",
+			body:            otlpRequest("component-1", "env-1"),
+			wantStatus:      http.StatusOK,
+			wantStored:      true,
+		},
+		{
+			name:            "gzip encoding of an uncompressed body",
+			contentType:     "application/json",
+			contentEncoding: "gzip",
+			body:            otlpRequest("component-1", "env-1"),
+			wantStatus:      http.StatusBadRequest,
+		},
+		{
+			name:            "unsupported content encoding",
+			contentType:     "application/json",
+			contentEncoding: "br",
+			body:            otlpRequest("component-1", "env-1"),
+			wantStatus:      http.StatusUnsupportedMediaType,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "text/plain",
+			body:        otlpRequest("component-1", "env-1"),
+			wantStatus:  http.StatusUnsupportedMediaType,
+		},
+		{
+			name:        "malformed JSON",
+			contentType: "application/json",
+			body:        `{"resourceSpans":`,
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newScopedHandler(t, nil)
+			token := signToken(t, "", []string{"component-1"}, []string{"env-1"})
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/traces", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", tt.contentType)
+			if tt.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tt.contentEncoding)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+
+			target := "/api/v1/trace?traceId=5b8efff798038103d269b633813fc60c&componentUid=component-1&environmentUid=env-1"
+			get := httptest.NewRequest(http.MethodGet, target, nil)
+			get.Header.Set("Authorization", "Bearer "+token)
+			rr = httptest.NewRecorder()
+			handler.ServeHTTP(rr, get)
+			if stored := strings.Contains(rr.Body.String(), "invoke_agent"); stored != tt.wantStored {
+				t.Errorf("stored = %v, want %v: %s", stored, tt.wantStored, rr.Body.String())
+			}
+		})
+	}
+}
@@ -29,9 +29,13 @@ import (
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/handlers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/memory"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/redaction"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
 )
 
 func setupLogger(cfg *config.Config) {
@@ -61,6 +65,62 @@ func setupLogger(cfg *config.Config) {
 		"level", level.String())
 }
 
+// newTraceStore creates the trace store selected by the configuration
+func newTraceStore(cfg *config.Config) (traces.TraceStore, error) {
+	if cfg.Store.Backend == config.StoreBackendMemory {
+		store := memory.NewStore()
+		if cfg.Store.SeedFile != "" {
+			if err := store.LoadFile(cfg.Store.SeedFile); err != nil {
+				return nil, err
+			}
+		}
+		slog.Info("Using in-memory trace store", "seedFile", cfg.Store.SeedFile)
+		return store, nil
+	}
+
+	indices, err := opensearch.NewIndexPattern(cfg.OpenSearch.IndexPattern, cfg.OpenSearch.IndexGranularity)
+	if err != nil {
+		return nil, err
+	}
+	osClient, err := opensearch.NewClient(&cfg.OpenSearch)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.NewStore(osClient, indices), nil
+}
+
+// newRedactor creates the redactor of the configured policies, which redacts nothing when none are configured
+func newRedactor(cfg *config.RedactionConfig) (*redaction.Redactor, error) {
+	var policies *redaction.Config
+	if cfg.PolicyFile != "" {
+		var err error
+		if policies, err = redaction.LoadConfig(cfg.PolicyFile); err != nil {
+			return nil, err
+		}
+	} else if len(cfg.Detectors) > 0 {
+		policies = &redaction.Config{Default: &redaction.PolicyConfig{Detectors: cfg.Detectors}}
+	}
+	return redaction.NewRedactor(policies)
+}
+
+// newAuthMiddleware creates the middleware that authenticates callers of the query API
+func newAuthMiddleware(cfg *config.AuthConfig) func(http.Handler) http.Handler {
+	switch cfg.Mode {
+	case config.AuthModeAPIKey:
+		return auth.APIKeyMiddleware(cfg.APIKeyHeader, cfg.APIKey)
+	case config.AuthModeJWT:
+		return auth.JWTMiddleware(auth.NewVerifier(auth.VerifierConfig{
+			Secret:    cfg.JWTSecret,
+			Issuer:    cfg.JWTIssuer,
+			Audience:  cfg.JWTAudience,
+			ClockSkew: time.Duration(cfg.ClockSkewSeconds) * time.Second,
+		}))
+	default:
+		slog.Warn("Authentication is disabled, any caller can read the traces of every component")
+		return func(next http.Handler) http.Handler { return next }
+	}
+}
+
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -74,23 +134,40 @@ func main() {
 
 	slog.Info("Starting tracing service", "port", cfg.Server.Port)
 
-	// Initialize OpenSearch client
-	osClient, err := opensearch.NewClient(&cfg.OpenSearch)
+	// Initialize trace store
+	store, err := newTraceStore(cfg)
+	if err != nil {
+		slog.Error("Failed to create trace store", "store", cfg.Store.Backend, "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize redaction policies
+	redactor, err := newRedactor(&cfg.Redaction)
 	if err != nil {
-		slog.Error("Failed to create OpenSearch client", "error", err)
+		slog.Error("Failed to load redaction policies", "error", err)
 		os.Exit(1)
 	}
 
 	// Initialize service
-	tracingController := controllers.NewTracingController(osClient)
+	tracingController := controllers.NewTracingController(store, traces.SessionIDAttributes(cfg.Sessions.IDAttribute), redactor)
 
 	// Initialize handlers
-	handler := handlers.NewHandler(tracingController)
-
-	// Setup routes
+	handler := handlers.NewHandler(tracingController, time.Duration(cfg.Query.LookbackDays)*24*time.Hour)
+
+	// Setup routes, the query API requires authentication
+	api := http.NewServeMux()
+	api.HandleFunc("/api/v1/traces", handler.GetTraceOverviews)
+	api.HandleFunc("/api/v1/trace", handler.GetTraceByIdAndService)
+	api.HandleFunc("/api/v1/metrics", handler.GetMetrics)
+	api.HandleFunc("/api/v1/token-usage", handler.GetTokenUsage)
+	api.HandleFunc("/api/v1/sessions", handler.ListSessions)
+	api.HandleFunc("/api/v1/sessions/{sessionId}", handler.GetSession)
+
+	// The OTLP receiver requires the same authentication as the query API
+	authMiddleware := newAuthMiddleware(&cfg.Auth)
 	mux := http.NewServeMux()
-	mux.HandleFunc("/api/v1/traces", handler.GetTraceOverviews)
-	mux.HandleFunc("/api/v1/trace", handler.GetTraceByIdAndService)
+	mux.Handle("/api/v1/", authMiddleware(api))
+	mux.Handle("/v1/traces", authMiddleware(http.HandlerFunc(handler.ReceiveTraces)))
 	mux.HandleFunc("/health", handler.Health)
 
 	// Apply middleware: Request Logger -> CORS
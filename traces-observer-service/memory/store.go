@@ -0,0 +1,529 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package memory provides a trace store that keeps spans in memory, so the observer
+// can run without an OpenSearch cluster for local development and tests.
+package memory
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+const (
+	componentUidResource   = "openchoreo.dev/component-uid"
+	environmentUidResource = "openchoreo.dev/environment-uid"
+)
+
+// Store is a trace store that keeps spans in memory
+type Store struct {
+	mu    sync.RWMutex
+	spans []traces.Span // Sorted by start time and span ID
+}
+
+var _ traces.TraceStore = (*Store)(nil)
+
+// NewStore creates an empty in-memory trace store
+func NewStore() *Store {
+	return &Store{}
+}
+
+// AddSpans adds spans to the store. The component of a span is taken from its
+// openchoreo.dev/component-uid resource attribute when Service is not set.
+func (s *Store) AddSpans(spans ...traces.Span) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addSpans(spans)
+}
+
+// addSpans adds spans to the store, the caller must hold the write lock
+func (s *Store) addSpans(spans []traces.Span) {
+	for _, span := range spans {
+		if span.Service == "" {
+			span.Service = resourceValue(span, componentUidResource)
+		}
+		if span.DurationInNanos == 0 && !span.StartTime.IsZero() && !span.EndTime.IsZero() {
+			span.DurationInNanos = span.EndTime.Sub(span.StartTime).Nanoseconds()
+		}
+		traces.ClassifySpanEvents(span.Events)
+		traces.PopulateAmpAttributes(&span)
+		s.spans = append(s.spans, span)
+	}
+	sort.SliceStable(s.spans, func(i, j int) bool {
+		return spanBefore(s.spans[i], s.spans[j])
+	})
+}
+
+// LoadFile adds the spans of a JSON file holding an array of spans in the format returned by the trace API
+func (s *Store) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read spans file: %w", err)
+	}
+	var spans []traces.Span
+	if err := json.Unmarshal(data, &spans); err != nil {
+		return fmt.Errorf("failed to parse spans file: %w", err)
+	}
+	s.AddSpans(spans...)
+	return nil
+}
+
+// FindRootSpans returns a page of root spans, see traces.TraceStore
+func (s *Store) FindRootSpans(ctx context.Context, params traces.TraceQueryParams) (*traces.RootSpanPage, error) {
+	start, end, err := parseTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var traceIDs map[string]bool
+	if len(params.TraceIDs) > 0 {
+		traceIDs = make(map[string]bool, len(params.TraceIDs))
+		for _, traceID := range params.TraceIDs {
+			traceIDs[traceID] = true
+		}
+	}
+
+	s.mu.RLock()
+	rootSpans := []traces.Span{}
+	for _, span := range s.spans {
+		if span.ParentSpanID != "" || !inComponents(span, params.ComponentUids, params.EnvironmentUid) ||
+			!inTimeRange(span, start, end) {
+			continue
+		}
+		if traceIDs != nil && !traceIDs[span.TraceID] {
+			continue
+		}
+		if params.Filters.MinDuration > 0 && span.DurationInNanos < params.Filters.MinDuration.Nanoseconds() {
+			continue
+		}
+		if params.Filters.MaxDuration > 0 && span.DurationInNanos > params.Filters.MaxDuration.Nanoseconds() {
+			continue
+		}
+		rootSpans = append(rootSpans, span)
+	}
+	s.mu.RUnlock()
+
+	descending := params.SortOrder != "asc"
+	if descending {
+		reverse(rootSpans)
+	}
+
+	first := max(params.Offset, 0)
+	if params.Cursor != "" {
+		last, err := decodeCursor(params.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", traces.ErrInvalidCursor, err)
+		}
+		first = sort.Search(len(rootSpans), func(i int) bool {
+			if descending {
+				return spanBefore(rootSpans[i], last)
+			}
+			return spanBefore(last, rootSpans[i])
+		})
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = 10
+	}
+
+	// A trace may have several root spans, so traces are counted by their IDs
+	matchedTraces := make(map[string]bool, len(rootSpans))
+	for _, span := range rootSpans {
+		matchedTraces[span.TraceID] = true
+	}
+	page := &traces.RootSpanPage{
+		Spans:      []traces.Span{},
+		TotalCount: len(matchedTraces),
+	}
+	if first < len(rootSpans) {
+		page.Spans = rootSpans[first:min(first+limit, len(rootSpans))]
+	}
+	if first+limit < len(rootSpans) {
+		page.NextCursor, err = encodeCursor(page.Spans[len(page.Spans)-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+// FindTraceIDsBySpanFilters returns the traces that have a span matching the span filters, see traces.TraceStore
+func (s *Store) FindTraceIDsBySpanFilters(ctx context.Context, params traces.TraceQueryParams, limit int) (*traces.SpanFilterMatches, error) {
+	start, end, err := parseTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matches := &traces.SpanFilterMatches{TraceIDs: []string{}}
+	seen := make(map[string]bool)
+	for _, span := range s.spans {
+		if seen[span.TraceID] || !inComponents(span, params.ComponentUids, params.EnvironmentUid) ||
+			!inTimeRange(span, start, end) {
+			continue
+		}
+		if !params.Filters.MatchesSpanAttributes(span) || !params.Filters.MatchesSpan(span) {
+			continue
+		}
+		if len(matches.TraceIDs) == limit {
+			matches.Truncated = true
+			break
+		}
+		seen[span.TraceID] = true
+		matches.TraceIDs = append(matches.TraceIDs, span.TraceID)
+	}
+	return matches, nil
+}
+
+// GetTraceSpans returns the spans of the given traces grouped by trace ID, see traces.TraceStore
+func (s *Store) GetTraceSpans(ctx context.Context, traceIDs []string, params traces.TraceQueryParams) (*traces.TraceSpans, error) {
+	traceSpans := &traces.TraceSpans{Spans: make(map[string][]traces.Span, len(traceIDs))}
+	if len(traceIDs) == 0 {
+		return traceSpans, nil
+	}
+
+	wanted := make(map[string]bool, len(traceIDs))
+	for _, traceID := range traceIDs {
+		wanted[traceID] = true
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, span := range s.spans {
+		if wanted[span.TraceID] && inComponents(span, params.ComponentUids, params.EnvironmentUid) {
+			traceSpans.Spans[span.TraceID] = append(traceSpans.Spans[span.TraceID], span)
+		}
+	}
+	return traceSpans, nil
+}
+
+// GetTrace returns the spans of a trace, see traces.TraceStore
+func (s *Store) GetTrace(ctx context.Context, params traces.TraceByIdAndServiceParams) ([]traces.Span, error) {
+	s.mu.RLock()
+	spans := []traces.Span{}
+	for _, span := range s.spans {
+		if span.TraceID == params.TraceID && inComponents(span, params.ComponentUids, params.EnvironmentUid) {
+			spans = append(spans, span)
+		}
+	}
+	s.mu.RUnlock()
+
+	if params.SortOrder == "desc" {
+		reverse(spans)
+	}
+	if params.Limit > 0 && len(spans) > params.Limit {
+		spans = spans[:params.Limit]
+	}
+	return spans, nil
+}
+
+// FindSessionSpans returns the spans that carry a session ID, see traces.TraceStore
+func (s *Store) FindSessionSpans(ctx context.Context, params traces.SessionQueryParams, sessionAttributes []string) (*traces.SessionSpans, error) {
+	start, end, err := parseTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	spans := []traces.Span{}
+	for _, span := range s.spans {
+		if !inScope(span, params.ComponentUid, params.EnvironmentUid) || !inTimeRange(span, start, end) {
+			continue
+		}
+		if !hasSession(span, sessionAttributes, params.SessionID) {
+			continue
+		}
+		spans = append(spans, span)
+	}
+	return &traces.SessionSpans{Spans: spans}, nil
+}
+
+// GetMetrics computes the metrics of a component over fixed time buckets aligned to the Unix epoch
+func (s *Store) GetMetrics(ctx context.Context, params traces.MetricsQueryParams) (*traces.MetricsResponse, error) {
+	if params.Interval <= 0 {
+		return nil, fmt.Errorf("invalid metrics interval: %s", params.Interval)
+	}
+
+	firstBucket := params.StartTime.UTC().Truncate(params.Interval)
+	bucketCount := int(params.EndTime.UTC().Truncate(params.Interval).Sub(firstBucket)/params.Interval) + 1
+	buckets := make([]*metricsAccumulator, bucketCount)
+	for i := range buckets {
+		buckets[i] = newMetricsAccumulator()
+	}
+	summary := newMetricsAccumulator()
+
+	s.mu.RLock()
+	for _, span := range s.spans {
+		if !inScope(span, params.ComponentUid, params.EnvironmentUid) ||
+			!inTimeRange(span, params.StartTime, params.EndTime) {
+			continue
+		}
+		summary.add(span)
+		buckets[int(span.StartTime.UTC().Truncate(params.Interval).Sub(firstBucket)/params.Interval)].add(span)
+	}
+	s.mu.RUnlock()
+
+	metrics := &traces.MetricsResponse{
+		Interval: traces.FormatMetricsInterval(params.Interval),
+		Summary:  summary.bucket(),
+		Buckets:  make([]traces.MetricsBucket, 0, len(buckets)),
+	}
+	for i, accumulator := range buckets {
+		bucket := accumulator.bucket()
+		bucket.Timestamp = firstBucket.Add(time.Duration(i) * params.Interval).Format(time.RFC3339)
+		metrics.Buckets = append(metrics.Buckets, bucket)
+	}
+	return metrics, nil
+}
+
+// GetTokenUsage computes the daily token usage of components per vendor and model
+func (s *Store) GetTokenUsage(ctx context.Context, params traces.TokenUsageQueryParams) (*traces.TokenUsageResponse, error) {
+	components := make(map[string]bool, len(params.ComponentUids))
+	for _, componentUid := range params.ComponentUids {
+		components[componentUid] = true
+	}
+
+	type dayKey struct {
+		componentUid string
+		date         string
+	}
+	spansByDay := make(map[dayKey][]traces.Span)
+
+	s.mu.RLock()
+	for _, span := range s.spans {
+		if !components[span.Service] || !inScope(span, "", params.EnvironmentUid) ||
+			!inTimeRange(span, params.StartTime, params.EndTime) {
+			continue
+		}
+		key := dayKey{componentUid: span.Service, date: span.StartTime.UTC().Format(time.DateOnly)}
+		spansByDay[key] = append(spansByDay[key], span)
+	}
+	s.mu.RUnlock()
+
+	usage := []traces.DailyTokenUsage{}
+	for key, spans := range spansByDay {
+		for _, modelUsage := range traces.ExtractModelTokenUsage(spans) {
+			if modelUsage.TotalTokens == 0 {
+				continue
+			}
+			usage = append(usage, traces.DailyTokenUsage{
+				ComponentUid:    key.componentUid,
+				Date:            key.date,
+				ModelTokenUsage: modelUsage,
+			})
+		}
+	}
+	traces.SortDailyTokenUsage(usage)
+
+	return &traces.TokenUsageResponse{Usage: usage}, nil
+}
+
+// WriteSpans adds spans to the store, see AddSpans. Spans that are already stored, by their
+// trace and span IDs, are skipped so that retried writes do not duplicate them.
+func (s *Store) WriteSpans(ctx context.Context, spans []traces.Span) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := make(map[string]bool, len(s.spans))
+	for _, span := range s.spans {
+		stored[span.TraceID+span.SpanID] = true
+	}
+
+	newSpans := make([]traces.Span, 0, len(spans))
+	for _, span := range spans {
+		if !stored[span.TraceID+span.SpanID] {
+			stored[span.TraceID+span.SpanID] = true
+			newSpans = append(newSpans, span)
+		}
+	}
+	s.addSpans(newSpans)
+	return nil
+}
+
+// HealthCheck always succeeds for the in-memory store
+func (s *Store) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+// metricsAccumulator collects the spans of a metrics bucket
+type metricsAccumulator struct {
+	latencies   []int64
+	errorTraces map[string]bool
+	spans       []traces.Span
+}
+
+func newMetricsAccumulator() *metricsAccumulator {
+	return &metricsAccumulator{errorTraces: make(map[string]bool)}
+}
+
+func (a *metricsAccumulator) add(span traces.Span) {
+	if span.ParentSpanID == "" {
+		a.latencies = append(a.latencies, span.DurationInNanos)
+	}
+	if traces.SpanHasError(span) {
+		a.errorTraces[span.TraceID] = true
+	}
+	a.spans = append(a.spans, span)
+}
+
+func (a *metricsAccumulator) bucket() traces.MetricsBucket {
+	bucket := traces.MetricsBucket{
+		RequestCount: len(a.latencies),
+		ErrorCount:   len(a.errorTraces),
+	}
+	if tokenUsage := traces.ExtractTokenUsage(a.spans); tokenUsage != nil {
+		bucket.TokenUsage = *tokenUsage
+	}
+
+	if bucket.RequestCount > 0 {
+		// Error spans of a trace may fall in the bucket while its root span does not
+		bucket.ErrorRate = math.Min(float64(bucket.ErrorCount)/float64(bucket.RequestCount), 1)
+		sort.Slice(a.latencies, func(i, j int) bool { return a.latencies[i] < a.latencies[j] })
+		bucket.Latency = &traces.LatencyPercentiles{
+			P50InNanos: percentile(a.latencies, 50),
+			P95InNanos: percentile(a.latencies, 95),
+			P99InNanos: percentile(a.latencies, 99),
+		}
+	}
+
+	return bucket
+}
+
+// percentile interpolates a percentile of sorted values
+func percentile(sorted []int64, percent float64) int64 {
+	rank := percent / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	value := float64(sorted[lower]) + (rank-float64(lower))*float64(sorted[upper]-sorted[lower])
+	return int64(math.Round(value))
+}
+
+// spanBefore orders spans by start time with the span ID as a tie breaker
+func spanBefore(a, b traces.Span) bool {
+	if !a.StartTime.Equal(b.StartTime) {
+		return a.StartTime.Before(b.StartTime)
+	}
+	return a.SpanID < b.SpanID
+}
+
+func reverse(spans []traces.Span) {
+	for i, j := 0, len(spans)-1; i < j; i, j = i+1, j-1 {
+		spans[i], spans[j] = spans[j], spans[i]
+	}
+}
+
+func resourceValue(span traces.Span, key string) string {
+	value, _ := span.Resource[key].(string)
+	return value
+}
+
+// inScope checks whether a span belongs to the component and environment, either of which may be empty
+func inScope(span traces.Span, componentUid string, environmentUid string) bool {
+	if componentUid != "" && span.Service != componentUid {
+		return false
+	}
+	return environmentUid == "" || resourceValue(span, environmentUidResource) == environmentUid
+}
+
+// inComponents checks whether a span belongs to any of the components, or to any component when
+// none are given, and to the environment, which may be empty
+func inComponents(span traces.Span, componentUids []string, environmentUid string) bool {
+	if len(componentUids) > 0 && !slices.Contains(componentUids, span.Service) {
+		return false
+	}
+	return inScope(span, "", environmentUid)
+}
+
+// inTimeRange checks whether a span started within the range; a zero range matches all spans
+func inTimeRange(span traces.Span, start time.Time, end time.Time) bool {
+	if start.IsZero() || end.IsZero() {
+		return true
+	}
+	return !span.StartTime.Before(start) && !span.StartTime.After(end)
+}
+
+// parseTimeRange parses an RFC3339 time range, which is only applied when both times are set
+func parseTimeRange(startTime string, endTime string) (time.Time, time.Time, error) {
+	if startTime == "" || endTime == "" {
+		return time.Time{}, time.Time{}, nil
+	}
+	start, err := time.Parse(time.RFC3339Nano, startTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start time format: %w", err)
+	}
+	end, err := time.Parse(time.RFC3339Nano, endTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end time format: %w", err)
+	}
+	return start, end, nil
+}
+
+// hasSession checks whether a span carries one of the session attributes, with the given value when it is set
+func hasSession(span traces.Span, sessionAttributes []string, sessionID string) bool {
+	for _, attribute := range sessionAttributes {
+		value, ok := span.Attributes[attribute]
+		if !ok {
+			continue
+		}
+		if sessionID == "" || value == sessionID {
+			return true
+		}
+	}
+	return false
+}
+
+// cursorKey is the position of the last root span of a page
+type cursorKey struct {
+	StartTime time.Time `json:"startTime"`
+	SpanID    string    `json:"spanId"`
+}
+
+func encodeCursor(span traces.Span) (string, error) {
+	data, err := json.Marshal(cursorKey{StartTime: span.StartTime, SpanID: span.SpanID})
+	if err != nil {
+		return "", fmt.Errorf("failed to create next cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(cursor string) (traces.Span, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return traces.Span{}, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+	var key cursorKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return traces.Span{}, fmt.Errorf("failed to parse cursor: %w", err)
+	}
+	return traces.Span{StartTime: key.StartTime, SpanID: key.SpanID}, nil
+}
@@ -0,0 +1,512 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/otlp"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+var baseTime = time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+// testSpan returns a span of the component in the environment that starts the given number of minutes after baseTime
+func testSpan(traceID, spanID, parentSpanID, componentUid, environmentUid string, minute int, attributes map[string]interface{}) traces.Span {
+	start := baseTime.Add(time.Duration(minute) * time.Minute)
+	return traces.Span{
+		TraceID:      traceID,
+		SpanID:       spanID,
+		ParentSpanID: parentSpanID,
+		Name:         spanID,
+		Service:      componentUid,
+		StartTime:    start,
+		EndTime:      start.Add(time.Duration(minute+1) * time.Second),
+		Attributes:   attributes,
+		Resource:     map[string]interface{}{environmentUidResource: environmentUid},
+	}
+}
+
+func spanIDs(spans []traces.Span) []string {
+	ids := []string{}
+	for _, span := range spans {
+		ids = append(ids, span.SpanID)
+	}
+	return ids
+}
+
+func TestFindRootSpansPaging(t *testing.T) {
+	store := NewStore()
+	for i, id := range []string{"root-1", "root-2", "root-3", "root-4", "root-5"} {
+		store.AddSpans(testSpan("trace-"+id, id, "", "component-1", "env-1", i, nil))
+	}
+	store.AddSpans(
+		testSpan("trace-root-1", "child-1", "root-1", "component-1", "env-1", 1, nil),
+		testSpan("trace-other", "other-component", "", "component-2", "env-1", 2, nil),
+		testSpan("trace-other-env", "other-env", "", "component-1", "env-2", 2, nil),
+	)
+	params := traces.TraceQueryParams{ComponentUids: []string{"component-1"}, EnvironmentUid: "env-1", Limit: 2}
+
+	tests := []struct {
+		name      string
+		sortOrder string
+		expected  []string
+	}{
+		{name: "descending", sortOrder: "desc", expected: []string{"root-5", "root-4", "root-3", "root-2", "root-1"}},
+		{name: "ascending", sortOrder: "asc", expected: []string{"root-1", "root-2", "root-3", "root-4", "root-5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := params
+			params.SortOrder = tt.sortOrder
+			var got []string
+			for pages := 0; ; pages++ {
+				if pages == 5 {
+					t.Fatal("cursor does not advance")
+				}
+				page, err := store.FindRootSpans(context.Background(), params)
+				if err != nil {
+					t.Fatalf("FindRootSpans() error = %v", err)
+				}
+				if page.TotalCount != 5 {
+					t.Errorf("TotalCount = %d, want 5", page.TotalCount)
+				}
+				got = append(got, spanIDs(page.Spans)...)
+				if page.NextCursor == "" {
+					break
+				}
+				params.Cursor = page.NextCursor
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("root spans = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	t.Run("offset", func(t *testing.T) {
+		params := params
+		params.Offset = 3
+		page, err := store.FindRootSpans(context.Background(), params)
+		if err != nil {
+			t.Fatalf("FindRootSpans() error = %v", err)
+		}
+		if expected := []string{"root-2", "root-1"}; !reflect.DeepEqual(spanIDs(page.Spans), expected) {
+			t.Errorf("root spans = %v, want %v", spanIDs(page.Spans), expected)
+		}
+		if page.NextCursor != "" {
+			t.Errorf("NextCursor = %q on the last page", page.NextCursor)
+		}
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		params := params
+		params.StartTime = baseTime.Add(time.Minute).Format(time.RFC3339)
+		params.EndTime = baseTime.Add(3 * time.Minute).Format(time.RFC3339)
+		params.Limit = 10
+		page, err := store.FindRootSpans(context.Background(), params)
+		if err != nil {
+			t.Fatalf("FindRootSpans() error = %v", err)
+		}
+		if expected := []string{"root-4", "root-3", "root-2"}; !reflect.DeepEqual(spanIDs(page.Spans), expected) {
+			t.Errorf("root spans = %v, want %v", spanIDs(page.Spans), expected)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		params := params
+		params.Cursor = "not-a-cursor"
+		if _, err := store.FindRootSpans(context.Background(), params); !errors.Is(err, traces.ErrInvalidCursor) {
+			t.Errorf("FindRootSpans() error = %v, want %v", err, traces.ErrInvalidCursor)
+		}
+	})
+}
+
+func TestFindRootSpansFilters(t *testing.T) {
+	store := NewStore()
+	store.AddSpans(
+		testSpan("trace-1", "root-1", "", "component-1", "env-1", 0, nil), // 1s
+		testSpan("trace-2", "root-2", "", "component-1", "env-1", 4, nil), // 5s
+		testSpan("trace-3", "root-3", "", "component-1", "env-1", 9, nil), // 10s
+	)
+
+	tests := []struct {
+		name     string
+		params   traces.TraceQueryParams
+		expected []string
+	}{
+		{
+			name:     "minimum duration",
+			params:   traces.TraceQueryParams{Filters: traces.TraceFilters{MinDuration: 5 * time.Second}},
+			expected: []string{"root-3", "root-2"},
+		},
+		{
+			name:     "maximum duration",
+			params:   traces.TraceQueryParams{Filters: traces.TraceFilters{MaxDuration: 5 * time.Second}},
+			expected: []string{"root-2", "root-1"},
+		},
+		{
+			name:     "trace IDs",
+			params:   traces.TraceQueryParams{TraceIDs: []string{"trace-1", "trace-3"}},
+			expected: []string{"root-3", "root-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.ComponentUids = []string{"component-1"}
+			page, err := store.FindRootSpans(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("FindRootSpans() error = %v", err)
+			}
+			if !reflect.DeepEqual(spanIDs(page.Spans), tt.expected) {
+				t.Errorf("root spans = %v, want %v", spanIDs(page.Spans), tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindRootSpansCountsTraces(t *testing.T) {
+	store := NewStore()
+	store.AddSpans(
+		testSpan("trace-1", "root-1", "", "component-1", "env-1", 0, nil),
+		testSpan("trace-1", "root-1b", "", "component-1", "env-1", 1, nil),
+		testSpan("trace-2", "root-2", "", "component-1", "env-1", 2, nil),
+	)
+	page, err := store.FindRootSpans(context.Background(), traces.TraceQueryParams{ComponentUids: []string{"component-1"}})
+	if err != nil {
+		t.Fatalf("FindRootSpans() error = %v", err)
+	}
+	// A trace with several root spans is counted once
+	if page.TotalCount != 2 {
+		t.Errorf("TotalCount = %d, want 2", page.TotalCount)
+	}
+}
+
+func TestFindTraceIDsBySpanFilters(t *testing.T) {
+	store := NewStore()
+	failed := testSpan("trace-2", "tool-2", "root-2", "component-1", "env-1", 3, map[string]interface{}{"tool.name": "search"})
+	failed.Status = "2"
+	store.AddSpans(
+		testSpan("trace-1", "root-1", "", "component-1", "env-1", 0, map[string]interface{}{"user.id": "alice"}),
+		testSpan("trace-1", "tool-1", "root-1", "component-1", "env-1", 1, map[string]interface{}{"tool.name": "search"}),
+		testSpan("trace-2", "root-2", "", "component-1", "env-1", 2, map[string]interface{}{"user.id": "bob"}),
+		failed,
+		testSpan("trace-3", "root-3", "", "component-2", "env-1", 4, map[string]interface{}{"user.id": "alice"}),
+	)
+
+	tests := []struct {
+		name          string
+		filters       traces.TraceFilters
+		limit         int
+		expected      []string
+		wantTruncated bool
+	}{
+		{name: "attribute", filters: traces.TraceFilters{Attributes: map[string]string{"user.id": "alice"}}, limit: 10, expected: []string{"trace-1"}},
+		{name: "errors only", filters: traces.TraceFilters{ErrorsOnly: true}, limit: 10, expected: []string{"trace-2"}},
+		{name: "one trace per matching span", filters: traces.TraceFilters{Attributes: map[string]string{"tool.name": "search"}}, limit: 10, expected: []string{"trace-1", "trace-2"}},
+		{name: "limit", filters: traces.TraceFilters{Attributes: map[string]string{"tool.name": "search"}}, limit: 1, expected: []string{"trace-1"}, wantTruncated: true},
+		{name: "exactly the limit", filters: traces.TraceFilters{Attributes: map[string]string{"tool.name": "search"}}, limit: 2, expected: []string{"trace-1", "trace-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := traces.TraceQueryParams{ComponentUids: []string{"component-1"}, EnvironmentUid: "env-1", Filters: tt.filters}
+			matches, err := store.FindTraceIDsBySpanFilters(context.Background(), params, tt.limit)
+			if err != nil {
+				t.Fatalf("FindTraceIDsBySpanFilters() error = %v", err)
+			}
+			if !reflect.DeepEqual(matches.TraceIDs, tt.expected) {
+				t.Errorf("trace IDs = %v, want %v", matches.TraceIDs, tt.expected)
+			}
+			if matches.Truncated != tt.wantTruncated {
+				t.Errorf("truncated = %v, want %v", matches.Truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestGetTrace(t *testing.T) {
+	store := NewStore()
+	store.AddSpans(
+		testSpan("trace-1", "child-2", "root", "component-2", "env-1", 2, nil),
+		testSpan("trace-1", "root", "", "component-1", "env-1", 0, nil),
+		testSpan("trace-1", "child-1", "root", "component-1", "env-1", 1, nil),
+		testSpan("trace-2", "other", "", "component-1", "env-1", 0, nil),
+	)
+
+	tests := []struct {
+		name     string
+		params   traces.TraceByIdAndServiceParams
+		expected []string
+	}{
+		{
+			name:     "spans of all components in ascending order",
+			params:   traces.TraceByIdAndServiceParams{TraceID: "trace-1", SortOrder: "asc"},
+			expected: []string{"root", "child-1", "child-2"},
+		},
+		{
+			name:     "spans of a component in descending order",
+			params:   traces.TraceByIdAndServiceParams{TraceID: "trace-1", ComponentUids: []string{"component-1"}, SortOrder: "desc"},
+			expected: []string{"child-1", "root"},
+		},
+		{
+			name:     "limit",
+			params:   traces.TraceByIdAndServiceParams{TraceID: "trace-1", SortOrder: "asc", Limit: 2},
+			expected: []string{"root", "child-1"},
+		},
+		{
+			name:     "other environment",
+			params:   traces.TraceByIdAndServiceParams{TraceID: "trace-1", EnvironmentUid: "env-2"},
+			expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans, err := store.GetTrace(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("GetTrace() error = %v", err)
+			}
+			if !reflect.DeepEqual(spanIDs(spans), tt.expected) {
+				t.Errorf("spans = %v, want %v", spanIDs(spans), tt.expected)
+			}
+		})
+	}
+
+	traceSpans, err := store.GetTraceSpans(context.Background(), []string{"trace-1", "trace-2"},
+		traces.TraceQueryParams{ComponentUids: []string{"component-1"}})
+	if err != nil {
+		t.Fatalf("GetTraceSpans() error = %v", err)
+	}
+	if traceSpans.Truncated {
+		t.Error("GetTraceSpans() truncated = true, want false")
+	}
+	expected := map[string][]string{"trace-1": {"root", "child-1"}, "trace-2": {"other"}}
+	for traceID, ids := range expected {
+		if !reflect.DeepEqual(spanIDs(traceSpans.Spans[traceID]), ids) {
+			t.Errorf("spans of %s = %v, want %v", traceID, spanIDs(traceSpans.Spans[traceID]), ids)
+		}
+	}
+}
+
+func TestFindSessionSpans(t *testing.T) {
+	sessionAttributes := []string{"session.id", "gen_ai.conversation.id"}
+	store := NewStore()
+	store.AddSpans(
+		testSpan("trace-1", "span-1", "", "component-1", "env-1", 0, map[string]interface{}{"session.id": "session-a"}),
+		testSpan("trace-2", "span-2", "", "component-1", "env-1", 1, map[string]interface{}{"gen_ai.conversation.id": "session-b"}),
+		testSpan("trace-3", "span-3", "", "component-1", "env-1", 2, map[string]interface{}{"user.id": "alice"}),
+		testSpan("trace-4", "span-4", "", "component-2", "env-1", 3, map[string]interface{}{"session.id": "session-a"}),
+	)
+
+	tests := []struct {
+		name      string
+		sessionID string
+		expected  []string
+	}{
+		{name: "all sessions", expected: []string{"span-1", "span-2"}},
+		{name: "one session", sessionID: "session-b", expected: []string{"span-2"}},
+		{name: "unknown session", sessionID: "session-c", expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := traces.SessionQueryParams{ComponentUid: "component-1", EnvironmentUid: "env-1", SessionID: tt.sessionID}
+			found, err := store.FindSessionSpans(context.Background(), params, sessionAttributes)
+			if err != nil {
+				t.Fatalf("FindSessionSpans() error = %v", err)
+			}
+			if !reflect.DeepEqual(spanIDs(found.Spans), tt.expected) {
+				t.Errorf("spans = %v, want %v", spanIDs(found.Spans), tt.expected)
+			}
+			if found.Truncated {
+				t.Errorf("Truncated = true, want false")
+			}
+		})
+	}
+}
+
+func TestGetMetrics(t *testing.T) {
+	llmAttributes := map[string]interface{}{
+		"gen_ai.request.model":       "gpt-4o",
+		"gen_ai.usage.input_tokens":  float64(100),
+		"gen_ai.usage.output_tokens": float64(20),
+	}
+	failed := testSpan("trace-2", "llm-2", "root-2", "component-1", "env-1", 61, llmAttributes)
+	failed.Status = "2"
+	store := NewStore()
+	store.AddSpans(
+		testSpan("trace-1", "root-1", "", "component-1", "env-1", 0, nil),                // 1s
+		testSpan("trace-1", "llm-1", "root-1", "component-1", "env-1", 0, llmAttributes), // first hour
+		testSpan("trace-2", "root-2", "", "component-1", "env-1", 60, nil),               // 61s
+		failed,
+		testSpan("trace-3", "root-3", "", "component-1", "env-1", 62, nil), // 63s
+		testSpan("trace-4", "root-4", "", "component-2", "env-1", 0, nil),
+	)
+
+	metrics, err := store.GetMetrics(context.Background(), traces.MetricsQueryParams{
+		ComponentUid:   "component-1",
+		EnvironmentUid: "env-1",
+		StartTime:      baseTime,
+		EndTime:        baseTime.Add(2*time.Hour - time.Second),
+		Interval:       time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("GetMetrics() error = %v", err)
+	}
+
+	if metrics.Interval != "1h" {
+		t.Errorf("Interval = %q, want 1h", metrics.Interval)
+	}
+	expectedSummary := traces.MetricsBucket{
+		RequestCount: 3,
+		ErrorCount:   1,
+		ErrorRate:    1.0 / 3,
+		Latency:      &traces.LatencyPercentiles{P50InNanos: 61e9, P95InNanos: 62.8e9, P99InNanos: 62.96e9},
+		TokenUsage:   traces.TokenUsage{InputTokens: 200, OutputTokens: 40, TotalTokens: 240},
+	}
+	if !reflect.DeepEqual(metrics.Summary, expectedSummary) {
+		t.Errorf("Summary = %+v, want %+v", metrics.Summary, expectedSummary)
+	}
+
+	if len(metrics.Buckets) != 2 {
+		t.Fatalf("got %d buckets, want 2", len(metrics.Buckets))
+	}
+	tests := []struct {
+		timestamp    string
+		requestCount int
+		errorCount   int
+		totalTokens  int
+	}{
+		{timestamp: "2025-06-01T10:00:00Z", requestCount: 1, errorCount: 0, totalTokens: 120},
+		{timestamp: "2025-06-01T11:00:00Z", requestCount: 2, errorCount: 1, totalTokens: 120},
+	}
+	for i, tt := range tests {
+		bucket := metrics.Buckets[i]
+		if bucket.Timestamp != tt.timestamp || bucket.RequestCount != tt.requestCount ||
+			bucket.ErrorCount != tt.errorCount || bucket.TokenUsage.TotalTokens != tt.totalTokens {
+			t.Errorf("bucket %d = %+v, want %+v", i, bucket, tt)
+		}
+	}
+
+	if _, err := store.GetMetrics(context.Background(), traces.MetricsQueryParams{StartTime: baseTime, EndTime: baseTime}); err == nil {
+		t.Error("GetMetrics() without an interval should fail")
+	}
+}
+
+func TestGetTokenUsage(t *testing.T) {
+	usage := func(model string, input, output float64) map[string]interface{} {
+		return map[string]interface{}{
+			"gen_ai.system":              "openai",
+			"gen_ai.request.model":       model,
+			"gen_ai.usage.input_tokens":  input,
+			"gen_ai.usage.output_tokens": output,
+		}
+	}
+	store := NewStore()
+	store.AddSpans(
+		testSpan("trace-1", "llm-1", "root-1", "component-1", "env-1", 0, usage("gpt-4o", 100, 10)),
+		testSpan("trace-1", "llm-2", "root-1", "component-1", "env-1", 1, usage("gpt-4o", 50, 5)),
+		testSpan("trace-2", "llm-3", "root-2", "component-1", "env-1", 24*60, usage("gpt-4o-mini", 30, 3)),
+		testSpan("trace-3", "llm-4", "root-3", "component-1", "env-2", 0, usage("gpt-4o", 1000, 100)),
+		testSpan("trace-4", "llm-5", "root-4", "component-2", "env-1", 0, usage("gpt-4o", 1000, 100)),
+	)
+
+	response, err := store.GetTokenUsage(context.Background(), traces.TokenUsageQueryParams{
+		ComponentUids:  []string{"component-1"},
+		EnvironmentUid: "env-1",
+		StartTime:      baseTime,
+		EndTime:        baseTime.Add(48 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("GetTokenUsage() error = %v", err)
+	}
+
+	expected := []traces.DailyTokenUsage{
+		{ComponentUid: "component-1", Date: "2025-06-01", ModelTokenUsage: traces.ModelTokenUsage{
+			Vendor: "openai", Model: "gpt-4o", InputTokens: 150, OutputTokens: 15, TotalTokens: 165,
+		}},
+		{ComponentUid: "component-1", Date: "2025-06-02", ModelTokenUsage: traces.ModelTokenUsage{
+			Vendor: "openai", Model: "gpt-4o-mini", InputTokens: 30, OutputTokens: 3, TotalTokens: 33,
+		}},
+	}
+	if !reflect.DeepEqual(response.Usage, expected) {
+		t.Errorf("usage = %+v, want %+v", response.Usage, expected)
+	}
+}
+
+func TestOTLPRoundTrip(t *testing.T) {
+	body := `{"resourceSpans":[{"resource":{"attributes":[` +
+		`{"key":"openchoreo.dev/component-uid","value":{"stringValue":"component-1"}},` +
+		`{"key":"openchoreo.dev/environment-uid","value":{"stringValue":"env-1"}}]},` +
+		`"scopeSpans":[{"spans":[` +
+		`{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174","name":"invoke_agent",` +
+		`"startTimeUnixNano":"1748772000000000000","endTimeUnixNano":"1748772002000000000",` +
+		`"attributes":[{"key":"gen_ai.agent.name","value":{"stringValue":"planner"}}]},` +
+		`{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b175","parentSpanId":"eee19b7ec3c1b174",` +
+		`"name":"chat","startTimeUnixNano":"1748772000500000000","endTimeUnixNano":"1748772001000000000",` +
+		`"status":{"code":2,"message":"rate limited"},` +
+		`"attributes":[{"key":"gen_ai.usage.input_tokens","value":{"intValue":"42"}}]}]}]}]}`
+	request, err := otlp.DecodeTraces([]byte(body), otlp.ContentTypeJSON)
+	if err != nil {
+		t.Fatalf("DecodeTraces() error = %v", err)
+	}
+	store := NewStore()
+	// An exporter retrying the request must not duplicate the spans
+	for i := 0; i < 2; i++ {
+		if err := store.WriteSpans(context.Background(), otlp.ConvertSpans(request)); err != nil {
+			t.Fatalf("WriteSpans() error = %v", err)
+		}
+	}
+
+	page, err := store.FindRootSpans(context.Background(), traces.TraceQueryParams{
+		ComponentUids: []string{"component-1"}, EnvironmentUid: "env-1",
+	})
+	if err != nil {
+		t.Fatalf("FindRootSpans() error = %v", err)
+	}
+	if page.TotalCount != 1 || page.Spans[0].SpanID != "eee19b7ec3c1b174" {
+		t.Fatalf("root spans = %v, want the invoke_agent span", spanIDs(page.Spans))
+	}
+	root := page.Spans[0]
+	if root.TraceID != "5b8efff798038103d269b633813fc60c" || root.DurationInNanos != int64(2*time.Second) {
+		t.Errorf("root span = %+v", root)
+	}
+	if !root.StartTime.Equal(time.Unix(1748772000, 0)) {
+		t.Errorf("root span starts at %v", root.StartTime)
+	}
+
+	spans, err := store.GetTrace(context.Background(), traces.TraceByIdAndServiceParams{
+		TraceID: root.TraceID, ComponentUids: []string{"component-1"}, EnvironmentUid: "env-1", SortOrder: "asc",
+	})
+	if err != nil {
+		t.Fatalf("GetTrace() error = %v", err)
+	}
+	if expected := []string{"eee19b7ec3c1b174", "eee19b7ec3c1b175"}; !reflect.DeepEqual(spanIDs(spans), expected) {
+		t.Fatalf("spans = %v, want %v", spanIDs(spans), expected)
+	}
+	child := spans[1]
+	if child.ParentSpanID != root.SpanID || child.Service != "component-1" {
+		t.Errorf("child span = %+v", child)
+	}
+	if child.Attributes["gen_ai.usage.input_tokens"] != float64(42) {
+		t.Errorf("input tokens attribute = %#v, want 42", child.Attributes["gen_ai.usage.input_tokens"])
+	}
+	if child.AmpAttributes == nil || child.AmpAttributes.Status == nil || !child.AmpAttributes.Status.Error {
+		t.Errorf("child span status = %+v, want an error", child.AmpAttributes)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+)
+
+// APIKeyMiddleware validates the shared API key in the request header. Callers holding the key
+// are trusted services that resolve the scope of their own users, so they get the full scope.
+func APIKeyMiddleware(header string, apiKey string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(header)
+			if key == "" || subtle.ConstantTimeCompare([]byte(key), []byte(apiKey)) != 1 {
+				writeError(w, http.StatusUnauthorized, "unauthorized: invalid API key")
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithScope(r.Context(), FullScope)))
+		})
+	}
+}
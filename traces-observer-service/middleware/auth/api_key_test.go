@@ -0,0 +1,77 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	var scope *Scope
+	handler := APIKeyMiddleware("X-API-Key", "secret-key")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope = GetScope(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name       string
+		header     string
+		key        string
+		wantStatus int
+	}{
+		{name: "matching key", header: "X-API-Key", key: "secret-key", wantStatus: http.StatusOK},
+		{name: "missing key", wantStatus: http.StatusUnauthorized},
+		{name: "wrong key", header: "X-API-Key", key: "secret-kez", wantStatus: http.StatusUnauthorized},
+		{name: "prefix of the key", header: "X-API-Key", key: "secret", wantStatus: http.StatusUnauthorized},
+		{name: "key with a suffix", header: "X-API-Key", key: "secret-key2", wantStatus: http.StatusUnauthorized},
+		{name: "key in another header", header: "Authorization", key: "secret-key", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope = nil
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/traces", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.key)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && scope != FullScope {
+				t.Errorf("scope = %+v, want the full scope", scope)
+			}
+			if tt.wantStatus != http.StatusOK && scope != nil {
+				t.Error("handler was called for a rejected request")
+			}
+		})
+	}
+}
+
+func TestAPIKeyMiddlewareRejectsEmptyConfiguredKey(t *testing.T) {
+	handler := APIKeyMiddleware("X-API-Key", "")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/traces", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusUnauthorized)
+	}
+}
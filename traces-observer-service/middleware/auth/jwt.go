@@ -0,0 +1,190 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrMissingExpiry        = errors.New("token has no expiry")
+	ErrTokenExpired         = errors.New("token has expired")
+	ErrTokenNotYetValid     = errors.New("token is not valid yet")
+	ErrInvalidIssuer        = errors.New("invalid token issuer")
+	ErrInvalidAudience      = errors.New("invalid token audience")
+)
+
+const algHS256 = "HS256"
+
+// VerifierConfig holds the settings used to verify tokens
+type VerifierConfig struct {
+	// Secret is the key shared with the token issuer
+	Secret    string
+	Issuer    string
+	Audience  string
+	ClockSkew time.Duration
+}
+
+// Verifier verifies HS256 signed tokens and validates the registered claims
+type Verifier struct {
+	secret    []byte
+	issuer    string
+	audience  string
+	clockSkew time.Duration
+}
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+}
+
+// TokenClaims are the claims of a token. The scope claims list the components and environments
+// the token grants access to; a token without environmentUids covers every environment.
+// The org claim names the organization of the caller.
+type TokenClaims struct {
+	Sub             string   `json:"sub"`
+	Iss             string   `json:"iss"`
+	Aud             audience `json:"aud"`
+	Exp             *int64   `json:"exp"`
+	Nbf             *int64   `json:"nbf"`
+	Org             string   `json:"org"`
+	ComponentUids   []string `json:"componentUids"`
+	EnvironmentUids []string `json:"environmentUids"`
+}
+
+// audience accepts both the single string and the array form of the "aud" claim
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("aud must be a string or an array of strings")
+	}
+	*a = multiple
+	return nil
+}
+
+func NewVerifier(cfg VerifierConfig) *Verifier {
+	return &Verifier{
+		secret:    []byte(cfg.Secret),
+		issuer:    cfg.Issuer,
+		audience:  cfg.Audience,
+		clockSkew: cfg.ClockSkew,
+	}
+}
+
+// Verify checks the token signature and the exp, nbf, iss and aud claims, and returns the token claims
+func (v *Verifier) Verify(tokenString string) (*TokenClaims, error) {
+	parts := strings.Split(tokenString, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: found %d parts", ErrMalformedToken, len(parts))
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode header: %w", ErrMalformedToken, err)
+	}
+	if header.Alg != algHS256 {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decode signature: %w", ErrMalformedToken, err)
+	}
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var claims TokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode payload: %w", ErrMalformedToken, err)
+	}
+	if err := v.validateClaims(&claims, time.Now()); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+func (v *Verifier) validateClaims(claims *TokenClaims, now time.Time) error {
+	if claims.Exp == nil {
+		return ErrMissingExpiry
+	}
+	if now.After(time.Unix(*claims.Exp, 0).Add(v.clockSkew)) {
+		return ErrTokenExpired
+	}
+	if claims.Nbf != nil && now.Before(time.Unix(*claims.Nbf, 0).Add(-v.clockSkew)) {
+		return ErrTokenNotYetValid
+	}
+	if v.issuer != "" && claims.Iss != v.issuer {
+		return fmt.Errorf("%w: expected %q, got %q", ErrInvalidIssuer, v.issuer, claims.Iss)
+	}
+	if v.audience != "" && !slices.Contains(claims.Aud, v.audience) {
+		return fmt.Errorf("%w: expected %q", ErrInvalidAudience, v.audience)
+	}
+	return nil
+}
+
+// JWTMiddleware verifies the bearer token in the Authorization header and authorizes the
+// components and environments listed in its claims
+func JWTMiddleware(verifier *Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || tokenString == "" {
+				writeError(w, http.StatusUnauthorized, "missing bearer token")
+				return
+			}
+			claims, err := verifier.Verify(tokenString)
+			if err != nil {
+				writeError(w, http.StatusUnauthorized, fmt.Sprintf("invalid jwt: %v", err))
+				return
+			}
+			scope := &Scope{
+				ComponentUids:   claims.ComponentUids,
+				EnvironmentUids: claims.EnvironmentUids,
+				OrgName:         claims.Org,
+			}
+			next.ServeHTTP(w, r.WithContext(WithScope(r.Context(), scope)))
+		})
+	}
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-secret"
+
+// signToken creates a token with the header and claims, signed with HS256 and the secret
+func signToken(t *testing.T, header map[string]interface{}, claims map[string]interface{}, secret string) string {
+	t.Helper()
+	encode := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to encode token segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	signingInput := encode(header) + "." + encode(claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifierVerify(t *testing.T) {
+	now := time.Now()
+	hs256 := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":           "agent-manager-service",
+			"aud":           "traces-observer-service",
+			"exp":           now.Add(time.Minute).Unix(),
+			"org":           "acme",
+			"componentUids": []string{"component-1"},
+		}
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+	verifier := NewVerifier(VerifierConfig{
+		Secret:    testSecret,
+		Issuer:    "agent-manager-service",
+		Audience:  "traces-observer-service",
+		ClockSkew: 30 * time.Second,
+	})
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "valid token",
+			token: signToken(t, hs256, validClaims(), testSecret),
+		},
+		{
+			name:  "audience array containing the expected audience",
+			token: signToken(t, hs256, with("aud", []string{"other", "traces-observer-service"}), testSecret),
+		},
+		{
+			name:  "expired within the clock skew",
+			token: signToken(t, hs256, with("exp", now.Add(-10*time.Second).Unix()), testSecret),
+		},
+		{
+			name:    "signed with another secret",
+			token:   signToken(t, hs256, validClaims(), "other-secret"),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "tampered payload",
+			token: func() string {
+				parts := strings.Split(signToken(t, hs256, validClaims(), testSecret), ".")
+				tampered := strings.Split(signToken(t, hs256, with("componentUids", []string{"component-2"}), testSecret), ".")
+				return parts[0] + "." + tampered[1] + "." + parts[2]
+			}(),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "alg none",
+			token:   signToken(t, map[string]interface{}{"alg": "none"}, validClaims(), testSecret),
+			wantErr: ErrUnsupportedAlgorithm,
+		},
+		{
+			name:    "alg RS256",
+			token:   signToken(t, map[string]interface{}{"alg": "RS256"}, validClaims(), testSecret),
+			wantErr: ErrUnsupportedAlgorithm,
+		},
+		{
+			name:    "not three segments",
+			token:   "header.payload",
+			wantErr: ErrMalformedToken,
+		},
+		{
+			name:    "missing exp",
+			token:   signToken(t, hs256, with("exp", nil), testSecret),
+			wantErr: ErrMissingExpiry,
+		},
+		{
+			name:    "expired beyond the clock skew",
+			token:   signToken(t, hs256, with("exp", now.Add(-time.Minute).Unix()), testSecret),
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:  "nbf within the clock skew",
+			token: signToken(t, hs256, with("nbf", now.Add(10*time.Second).Unix()), testSecret),
+		},
+		{
+			name:    "nbf in the future",
+			token:   signToken(t, hs256, with("nbf", now.Add(time.Minute).Unix()), testSecret),
+			wantErr: ErrTokenNotYetValid,
+		},
+		{
+			name:    "wrong issuer",
+			token:   signToken(t, hs256, with("iss", "someone-else"), testSecret),
+			wantErr: ErrInvalidIssuer,
+		},
+		{
+			name:    "missing issuer",
+			token:   signToken(t, hs256, with("iss", nil), testSecret),
+			wantErr: ErrInvalidIssuer,
+		},
+		{
+			name:    "wrong audience",
+			token:   signToken(t, hs256, with("aud", "someone-else"), testSecret),
+			wantErr: ErrInvalidAudience,
+		},
+		{
+			name:    "audience array without the expected audience",
+			token:   signToken(t, hs256, with("aud", []string{"a", "b"}), testSecret),
+			wantErr: ErrInvalidAudience,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() unexpected error: %v", err)
+			}
+			if claims.Org != "acme" || !reflect.DeepEqual(claims.ComponentUids, []string{"component-1"}) {
+				t.Errorf("Verify() claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestVerifierSkipsUnconfiguredIssuerAndAudience(t *testing.T) {
+	verifier := NewVerifier(VerifierConfig{Secret: testSecret})
+	token := signToken(t, map[string]interface{}{"alg": "HS256"}, map[string]interface{}{
+		"iss": "anyone",
+		"aud": "anything",
+		"exp": time.Now().Add(time.Minute).Unix(),
+	}, testSecret)
+	if _, err := verifier.Verify(token); err != nil {
+		t.Fatalf("Verify() unexpected error: %v", err)
+	}
+}
+
+func TestJWTMiddleware(t *testing.T) {
+	verifier := NewVerifier(VerifierConfig{Secret: testSecret})
+	var scope *Scope
+	handler := JWTMiddleware(verifier)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope = GetScope(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+	token := signToken(t, map[string]interface{}{"alg": "HS256"}, map[string]interface{}{
+		"exp":             time.Now().Add(time.Minute).Unix(),
+		"org":             "acme",
+		"componentUids":   []string{"component-1", "component-2"},
+		"environmentUids": []string{"env-1"},
+	}, testSecret)
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "valid bearer token", authorization: "Bearer " + token, wantStatus: http.StatusOK},
+		{name: "missing header", authorization: "", wantStatus: http.StatusUnauthorized},
+		{name: "not a bearer token", authorization: "Basic " + token, wantStatus: http.StatusUnauthorized},
+		{name: "empty bearer token", authorization: "Bearer ", wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", authorization: "Bearer " + token + "x", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope = nil
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/traces", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				if scope != nil {
+					t.Error("handler was called for a rejected request")
+				}
+				return
+			}
+			want := &Scope{
+				ComponentUids:   []string{"component-1", "component-2"},
+				EnvironmentUids: []string{"env-1"},
+				OrgName:         "acme",
+			}
+			if !reflect.DeepEqual(scope, want) {
+				t.Errorf("scope = %+v, want %+v", scope, want)
+			}
+		})
+	}
+}
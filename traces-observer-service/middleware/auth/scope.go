@@ -0,0 +1,90 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"slices"
+)
+
+// Scope is the set of components and environments whose traces a caller may read
+type Scope struct {
+	// All grants access to every component and environment, e.g. to a trusted service holding the API key
+	All bool
+	// ComponentUids are the components that may be read
+	ComponentUids []string
+	// EnvironmentUids are the environments that may be read; any environment of the components when nil
+	EnvironmentUids []string
+	// OrgName is the organization of the caller, when known
+	OrgName string
+}
+
+// FullScope grants access to all components and environments
+var FullScope = &Scope{All: true}
+
+type scopeKey struct{}
+
+// WithScope adds the authorized scope to the context
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// GetScope retrieves the authorized scope from the context. Requests that did not pass through
+// an auth middleware, which happens only when authentication is disabled, have the full scope.
+func GetScope(ctx context.Context) *Scope {
+	if scope, ok := ctx.Value(scopeKey{}).(*Scope); ok {
+		return scope
+	}
+	return FullScope
+}
+
+// Allows reports whether the scope covers all the given components in the given environment.
+// An empty environmentUid stands for all environments, which only an unrestricted scope covers.
+func (s *Scope) Allows(componentUids []string, environmentUid string) bool {
+	if s.All {
+		return true
+	}
+	if len(componentUids) == 0 {
+		return false
+	}
+	for _, componentUid := range componentUids {
+		if !slices.Contains(s.ComponentUids, componentUid) {
+			return false
+		}
+	}
+	if s.EnvironmentUids == nil {
+		return true
+	}
+	return environmentUid != "" && slices.Contains(s.EnvironmentUids, environmentUid)
+}
+
+// errorResponse matches the error body written by the handlers
+type errorResponse struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(errorResponse{Error: "error", Message: message}); err != nil {
+		slog.Error("Failed to encode JSON", "error", err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestScopeAllows(t *testing.T) {
+	anyEnvironment := &Scope{ComponentUids: []string{"component-1", "component-2"}}
+	noEnvironment := &Scope{ComponentUids: []string{"component-1"}, EnvironmentUids: []string{}}
+	oneEnvironment := &Scope{ComponentUids: []string{"component-1", "component-2"}, EnvironmentUids: []string{"env-1"}}
+
+	tests := []struct {
+		name           string
+		scope          *Scope
+		componentUids  []string
+		environmentUid string
+		want           bool
+	}{
+		{name: "full scope", scope: FullScope, componentUids: []string{"any"}, environmentUid: "", want: true},
+		{name: "full scope without components", scope: FullScope, want: true},
+		{name: "no components requested", scope: anyEnvironment, environmentUid: "env-1", want: false},
+		{name: "nil environments cover an environment", scope: anyEnvironment, componentUids: []string{"component-1"}, environmentUid: "env-1", want: true},
+		{name: "nil environments cover all environments", scope: anyEnvironment, componentUids: []string{"component-1"}, environmentUid: "", want: true},
+		{name: "empty environments cover no environment", scope: noEnvironment, componentUids: []string{"component-1"}, environmentUid: "env-1", want: false},
+		{name: "empty environments do not cover all environments", scope: noEnvironment, componentUids: []string{"component-1"}, environmentUid: "", want: false},
+		{name: "listed environment", scope: oneEnvironment, componentUids: []string{"component-1"}, environmentUid: "env-1", want: true},
+		{name: "unlisted environment", scope: oneEnvironment, componentUids: []string{"component-1"}, environmentUid: "env-2", want: false},
+		{name: "all environments with listed environments", scope: oneEnvironment, componentUids: []string{"component-1"}, environmentUid: "", want: false},
+		{name: "multiple listed components", scope: oneEnvironment, componentUids: []string{"component-1", "component-2"}, environmentUid: "env-1", want: true},
+		{name: "one unlisted component among listed ones", scope: oneEnvironment, componentUids: []string{"component-1", "component-3"}, environmentUid: "env-1", want: false},
+		{name: "unlisted component", scope: anyEnvironment, componentUids: []string{"component-3"}, environmentUid: "env-1", want: false},
+		{name: "empty component", scope: anyEnvironment, componentUids: []string{""}, environmentUid: "env-1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.Allows(tt.componentUids, tt.environmentUid); got != tt.want {
+				t.Errorf("Allows(%v, %q) = %v, want %v", tt.componentUids, tt.environmentUid, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetScope(t *testing.T) {
+	if scope := GetScope(context.Background()); scope != FullScope {
+		t.Errorf("GetScope() without a scope = %+v, want the full scope", scope)
+	}
+	scope := &Scope{ComponentUids: []string{"component-1"}}
+	if got := GetScope(WithScope(context.Background(), scope)); got != scope {
+		t.Errorf("GetScope() = %+v, want %+v", got, scope)
+	}
+}
@@ -110,6 +110,68 @@ func (c *Client) Search(ctx context.Context, indices []string, query map[string]
 	return &response, nil
 }
 
+// BulkDocument is a document indexed by BulkIndex
+type BulkDocument struct {
+	Index string
+	// ID is the document ID. When set, creating a document that already exists succeeds
+	// without writing it again, so that retried requests do not duplicate documents.
+	ID     string
+	Source map[string]interface{}
+}
+
+// BulkIndex creates documents with a single bulk request, failing if any document is rejected.
+// Documents are created rather than indexed so that they can be written to data streams.
+func (c *Client) BulkIndex(ctx context.Context, documents []BulkDocument) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, document := range documents {
+		metadata := map[string]interface{}{"_index": document.Index}
+		if document.ID != "" {
+			metadata["_id"] = document.ID
+		}
+		action := map[string]interface{}{"create": metadata}
+		if err := encoder.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		if err := encoder.Encode(document.Source); err != nil {
+			return fmt.Errorf("failed to encode document: %w", err)
+		}
+	}
+
+	req := opensearchapi.BulkRequest{
+		Body: &buf,
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		log.Printf("Bulk request failed: %v", err)
+		return fmt.Errorf("bulk request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		log.Printf("Bulk request returned error: %s", res.Status())
+		return fmt.Errorf("bulk request failed with status: %s", res.Status())
+	}
+
+	var response bulkResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	if response.Errors {
+		for _, item := range response.Items {
+			result := item["create"]
+			// A conflict means the document was created by an earlier attempt
+			if result.Error != nil && result.Status != http.StatusConflict {
+				return fmt.Errorf("failed to index document: %s: %s", result.Error.Type, result.Error.Reason)
+			}
+		}
+	}
+
+	log.Printf("Bulk index completed: documents=%d", len(documents))
+
+	return nil
+}
+
 // HealthCheck checks if OpenSearch is accessible
 func (c *Client) HealthCheck(ctx context.Context) error {
 	_, err := c.client.Info()
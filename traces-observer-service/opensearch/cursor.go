@@ -0,0 +1,54 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// traceCursorSortValues is the number of sort values in a root span query, see BuildRootSpanQuery
+const traceCursorSortValues = 2
+
+// EncodeTraceCursor encodes the sort values of the last root span of a page into an opaque cursor
+func EncodeTraceCursor(sortValues []json.RawMessage) (string, error) {
+	if len(sortValues) != traceCursorSortValues {
+		return "", fmt.Errorf("expected %d sort values, got %d", traceCursorSortValues, len(sortValues))
+	}
+	data, err := json.Marshal(sortValues)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeTraceCursor decodes a cursor created by EncodeTraceCursor into search_after values
+func DecodeTraceCursor(cursor string) ([]json.RawMessage, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+	var sortValues []json.RawMessage
+	if err := json.Unmarshal(data, &sortValues); err != nil {
+		return nil, fmt.Errorf("failed to parse cursor: %w", err)
+	}
+	if len(sortValues) != traceCursorSortValues {
+		return nil, fmt.Errorf("expected %d sort values in cursor, got %d", traceCursorSortValues, len(sortValues))
+	}
+	return sortValues, nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// maxSearchIndices bounds the number of indices named in a search. Longer time ranges are
+// searched with wildcards over a coarser period, e.g. otel-traces-2025-01-* instead of each day.
+const maxSearchIndices = 62
+
+// indexPatternTokens are the date placeholders of an index pattern, from the coarsest to the finest.
+// Each token is replaced with the UTC date formatted with its layout.
+var indexPatternTokens = []struct {
+	token  string
+	layout string
+}{
+	{"{yyyy}", "2006"},
+	{"{MM}", "01"},
+	{"{dd}", "02"},
+	{"{HH}", "15"},
+}
+
+// indexGranularityTokens is the number of leading indexPatternTokens used by each index granularity
+var indexGranularityTokens = map[string]int{
+	config.IndexGranularityNone:   0,
+	config.IndexGranularityDaily:  3,
+	config.IndexGranularityHourly: 4,
+}
+
+// IndexPattern names the trace indices, which roll over daily or hourly, or are a single
+// data stream or alias when the granularity is none
+type IndexPattern struct {
+	pattern   string
+	precision int // Number of leading indexPatternTokens in the pattern
+}
+
+// NewIndexPattern creates an index pattern, checking that the pattern has exactly the date tokens of the granularity
+func NewIndexPattern(pattern string, granularity string) (*IndexPattern, error) {
+	precision, ok := indexGranularityTokens[granularity]
+	if !ok {
+		return nil, fmt.Errorf("invalid index granularity: %s", granularity)
+	}
+	for i, token := range indexPatternTokens {
+		if contains := strings.Contains(pattern, token.token); contains != (i < precision) {
+			if contains {
+				return nil, fmt.Errorf("index pattern %q must not contain %s for %s indices", pattern, token.token, granularity)
+			}
+			return nil, fmt.Errorf("index pattern %q must contain %s for %s indices", pattern, token.token, granularity)
+		}
+	}
+	return &IndexPattern{pattern: pattern, precision: precision}, nil
+}
+
+// IsTimeBased reports whether the indices roll over by time, as opposed to a data stream or alias
+func (p *IndexPattern) IsTimeBased() bool {
+	return p.precision > 0
+}
+
+// IndexForTime returns the index that holds spans started at the given time
+func (p *IndexPattern) IndexForTime(t time.Time) string {
+	return p.name(t.UTC(), p.precision)
+}
+
+// IndicesForTimeRange returns the indices that hold spans started in the given time range.
+// Times are RFC3339 and may have any offset; indices are named by UTC date. When the range
+// covers more than maxSearchIndices indices, wildcards over a coarser period are returned.
+func (p *IndexPattern) IndicesForTimeRange(startTime, endTime string) ([]string, error) {
+	if startTime == "" || endTime == "" {
+		return nil, fmt.Errorf("start time and end time are required")
+	}
+
+	// Parse the time strings (expecting RFC3339 format)
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time format: %w", err)
+	}
+
+	end, err := time.Parse(time.RFC3339, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end time format: %w", err)
+	}
+
+	// Ensure start is before end
+	if start.After(end) {
+		return nil, fmt.Errorf("start time must be before end time")
+	}
+
+	if !p.IsTimeBased() {
+		return []string{p.pattern}, nil
+	}
+
+	start, end = start.UTC(), end.UTC()
+	for precision := p.precision; ; precision-- {
+		indices := p.indices(start, end, precision)
+		if len(indices) <= maxSearchIndices || precision == 1 {
+			return indices, nil
+		}
+	}
+}
+
+// indices names the indices from start to end, replacing the date tokens after precision with wildcards
+func (p *IndexPattern) indices(start, end time.Time, precision int) []string {
+	indices := []string{}
+	for current := truncate(start, precision); !current.After(end); current = advance(current, precision) {
+		indices = append(indices, p.name(current, precision))
+	}
+	return indices
+}
+
+// name formats the leading precision date tokens of the pattern and replaces the rest with a wildcard
+func (p *IndexPattern) name(t time.Time, precision int) string {
+	replacements := make([]string, 0, 2*len(indexPatternTokens))
+	for i, token := range indexPatternTokens {
+		value := "*"
+		if i < precision {
+			value = t.Format(token.layout)
+		}
+		replacements = append(replacements, token.token, value)
+	}
+	return strings.NewReplacer(replacements...).Replace(p.pattern)
+}
+
+// truncate returns the start of the year, month, day or hour of t for a precision of 1 to 4
+func truncate(t time.Time, precision int) time.Time {
+	month, day, hour := time.January, 1, 0
+	if precision >= 2 {
+		month = t.Month()
+	}
+	if precision >= 3 {
+		day = t.Day()
+	}
+	if precision >= 4 {
+		hour = t.Hour()
+	}
+	return time.Date(t.Year(), month, day, hour, 0, 0, 0, time.UTC)
+}
+
+// advance moves t forward by a year, month, day or hour for a precision of 1 to 4
+func advance(t time.Time, precision int) time.Time {
+	switch precision {
+	case 1:
+		return t.AddDate(1, 0, 0)
+	case 2:
+		return t.AddDate(0, 1, 0)
+	case 3:
+		return t.AddDate(0, 0, 1)
+	default:
+		return t.Add(time.Hour)
+	}
+}
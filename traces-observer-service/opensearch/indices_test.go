@@ -0,0 +1,174 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+func TestIndicesForTimeRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		pattern     string
+		granularity string
+		startTime   string
+		endTime     string
+		expected    []string
+	}{
+		{
+			name:        "daily indices of a range in a positive offset crossing midnight UTC",
+			pattern:     "otel-traces-{yyyy}-{MM}-{dd}",
+			granularity: config.IndexGranularityDaily,
+			startTime:   "2025-01-02T03:00:00+05:30", // 2025-01-01T21:30:00Z
+			endTime:     "2025-01-02T08:00:00+05:30", // 2025-01-02T02:30:00Z
+			expected:    []string{"otel-traces-2025-01-01", "otel-traces-2025-01-02"},
+		},
+		{
+			name:        "daily indices of a range within a day",
+			pattern:     "otel-traces-{yyyy}-{MM}-{dd}",
+			granularity: config.IndexGranularityDaily,
+			startTime:   "2025-01-02T01:00:00Z",
+			endTime:     "2025-01-02T02:00:00Z",
+			expected:    []string{"otel-traces-2025-01-02"},
+		},
+		{
+			name:        "hourly indices across midnight",
+			pattern:     "otel-traces-{yyyy}.{MM}.{dd}.{HH}",
+			granularity: config.IndexGranularityHourly,
+			startTime:   "2025-01-01T22:30:00Z",
+			endTime:     "2025-01-02T01:10:00Z",
+			expected: []string{
+				"otel-traces-2025.01.01.22", "otel-traces-2025.01.01.23",
+				"otel-traces-2025.01.02.00", "otel-traces-2025.01.02.01",
+			},
+		},
+		{
+			name:        "daily indices coarsened to months past the index limit",
+			pattern:     "otel-traces-{yyyy}-{MM}-{dd}",
+			granularity: config.IndexGranularityDaily,
+			startTime:   "2025-01-15T00:00:00Z",
+			endTime:     "2025-04-10T00:00:00Z",
+			expected:    []string{"otel-traces-2025-01-*", "otel-traces-2025-02-*", "otel-traces-2025-03-*", "otel-traces-2025-04-*"},
+		},
+		{
+			name:        "hourly indices coarsened to days past the index limit",
+			pattern:     "otel-traces-{yyyy}.{MM}.{dd}.{HH}",
+			granularity: config.IndexGranularityHourly,
+			startTime:   "2025-01-01T00:00:00Z",
+			endTime:     "2025-01-04T00:00:00Z",
+			expected:    []string{"otel-traces-2025.01.01.*", "otel-traces-2025.01.02.*", "otel-traces-2025.01.03.*", "otel-traces-2025.01.04.*"},
+		},
+		{
+			name:        "daily indices coarsened to years past the index limit",
+			pattern:     "otel-traces-{yyyy}-{MM}-{dd}",
+			granularity: config.IndexGranularityDaily,
+			startTime:   "2019-12-01T00:00:00Z",
+			endTime:     "2025-06-01T00:00:00Z",
+			expected: []string{
+				"otel-traces-2019-*-*", "otel-traces-2020-*-*", "otel-traces-2021-*-*",
+				"otel-traces-2022-*-*", "otel-traces-2023-*-*", "otel-traces-2024-*-*", "otel-traces-2025-*-*",
+			},
+		},
+		{
+			name:        "data stream without time based indices",
+			pattern:     "otel-traces",
+			granularity: config.IndexGranularityNone,
+			startTime:   "2019-12-01T00:00:00Z",
+			endTime:     "2025-06-01T00:00:00Z",
+			expected:    []string{"otel-traces"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := NewIndexPattern(tt.pattern, tt.granularity)
+			if err != nil {
+				t.Fatalf("NewIndexPattern() error = %v", err)
+			}
+			indices, err := pattern.IndicesForTimeRange(tt.startTime, tt.endTime)
+			if err != nil {
+				t.Fatalf("IndicesForTimeRange() error = %v", err)
+			}
+			if !reflect.DeepEqual(indices, tt.expected) {
+				t.Errorf("indices = %v, want %v", indices, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIndicesForTimeRangeErrors(t *testing.T) {
+	pattern, err := NewIndexPattern("otel-traces-{yyyy}-{MM}-{dd}", config.IndexGranularityDaily)
+	if err != nil {
+		t.Fatalf("NewIndexPattern() error = %v", err)
+	}
+	tests := []struct {
+		name      string
+		startTime string
+		endTime   string
+		expected  string
+	}{
+		{name: "missing end time", startTime: "2025-01-01T00:00:00Z", expected: "start time and end time are required"},
+		{name: "invalid start time", startTime: "2025-01-01", endTime: "2025-01-02T00:00:00Z", expected: "invalid start time format"},
+		{name: "invalid end time", startTime: "2025-01-01T00:00:00Z", endTime: "tomorrow", expected: "invalid end time format"},
+		{name: "start after end", startTime: "2025-01-02T00:00:00Z", endTime: "2025-01-01T00:00:00Z", expected: "start time must be before end time"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pattern.IndicesForTimeRange(tt.startTime, tt.endTime)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("IndicesForTimeRange() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewIndexPattern(t *testing.T) {
+	tests := []struct {
+		name        string
+		pattern     string
+		granularity string
+		expected    string
+	}{
+		{name: "daily pattern without a day", pattern: "otel-traces-{yyyy}-{MM}", granularity: config.IndexGranularityDaily, expected: "must contain {dd}"},
+		{name: "daily pattern with an hour", pattern: "otel-traces-{yyyy}-{MM}-{dd}-{HH}", granularity: config.IndexGranularityDaily, expected: "must not contain {HH}"},
+		{name: "data stream with a date", pattern: "otel-traces-{yyyy}", granularity: config.IndexGranularityNone, expected: "must not contain {yyyy}"},
+		{name: "unknown granularity", pattern: "otel-traces", granularity: "weekly", expected: "invalid index granularity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewIndexPattern(tt.pattern, tt.granularity)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("NewIndexPattern() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIndexForTime(t *testing.T) {
+	pattern, err := NewIndexPattern("otel-traces-{yyyy}-{MM}-{dd}", config.IndexGranularityDaily)
+	if err != nil {
+		t.Fatalf("NewIndexPattern() error = %v", err)
+	}
+	spanTime := time.Date(2025, 1, 2, 3, 0, 0, 0, time.FixedZone("IST", 5*3600+1800))
+	if index := pattern.IndexForTime(spanTime); index != "otel-traces-2025-01-01" {
+		t.Errorf("IndexForTime() = %q, want the index of the UTC date", index)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// metricsLatencyPercents are the latency percentiles computed for each bucket
+var metricsLatencyPercents = []float64{50, 95, 99}
+
+// errorStatusValues are the status values treated as errors when extracting the status of a span
+var errorStatusValues = []string{"error", "Error", "ERROR", "failed", "Failed", "FAILED", "2"}
+
+// metricAggregations is the result of the aggregations built by buildMetricAggregations
+type metricAggregations struct {
+	Requests struct {
+		DocCount int `json:"doc_count"`
+		Latency  struct {
+			Values map[string]*float64 `json:"values"`
+		} `json:"latency"`
+	} `json:"requests"`
+	Errors struct {
+		Traces struct {
+			Value int `json:"value"`
+		} `json:"traces"`
+	} `json:"errors"`
+	tokenAggregations
+}
+
+// tokenAggregations is the result of the aggregations built by buildTokenAggregations
+type tokenAggregations struct {
+	InputTokens      sumAggregationResult       `json:"input_tokens"`
+	OutputTokens     sumAggregationResult       `json:"output_tokens"`
+	PromptTokens     legacySumAggregationResult `json:"prompt_tokens"`
+	CompletionTokens legacySumAggregationResult `json:"completion_tokens"`
+}
+
+// tokens returns the summed input and output tokens
+func (aggs tokenAggregations) tokens() (inputTokens int, outputTokens int) {
+	return int(aggs.InputTokens.Value + aggs.PromptTokens.Tokens.Value),
+		int(aggs.OutputTokens.Value + aggs.CompletionTokens.Tokens.Value)
+}
+
+type sumAggregationResult struct {
+	Value float64 `json:"value"`
+}
+
+type legacySumAggregationResult struct {
+	Tokens sumAggregationResult `json:"tokens"`
+}
+
+// metricsAggregationResult is the result of the aggregations built by BuildMetricsQuery
+type metricsAggregationResult struct {
+	metricAggregations
+	OverTime struct {
+		Buckets []struct {
+			Key int64 `json:"key"`
+			metricAggregations
+		} `json:"buckets"`
+	} `json:"over_time"`
+}
+
+// ParseMetrics converts the aggregations of a metrics query into a metrics response
+func ParseMetrics(response *SearchResponse, interval time.Duration) (*traces.MetricsResponse, error) {
+	var result metricsAggregationResult
+	if len(response.Aggregations) > 0 {
+		if err := json.Unmarshal(response.Aggregations, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse metrics aggregations: %w", err)
+		}
+	}
+
+	metrics := &traces.MetricsResponse{
+		Interval: traces.FormatMetricsInterval(interval),
+		Summary:  toMetricsBucket(result.metricAggregations),
+		Buckets:  make([]traces.MetricsBucket, 0, len(result.OverTime.Buckets)),
+	}
+	for _, bucket := range result.OverTime.Buckets {
+		metricsBucket := toMetricsBucket(bucket.metricAggregations)
+		metricsBucket.Timestamp = time.UnixMilli(bucket.Key).UTC().Format(time.RFC3339)
+		metrics.Buckets = append(metrics.Buckets, metricsBucket)
+	}
+
+	return metrics, nil
+}
+
+func toMetricsBucket(aggs metricAggregations) traces.MetricsBucket {
+	inputTokens, outputTokens := aggs.tokens()
+
+	bucket := traces.MetricsBucket{
+		RequestCount: aggs.Requests.DocCount,
+		ErrorCount:   aggs.Errors.Traces.Value,
+		TokenUsage: traces.TokenUsage{
+			InputTokens:  inputTokens,
+			OutputTokens: outputTokens,
+			TotalTokens:  inputTokens + outputTokens,
+		},
+	}
+
+	if bucket.RequestCount > 0 {
+		// Error spans of a trace may fall in the bucket while its root span does not
+		bucket.ErrorRate = math.Min(float64(bucket.ErrorCount)/float64(bucket.RequestCount), 1)
+		bucket.Latency = &traces.LatencyPercentiles{
+			P50InNanos: percentileValue(aggs.Requests.Latency.Values, 50),
+			P95InNanos: percentileValue(aggs.Requests.Latency.Values, 95),
+			P99InNanos: percentileValue(aggs.Requests.Latency.Values, 99),
+		}
+	}
+
+	return bucket
+}
+
+// percentileValue reads a percentile from a percentiles aggregation, keyed like "95.0"
+func percentileValue(values map[string]*float64, percent float64) int64 {
+	value, ok := values[fmt.Sprintf("%.1f", percent)]
+	if !ok || value == nil {
+		return 0
+	}
+	return int64(math.Round(*value))
+}
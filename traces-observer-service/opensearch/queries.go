@@ -17,65 +17,20 @@
 package opensearch
 
 import (
-	"fmt"
+	"encoding/json"
 	"time"
-)
-
-// GetIndicesForTimeRange generates index names for the given time range
-// Returns indices in format: otel-traces-YYYY-MM-DD
-func GetIndicesForTimeRange(startTime, endTime string) ([]string, error) {
-	if startTime == "" || endTime == "" {
-		return nil, fmt.Errorf("start time and end time are required")
-	}
-
-	// Parse the time strings (expecting RFC3339 format)
-	start, err := time.Parse(time.RFC3339, startTime)
-	if err != nil {
-		return nil, fmt.Errorf("invalid start time format: %w", err)
-	}
-
-	end, err := time.Parse(time.RFC3339, endTime)
-	if err != nil {
-		return nil, fmt.Errorf("invalid end time format: %w", err)
-	}
 
-	// Ensure start is before end
-	if start.After(end) {
-		return nil, fmt.Errorf("start time must be before end time")
-	}
-
-	// Generate indices for each day in the range
-	indices := []string{}
-	indexMap := make(map[string]bool) // To avoid duplicates
-
-	// Iterate through each day from start to end
-	currentDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
-	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
-
-	for !currentDay.After(endDay) {
-		indexName := fmt.Sprintf("otel-traces-%04d-%02d-%02d", currentDay.Year(), currentDay.Month(), currentDay.Day())
-		if !indexMap[indexName] {
-			indices = append(indices, indexName)
-			indexMap[indexName] = true
-		}
-		currentDay = currentDay.AddDate(0, 0, 1) // Add one day
-	}
-
-	return indices, nil
-}
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
 
-// BuildTraceQuery builds an OpenSearch query for traces
-func BuildTraceQuery(params TraceQueryParams) map[string]interface{} {
+// buildTraceFilters builds the filter conditions shared by the trace list queries
+func buildTraceFilters(params traces.TraceQueryParams) []map[string]interface{} {
 	// Build the must conditions
 	mustConditions := []map[string]interface{}{}
 
 	// Add component UID filter
-	if params.ComponentUid != "" {
-		mustConditions = append(mustConditions, map[string]interface{}{
-			"term": map[string]interface{}{
-				"resource.openchoreo.dev/component-uid": params.ComponentUid,
-			},
-		})
+	if len(params.ComponentUids) > 0 {
+		mustConditions = append(mustConditions, componentFilter(params.ComponentUids))
 	}
 
 	// Add environment UID filter
@@ -99,6 +54,29 @@ func BuildTraceQuery(params TraceQueryParams) map[string]interface{} {
 		})
 	}
 
+	return mustConditions
+}
+
+// componentFilter builds the condition that matches spans of any of the given components
+func componentFilter(componentUids []string) map[string]interface{} {
+	if len(componentUids) == 1 {
+		return map[string]interface{}{
+			"term": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": componentUids[0],
+			},
+		}
+	}
+	return map[string]interface{}{
+		"terms": map[string]interface{}{
+			"resource.openchoreo.dev/component-uid": componentUids,
+		},
+	}
+}
+
+// BuildTraceQuery builds an OpenSearch query for traces
+func BuildTraceQuery(params traces.TraceQueryParams) map[string]interface{} {
+	mustConditions := buildTraceFilters(params)
+
 	// Set default limit if not provided
 	limit := params.Limit
 	if limit == 0 {
@@ -138,26 +116,394 @@ func BuildTraceQuery(params TraceQueryParams) map[string]interface{} {
 	return query
 }
 
-// BuildTraceByIdAndServiceQuery builds a query to get spans by both traceId and componentUid
-func BuildTraceByIdAndServiceQuery(params TraceByIdAndServiceParams) map[string]interface{} {
-	// Build the must conditions - traceId and resource filters must match
+// BuildRootSpanQuery builds a query for the root spans of traces, one per trace.
+// Root spans are sorted by start time with the span ID as a tie breaker so that
+// searchAfter (the sort values of the last root span of the previous page) gives
+// stable pages. When searchAfter is nil, params.Offset is used instead.
+// A trace may have several root spans, so the traces matched are counted by the traces aggregation.
+func BuildRootSpanQuery(params traces.TraceQueryParams, searchAfter []json.RawMessage) map[string]interface{} {
+	mustConditions := buildTraceFilters(params)
+
+	mustConditions = append(mustConditions, rootSpanCondition())
+
+	// Restrict to the traces selected by span filters
+	if len(params.TraceIDs) > 0 {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"traceId": params.TraceIDs,
+			},
+		})
+	}
+
+	// Duration filters apply to the root span, which covers the whole trace
+	if params.Filters.MinDuration > 0 || params.Filters.MaxDuration > 0 {
+		durationRange := map[string]interface{}{}
+		if params.Filters.MinDuration > 0 {
+			durationRange["gte"] = params.Filters.MinDuration.Nanoseconds()
+		}
+		if params.Filters.MaxDuration > 0 {
+			durationRange["lte"] = params.Filters.MaxDuration.Nanoseconds()
+		}
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"range": map[string]interface{}{
+				"durationInNanos": durationRange,
+			},
+		})
+	}
+
+	// Set default limit if not provided
+	limit := params.Limit
+	if limit == 0 {
+		limit = 10
+	}
+
+	// Set default sort order
+	sortOrder := params.SortOrder
+	if sortOrder == "" {
+		sortOrder = "desc"
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size": limit,
+		"aggs": map[string]interface{}{
+			"traces": map[string]interface{}{
+				"cardinality": map[string]interface{}{
+					"field": "traceId",
+					// Counts are exact up to the threshold, the highest OpenSearch supports
+					"precision_threshold": 40000,
+				},
+			},
+		},
+		"sort": []map[string]interface{}{
+			{
+				"startTime": map[string]string{
+					"order": sortOrder,
+				},
+			},
+			{
+				"spanId": map[string]string{
+					"order": sortOrder,
+				},
+			},
+		},
+	}
+
+	if searchAfter != nil {
+		query["search_after"] = searchAfter
+	} else if params.Offset > 0 {
+		query["from"] = params.Offset
+	}
+
+	return query
+}
+
+// rootSpanCondition matches root spans, which either have an empty parentSpanId or none at all
+func rootSpanCondition() map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should": []map[string]interface{}{
+				{
+					"term": map[string]interface{}{
+						"parentSpanId": "",
+					},
+				},
+				{
+					"bool": map[string]interface{}{
+						"must_not": map[string]interface{}{
+							"exists": map[string]interface{}{
+								"field": "parentSpanId",
+							},
+						},
+					},
+				},
+			},
+			"minimum_should_match": 1,
+		},
+	}
+}
+
+// errorSpanCondition matches spans with an error, mirroring traces.SpanHasError
+func errorSpanCondition() map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should": []map[string]interface{}{
+				{
+					"exists": map[string]interface{}{
+						"field": "attributes.error.type",
+					},
+				},
+				{
+					"terms": map[string]interface{}{
+						"attributes.gen_ai.tool.status": errorStatusValues,
+					},
+				},
+				{
+					"range": map[string]interface{}{
+						"attributes.http.status_code": map[string]interface{}{
+							"gte": 400,
+						},
+					},
+				},
+				{
+					"terms": map[string]interface{}{
+						"status.code": errorStatusValues,
+					},
+				},
+			},
+			"minimum_should_match": 1,
+		},
+	}
+}
+
+// BuildMetricsQuery builds an aggregation query for time-bucketed agent metrics.
+// The metric aggregations are computed for the whole time range and for each bucket.
+func BuildMetricsQuery(params traces.MetricsQueryParams) map[string]interface{} {
+	mustConditions := buildTraceFilters(traces.TraceQueryParams{
+		ComponentUids:  []string{params.ComponentUid},
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.StartTime.UTC().Format(time.RFC3339Nano),
+		EndTime:        params.EndTime.UTC().Format(time.RFC3339Nano),
+	})
+
+	aggregations := buildMetricAggregations()
+	aggregations["over_time"] = map[string]interface{}{
+		"date_histogram": map[string]interface{}{
+			"field":          "startTime",
+			"fixed_interval": traces.FormatMetricsInterval(params.Interval),
+			"min_doc_count":  0,
+			"extended_bounds": map[string]interface{}{
+				"min": params.StartTime.UnixMilli(),
+				"max": params.EndTime.UnixMilli(),
+			},
+		},
+		"aggs": buildMetricAggregations(),
+	}
+
+	return map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size": 0,
+		"aggs": aggregations,
+	}
+}
+
+// buildMetricAggregations builds the aggregations computed for each metrics bucket
+func buildMetricAggregations() map[string]interface{} {
+	aggregations := map[string]interface{}{
+		// Each trace has one root span, which covers the whole request
+		"requests": map[string]interface{}{
+			"filter": rootSpanCondition(),
+			"aggs": map[string]interface{}{
+				"latency": map[string]interface{}{
+					"percentiles": map[string]interface{}{
+						"field":    "durationInNanos",
+						"percents": metricsLatencyPercents,
+					},
+				},
+			},
+		},
+		"errors": map[string]interface{}{
+			"filter": errorSpanCondition(),
+			"aggs": map[string]interface{}{
+				"traces": map[string]interface{}{
+					"cardinality": map[string]interface{}{
+						"field": "traceId",
+					},
+				},
+			},
+		},
+	}
+	for name, aggregation := range buildTokenAggregations() {
+		aggregations[name] = aggregation
+	}
+	return aggregations
+}
+
+// buildTokenAggregations builds the aggregations that sum the input and output tokens of GenAI spans.
+// Token attributes follow traces.ExtractTokenUsage: the legacy prompt and
+// completion attributes are only counted for spans without the current ones.
+func buildTokenAggregations() map[string]interface{} {
+	return map[string]interface{}{
+		"input_tokens":      sumAggregation("attributes.gen_ai.usage.input_tokens"),
+		"output_tokens":     sumAggregation("attributes.gen_ai.usage.output_tokens"),
+		"prompt_tokens":     legacySumAggregation("attributes.gen_ai.usage.prompt_tokens", "attributes.gen_ai.usage.input_tokens"),
+		"completion_tokens": legacySumAggregation("attributes.gen_ai.usage.completion_tokens", "attributes.gen_ai.usage.output_tokens"),
+	}
+}
+
+func sumAggregation(field string) map[string]interface{} {
+	return map[string]interface{}{
+		"sum": map[string]interface{}{
+			"field": field,
+		},
+	}
+}
+
+// legacySumAggregation sums field over the spans that do not have the replacement field
+func legacySumAggregation(field string, replacement string) map[string]interface{} {
+	return map[string]interface{}{
+		"filter": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must_not": map[string]interface{}{
+					"exists": map[string]interface{}{
+						"field": replacement,
+					},
+				},
+			},
+		},
+		"aggs": map[string]interface{}{
+			"tokens": sumAggregation(field),
+		},
+	}
+}
+
+// BuildSpanFilterQuery builds a query for the spans that may match the span filters in params.
+// Model, tool name and attribute filters are applied here; span kind, error and text filters
+// depend on the span attributes as a whole and are checked with TraceFilters.MatchesSpan.
+func BuildSpanFilterQuery(params traces.TraceQueryParams, size int, searchAfter []json.RawMessage) map[string]interface{} {
+	mustConditions := buildTraceFilters(params)
+
+	if params.Filters.Model != "" {
+		mustConditions = append(mustConditions, anyAttributeEquals(traces.SpanModelAttributes, params.Filters.Model))
+	}
+
+	if params.Filters.ToolName != "" {
+		mustConditions = append(mustConditions, anyAttributeEquals(traces.SpanToolNameAttributes, params.Filters.ToolName))
+	}
+
+	for key, value := range params.Filters.Attributes {
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"term": map[string]interface{}{
+				"attributes." + key: value,
+			},
+		})
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size":    size,
+		"_source": []string{"traceId", "spanId", "parentSpanId", "name", "status", "attributes"},
+		"sort": []map[string]interface{}{
+			{
+				"startTime": map[string]string{
+					"order": "asc",
+				},
+			},
+			{
+				"spanId": map[string]string{
+					"order": "asc",
+				},
+			},
+		},
+	}
+
+	if searchAfter != nil {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+// anyAttributeEquals builds a condition that matches when any of the given attributes has the value
+func anyAttributeEquals(attributes []string, value string) map[string]interface{} {
+	should := make([]map[string]interface{}, 0, len(attributes))
+	for _, attribute := range attributes {
+		should = append(should, map[string]interface{}{
+			"term": map[string]interface{}{
+				"attributes." + attribute: value,
+			},
+		})
+	}
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"should":               should,
+			"minimum_should_match": 1,
+		},
+	}
+}
+
+// BuildTraceSpansQuery builds a query for all spans of the given traces that belong to the
+// component and environment in params. Spans are sorted by start time and span ID so that
+// large result sets can be read in batches using searchAfter.
+func BuildTraceSpansQuery(traceIDs []string, params traces.TraceQueryParams, size int, searchAfter []json.RawMessage) map[string]interface{} {
 	mustConditions := []map[string]interface{}{
 		{
-			"term": map[string]interface{}{
-				"traceId": params.TraceID,
+			"terms": map[string]interface{}{
+				"traceId": traceIDs,
 			},
 		},
 	}
 
 	// Add component UID filter
-	if params.ComponentUid != "" {
+	if len(params.ComponentUids) > 0 {
+		mustConditions = append(mustConditions, componentFilter(params.ComponentUids))
+	}
+
+	// Add environment UID filter
+	if params.EnvironmentUid != "" {
 		mustConditions = append(mustConditions, map[string]interface{}{
 			"term": map[string]interface{}{
-				"resource.openchoreo.dev/component-uid": params.ComponentUid,
+				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
 			},
 		})
 	}
 
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size": size,
+		"sort": []map[string]interface{}{
+			{
+				"startTime": map[string]string{
+					"order": "asc",
+				},
+			},
+			{
+				"spanId": map[string]string{
+					"order": "asc",
+				},
+			},
+		},
+	}
+
+	if searchAfter != nil {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+// BuildTraceByIdAndServiceQuery builds a query to get spans by both traceId and componentUid
+func BuildTraceByIdAndServiceQuery(params traces.TraceByIdAndServiceParams) map[string]interface{} {
+	// Build the must conditions - traceId and resource filters must match
+	mustConditions := []map[string]interface{}{
+		{
+			"term": map[string]interface{}{
+				"traceId": params.TraceID,
+			},
+		},
+	}
+
+	// Add component UID filter
+	if len(params.ComponentUids) > 0 {
+		mustConditions = append(mustConditions, componentFilter(params.ComponentUids))
+	}
+
 	// Add environment UID filter
 	if params.EnvironmentUid != "" {
 		mustConditions = append(mustConditions, map[string]interface{}{
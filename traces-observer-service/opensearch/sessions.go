@@ -0,0 +1,88 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// BuildSessionSpansQuery builds a query for the spans of a component that carry a session ID.
+// When params.SessionID is set only the spans of that session are matched. Only the fields
+// needed to group traces into sessions are returned, sorted so that large result sets can be
+// read in batches using searchAfter.
+func BuildSessionSpansQuery(params traces.SessionQueryParams, sessionAttributes []string, size int, searchAfter []json.RawMessage) map[string]interface{} {
+	mustConditions := buildTraceFilters(traces.TraceQueryParams{
+		ComponentUids:  []string{params.ComponentUid},
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.StartTime,
+		EndTime:        params.EndTime,
+	})
+
+	if params.SessionID != "" {
+		mustConditions = append(mustConditions, anyAttributeEquals(sessionAttributes, params.SessionID))
+	} else {
+		should := make([]map[string]interface{}, 0, len(sessionAttributes))
+		for _, attribute := range sessionAttributes {
+			should = append(should, map[string]interface{}{
+				"exists": map[string]interface{}{
+					"field": "attributes." + attribute,
+				},
+			})
+		}
+		mustConditions = append(mustConditions, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should":               should,
+				"minimum_should_match": 1,
+			},
+		})
+	}
+
+	source := []string{"traceId", "spanId", "startTime", "endTime"}
+	for _, attribute := range sessionAttributes {
+		source = append(source, "attributes."+attribute)
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size":    size,
+		"_source": source,
+		"sort": []map[string]interface{}{
+			{
+				"startTime": map[string]string{
+					"order": "asc",
+				},
+			},
+			{
+				"spanId": map[string]string{
+					"order": "asc",
+				},
+			},
+		},
+	}
+
+	if searchAfter != nil {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
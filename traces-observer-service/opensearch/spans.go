@@ -0,0 +1,241 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// ParseSpans converts OpenSearch response to Span structs
+func ParseSpans(response *SearchResponse) []traces.Span {
+	spans := make([]traces.Span, 0, len(response.Hits.Hits))
+
+	for _, hit := range response.Hits.Hits {
+		span := parseSpan(hit.Source)
+		spans = append(spans, span)
+	}
+
+	return spans
+}
+
+// parseSpan extracts span information from a source document
+// ParseTraceCount returns the number of distinct traces counted by the traces aggregation of a root span query
+func ParseTraceCount(response *SearchResponse) (int, error) {
+	var result struct {
+		Traces struct {
+			Value int `json:"value"`
+		} `json:"traces"`
+	}
+	if len(response.Aggregations) > 0 {
+		if err := json.Unmarshal(response.Aggregations, &result); err != nil {
+			return 0, fmt.Errorf("failed to parse trace count aggregation: %w", err)
+		}
+	}
+	return result.Traces.Value, nil
+}
+
+func parseSpan(source map[string]interface{}) traces.Span {
+	span := traces.Span{}
+
+	// Try standard OTEL fields first
+	if traceID, ok := source["traceId"].(string); ok {
+		span.TraceID = traceID
+	}
+	if spanID, ok := source["spanId"].(string); ok {
+		span.SpanID = spanID
+	}
+	if parentSpanID, ok := source["parentSpanId"].(string); ok {
+		span.ParentSpanID = parentSpanID
+	}
+	if name, ok := source["name"].(string); ok {
+		span.Name = name
+	}
+	if kind, ok := source["kind"].(string); ok {
+		span.Kind = kind
+	}
+
+	// Extract component UID from resource
+	if resource, ok := source["resource"].(map[string]interface{}); ok {
+		if componentUid, ok := resource["openchoreo.dev/component-uid"].(string); ok {
+			span.Service = componentUid
+		}
+
+		// Store the complete resource object
+		span.Resource = resource
+	}
+
+	// Parse timestamps
+	if startTime, ok := source["startTime"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, startTime); err == nil {
+			span.StartTime = t
+		}
+	}
+	if endTime, ok := source["endTime"].(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, endTime); err == nil {
+			span.EndTime = t
+		}
+	}
+
+	// Parse duration - try durationInNanos field first
+	if duration, ok := source["durationInNanos"].(float64); ok {
+		span.DurationInNanos = int64(duration)
+	} else if !span.StartTime.IsZero() && !span.EndTime.IsZero() {
+		// Fallback: calculate duration from timestamps if durationInNanos not present
+		span.DurationInNanos = span.EndTime.Sub(span.StartTime).Nanoseconds()
+	}
+
+	// Parse status
+	if status, ok := source["status"].(map[string]interface{}); ok {
+		if code, ok := status["code"].(string); ok {
+			span.Status = code
+		} else if code, ok := status["code"].(float64); ok {
+			span.Status = fmt.Sprintf("%d", int(code))
+		}
+		if message, ok := status["message"].(string); ok {
+			span.StatusMessage = message
+		}
+	}
+
+	// Parse attributes
+	if attributes, ok := source["attributes"].(map[string]interface{}); ok {
+		span.Attributes = attributes
+	}
+
+	// Parse events and links
+	span.Events = parseSpanEvents(source["events"])
+	span.Links = parseSpanLinks(source["links"])
+
+	traces.PopulateAmpAttributes(&span)
+
+	return span
+}
+
+// parseSpanEvents extracts the events of a span from the events array of a source document
+func parseSpanEvents(value interface{}) []traces.SpanEvent {
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil
+	}
+
+	events := make([]traces.SpanEvent, 0, len(items))
+	for _, item := range items {
+		source, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		event := traces.SpanEvent{}
+		if name, ok := source["name"].(string); ok {
+			event.Name = name
+		}
+		if eventTime, ok := source["time"].(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, eventTime); err == nil {
+				event.Time = t
+			}
+		}
+		if attributes, ok := source["attributes"].(map[string]interface{}); ok {
+			event.Attributes = attributes
+		}
+		events = append(events, event)
+	}
+
+	traces.ClassifySpanEvents(events)
+	return events
+}
+
+// parseSpanLinks extracts the links of a span from the links array of a source document
+func parseSpanLinks(value interface{}) []traces.SpanLink {
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil
+	}
+
+	links := make([]traces.SpanLink, 0, len(items))
+	for _, item := range items {
+		source, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		link := traces.SpanLink{}
+		if traceID, ok := source["traceId"].(string); ok {
+			link.TraceID = traceID
+		}
+		if spanID, ok := source["spanId"].(string); ok {
+			link.SpanID = spanID
+		}
+		if attributes, ok := source["attributes"].(map[string]interface{}); ok {
+			link.Attributes = attributes
+		}
+		if link.TraceID != "" {
+			links = append(links, link)
+		}
+	}
+	return links
+}
+
+// spanDocument converts a span to a source document in the shape parseSpan reads
+func spanDocument(span traces.Span) map[string]interface{} {
+	document := map[string]interface{}{
+		"traceId":         span.TraceID,
+		"spanId":          span.SpanID,
+		"parentSpanId":    span.ParentSpanID,
+		"name":            span.Name,
+		"kind":            span.Kind,
+		"startTime":       span.StartTime.UTC().Format(time.RFC3339Nano),
+		"endTime":         span.EndTime.UTC().Format(time.RFC3339Nano),
+		"durationInNanos": span.DurationInNanos,
+		"attributes":      span.Attributes,
+		"resource":        span.Resource,
+	}
+	if span.Status != "" {
+		status := map[string]interface{}{"code": span.Status}
+		if code, err := strconv.Atoi(span.Status); err == nil {
+			status["code"] = code
+		}
+		if span.StatusMessage != "" {
+			status["message"] = span.StatusMessage
+		}
+		document["status"] = status
+	}
+	if len(span.Events) > 0 {
+		events := make([]map[string]interface{}, 0, len(span.Events))
+		for _, event := range span.Events {
+			events = append(events, map[string]interface{}{
+				"name":       event.Name,
+				"time":       event.Time.UTC().Format(time.RFC3339Nano),
+				"attributes": event.Attributes,
+			})
+		}
+		document["events"] = events
+	}
+	if len(span.Links) > 0 {
+		links := make([]map[string]interface{}, 0, len(span.Links))
+		for _, link := range span.Links {
+			links = append(links, map[string]interface{}{
+				"traceId":    link.TraceID,
+				"spanId":     link.SpanID,
+				"attributes": link.Attributes,
+			})
+		}
+		document["links"] = links
+	}
+	return document
+}
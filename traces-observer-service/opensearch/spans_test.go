@@ -0,0 +1,70 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+func TestParseTraceCount(t *testing.T) {
+	tests := []struct {
+		name         string
+		aggregations string
+		expected     int
+		wantErr      bool
+	}{
+		{name: "traces aggregation", aggregations: `{"traces":{"value":42}}`, expected: 42},
+		{name: "no aggregations", aggregations: "", expected: 0},
+		{name: "malformed aggregations", aggregations: `{"traces":{"value":"many"}}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &SearchResponse{}
+			if tt.aggregations != "" {
+				response.Aggregations = json.RawMessage(tt.aggregations)
+			}
+			// The number of root span hits must not be used, a trace may have several root spans
+			response.Hits.Total.Value = 50
+
+			count, err := ParseTraceCount(response)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTraceCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if count != tt.expected {
+				t.Errorf("ParseTraceCount() = %d, want %d", count, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildRootSpanQueryCountsTraces(t *testing.T) {
+	query := BuildRootSpanQuery(traces.TraceQueryParams{ComponentUids: []string{"component-1"}}, nil)
+	aggs, ok := query["aggs"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("query has no aggregations: %v", query)
+	}
+	expected := map[string]interface{}{
+		"cardinality": map[string]interface{}{"field": "traceId", "precision_threshold": 40000},
+	}
+	if !reflect.DeepEqual(aggs["traces"], expected) {
+		t.Errorf("traces aggregation = %v, want %v", aggs["traces"], expected)
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+const (
+	// spanBatchSize is the number of spans read per request when scanning spans in batches
+	spanBatchSize = 1000
+	// maxTraceSpanBatches bounds the number of batches read when loading the spans of traces
+	maxTraceSpanBatches = 50
+	// maxSpanFilterBatches bounds the number of batches of spans scanned when applying span filters
+	maxSpanFilterBatches = 50
+	// maxSessionSpanBatches bounds the number of batches of spans scanned when grouping traces into sessions
+	maxSessionSpanBatches = 50
+)
+
+// Store is a trace store backed by the OpenSearch trace indices
+type Store struct {
+	client  *Client
+	indices *IndexPattern
+}
+
+var _ traces.TraceStore = (*Store)(nil)
+
+// NewStore creates a trace store that searches the indices of the pattern with the given client
+func NewStore(client *Client, indices *IndexPattern) *Store {
+	return &Store{client: client, indices: indices}
+}
+
+// FindRootSpans returns a page of root spans, see traces.TraceStore
+func (s *Store) FindRootSpans(ctx context.Context, params traces.TraceQueryParams) (*traces.RootSpanPage, error) {
+	var searchAfter []json.RawMessage
+	if params.Cursor != "" {
+		var err error
+		searchAfter, err = DecodeTraceCursor(params.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", traces.ErrInvalidCursor, err)
+		}
+	}
+
+	indices, err := s.indicesForTimeRange(ctx, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one root span more than requested to know whether there is a next page
+	pageLimit := params.Limit
+	params.Limit = pageLimit + 1
+	response, err := s.client.Search(ctx, indices, BuildRootSpanQuery(params, searchAfter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search root spans: %w", err)
+	}
+
+	totalCount, err := ParseTraceCount(response)
+	if err != nil {
+		return nil, err
+	}
+	page := &traces.RootSpanPage{
+		Spans:      ParseSpans(response),
+		TotalCount: totalCount,
+	}
+	if len(page.Spans) > pageLimit {
+		page.Spans = page.Spans[:pageLimit]
+		page.NextCursor, err = EncodeTraceCursor(response.Hits.Hits[pageLimit-1].Sort)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create next cursor: %w", err)
+		}
+	}
+
+	return page, nil
+}
+
+// FindTraceIDsBySpanFilters returns the traces that have a span matching the span filters, see traces.TraceStore.
+// Model, tool name and attribute filters are applied by the query and the remaining filters to the spans found.
+// The matches are truncated when more than limit traces match or the span limit is reached first.
+func (s *Store) FindTraceIDsBySpanFilters(ctx context.Context, params traces.TraceQueryParams, limit int) (*traces.SpanFilterMatches, error) {
+	log := logger.GetLogger(ctx)
+
+	indices, err := s.indicesForTimeRange(ctx, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := &traces.SpanFilterMatches{TraceIDs: []string{}}
+	seen := make(map[string]bool)
+
+	var searchAfter []json.RawMessage
+	for batch := 0; batch < maxSpanFilterBatches; batch++ {
+		query := BuildSpanFilterQuery(params, spanBatchSize, searchAfter)
+		response, err := s.client.Search(ctx, indices, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search spans matching filters: %w", err)
+		}
+
+		for _, span := range ParseSpans(response) {
+			if seen[span.TraceID] || !params.Filters.MatchesSpan(span) {
+				continue
+			}
+			if len(matches.TraceIDs) == limit {
+				log.Warn("Trace limit reached while applying span filters, results are incomplete",
+					"max_traces", limit)
+				matches.Truncated = true
+				return matches, nil
+			}
+			seen[span.TraceID] = true
+			matches.TraceIDs = append(matches.TraceIDs, span.TraceID)
+		}
+
+		hits := response.Hits.Hits
+		if len(hits) < spanBatchSize {
+			return matches, nil
+		}
+		searchAfter = hits[len(hits)-1].Sort
+	}
+
+	log.Warn("Span limit reached while applying span filters, results may be incomplete",
+		"max_spans", maxSpanFilterBatches*spanBatchSize)
+	matches.Truncated = true
+	return matches, nil
+}
+
+// GetTraceSpans loads the spans of the given traces in batches and groups them by trace ID
+func (s *Store) GetTraceSpans(ctx context.Context, traceIDs []string, params traces.TraceQueryParams) (*traces.TraceSpans, error) {
+	traceSpans := &traces.TraceSpans{Spans: make(map[string][]traces.Span, len(traceIDs))}
+	if len(traceIDs) == 0 {
+		return traceSpans, nil
+	}
+
+	indices, err := s.indicesForTimeRange(ctx, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchAfter []json.RawMessage
+	for batch := 0; batch < maxTraceSpanBatches; batch++ {
+		query := BuildTraceSpansQuery(traceIDs, params, spanBatchSize, searchAfter)
+		response, err := s.client.Search(ctx, indices, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search trace spans: %w", err)
+		}
+
+		for _, span := range ParseSpans(response) {
+			traceSpans.Spans[span.TraceID] = append(traceSpans.Spans[span.TraceID], span)
+		}
+
+		hits := response.Hits.Hits
+		if len(hits) < spanBatchSize {
+			return traceSpans, nil
+		}
+		searchAfter = hits[len(hits)-1].Sort
+	}
+
+	logger.GetLogger(ctx).Warn("Span limit reached while loading traces, summaries may be incomplete",
+		"traces", len(traceIDs), "max_spans", maxTraceSpanBatches*spanBatchSize)
+	traceSpans.Truncated = true
+	return traceSpans, nil
+}
+
+// GetTrace returns the spans of a trace from the indices of the time range in params
+func (s *Store) GetTrace(ctx context.Context, params traces.TraceByIdAndServiceParams) ([]traces.Span, error) {
+	indices, err := s.indicesForTimeRange(ctx, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Search(ctx, indices, BuildTraceByIdAndServiceQuery(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+
+	return ParseSpans(response), nil
+}
+
+// FindSessionSpans reads the spans that carry a session ID in batches, see traces.TraceStore
+func (s *Store) FindSessionSpans(ctx context.Context, params traces.SessionQueryParams, sessionAttributes []string) (*traces.SessionSpans, error) {
+	indices, err := s.indicesForTimeRange(ctx, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	found := &traces.SessionSpans{Spans: []traces.Span{}}
+	var searchAfter []json.RawMessage
+	for batch := 0; batch < maxSessionSpanBatches; batch++ {
+		query := BuildSessionSpansQuery(params, sessionAttributes, spanBatchSize, searchAfter)
+		response, err := s.client.Search(ctx, indices, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search session spans: %w", err)
+		}
+
+		found.Spans = append(found.Spans, ParseSpans(response)...)
+
+		hits := response.Hits.Hits
+		if len(hits) < spanBatchSize {
+			return found, nil
+		}
+		searchAfter = hits[len(hits)-1].Sort
+	}
+
+	logger.GetLogger(ctx).Warn("Span limit reached while grouping sessions, results may be incomplete",
+		"max_spans", maxSessionSpanBatches*spanBatchSize)
+	found.Truncated = true
+	return found, nil
+}
+
+// GetMetrics aggregates the metrics of a component with a single aggregation query
+func (s *Store) GetMetrics(ctx context.Context, params traces.MetricsQueryParams) (*traces.MetricsResponse, error) {
+	indices, err := s.indicesForTimeRange(ctx,
+		params.StartTime.UTC().Format(time.RFC3339),
+		params.EndTime.UTC().Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Search(ctx, indices, BuildMetricsQuery(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search metrics: %w", err)
+	}
+
+	return ParseMetrics(response, params.Interval)
+}
+
+// GetTokenUsage aggregates the daily token usage of components with a single aggregation query
+func (s *Store) GetTokenUsage(ctx context.Context, params traces.TokenUsageQueryParams) (*traces.TokenUsageResponse, error) {
+	indices, err := s.indicesForTimeRange(ctx,
+		params.StartTime.UTC().Format(time.RFC3339),
+		params.EndTime.UTC().Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Search(ctx, indices, BuildTokenUsageQuery(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search token usage: %w", err)
+	}
+
+	return ParseTokenUsage(response)
+}
+
+// WriteSpans indexes spans into the index of their start time. Data streams require
+// a @timestamp field, which is set to the start time when the indices are not time based.
+// Spans are identified by their trace and span IDs, so exporter retries do not duplicate them.
+func (s *Store) WriteSpans(ctx context.Context, spans []traces.Span) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	documents := make([]BulkDocument, 0, len(spans))
+	for _, span := range spans {
+		document := spanDocument(span)
+		if !s.indices.IsTimeBased() {
+			document["@timestamp"] = document["startTime"]
+		}
+		documents = append(documents, BulkDocument{
+			Index:  s.indices.IndexForTime(span.StartTime),
+			ID:     span.TraceID + span.SpanID,
+			Source: document,
+		})
+	}
+	if err := s.client.BulkIndex(ctx, documents); err != nil {
+		return fmt.Errorf("failed to write spans: %w", err)
+	}
+	return nil
+}
+
+// HealthCheck checks if OpenSearch is accessible
+func (s *Store) HealthCheck(ctx context.Context) error {
+	return s.client.HealthCheck(ctx)
+}
+
+// indicesForTimeRange returns the indices covering a time range
+func (s *Store) indicesForTimeRange(ctx context.Context, startTime, endTime string) ([]string, error) {
+	indices, err := s.indices.IndicesForTimeRange(startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+	logger.GetLogger(ctx).Debug("Searching indices", "indices", indices)
+	return indices, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// maxTokenUsageTerms is the maximum number of vendors and models aggregated per component and day
+const maxTokenUsageTerms = 100
+
+// BuildTokenUsageQuery builds an aggregation query for the daily token usage of components.
+// Usage is bucketed by component, UTC day, vendor and model. The response and request model
+// are aggregated separately and combined by ParseTokenUsage, preferring the response model
+// like the span processing does.
+func BuildTokenUsageQuery(params traces.TokenUsageQueryParams) map[string]interface{} {
+	mustConditions := buildTraceFilters(traces.TraceQueryParams{
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.StartTime.UTC().Format(time.RFC3339Nano),
+		EndTime:        params.EndTime.UTC().Format(time.RFC3339Nano),
+	})
+	mustConditions = append(mustConditions,
+		map[string]interface{}{
+			"terms": map[string]interface{}{
+				"resource.openchoreo.dev/component-uid": params.ComponentUids,
+			},
+		},
+		map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{"exists": map[string]interface{}{"field": "attributes.gen_ai.response.model"}},
+					{"exists": map[string]interface{}{"field": "attributes.gen_ai.request.model"}},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+	)
+
+	return map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": mustConditions,
+			},
+		},
+		"size": 0,
+		"aggs": map[string]interface{}{
+			"by_component": map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "resource.openchoreo.dev/component-uid",
+					"size":  len(params.ComponentUids),
+				},
+				"aggs": map[string]interface{}{
+					"by_day": map[string]interface{}{
+						"date_histogram": map[string]interface{}{
+							"field":             "startTime",
+							"calendar_interval": "1d",
+							"time_zone":         "UTC",
+							"min_doc_count":     1,
+						},
+						"aggs": map[string]interface{}{
+							"by_vendor": termsAggregation("attributes.gen_ai.system", map[string]interface{}{
+								"by_response_model": termsAggregation("attributes.gen_ai.response.model", map[string]interface{}{
+									"by_request_model": termsAggregation("attributes.gen_ai.request.model", buildTokenAggregations()),
+								}),
+							}),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// termsAggregation builds a terms aggregation that puts documents without the field in a bucket with an empty key
+func termsAggregation(field string, aggs map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"terms": map[string]interface{}{
+			"field":   field,
+			"size":    maxTokenUsageTerms,
+			"missing": "",
+		},
+		"aggs": aggs,
+	}
+}
+
+// tokenUsageAggregationResult is the result of the aggregations built by BuildTokenUsageQuery
+type tokenUsageAggregationResult struct {
+	ByComponent struct {
+		Buckets []struct {
+			Key   string `json:"key"`
+			ByDay struct {
+				Buckets []struct {
+					Key      int64 `json:"key"`
+					ByVendor struct {
+						Buckets []struct {
+							Key             string `json:"key"`
+							ByResponseModel struct {
+								Buckets []struct {
+									Key            string `json:"key"`
+									ByRequestModel struct {
+										Buckets []struct {
+											Key string `json:"key"`
+											tokenAggregations
+										} `json:"buckets"`
+									} `json:"by_request_model"`
+								} `json:"buckets"`
+							} `json:"by_response_model"`
+						} `json:"buckets"`
+					} `json:"by_vendor"`
+				} `json:"buckets"`
+			} `json:"by_day"`
+		} `json:"buckets"`
+	} `json:"by_component"`
+}
+
+// ParseTokenUsage converts the aggregations of a token usage query into a token usage response
+func ParseTokenUsage(response *SearchResponse) (*traces.TokenUsageResponse, error) {
+	var result tokenUsageAggregationResult
+	if len(response.Aggregations) > 0 {
+		if err := json.Unmarshal(response.Aggregations, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse token usage aggregations: %w", err)
+		}
+	}
+
+	type usageKey struct {
+		componentUid string
+		date         string
+		vendor       string
+		model        string
+	}
+	usageByKey := make(map[usageKey]*traces.DailyTokenUsage)
+
+	for _, component := range result.ByComponent.Buckets {
+		for _, day := range component.ByDay.Buckets {
+			date := time.UnixMilli(day.Key).UTC().Format(time.DateOnly)
+			for _, vendor := range day.ByVendor.Buckets {
+				for _, responseModel := range vendor.ByResponseModel.Buckets {
+					for _, requestModel := range responseModel.ByRequestModel.Buckets {
+						model := responseModel.Key
+						if model == "" {
+							model = requestModel.Key
+						}
+						inputTokens, outputTokens := requestModel.tokens()
+						if model == "" || inputTokens+outputTokens == 0 {
+							continue
+						}
+
+						key := usageKey{componentUid: component.Key, date: date, vendor: vendor.Key, model: model}
+						usage, ok := usageByKey[key]
+						if !ok {
+							usage = &traces.DailyTokenUsage{
+								ComponentUid:    component.Key,
+								Date:            date,
+								ModelTokenUsage: traces.ModelTokenUsage{Vendor: vendor.Key, Model: model},
+							}
+							usageByKey[key] = usage
+						}
+						usage.InputTokens += inputTokens
+						usage.OutputTokens += outputTokens
+						usage.TotalTokens += inputTokens + outputTokens
+					}
+				}
+			}
+		}
+	}
+
+	usage := make([]traces.DailyTokenUsage, 0, len(usageByKey))
+	for _, entry := range usageByKey {
+		usage = append(usage, *entry)
+	}
+	traces.SortDailyTokenUsage(usage)
+
+	return &traces.TokenUsageResponse{Usage: usage}, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// Resource attributes that identify the component (agent) and environment of a span
+const (
+	ComponentUidResource   = "openchoreo.dev/component-uid"
+	EnvironmentUidResource = "openchoreo.dev/environment-uid"
+)
+
+// ConvertSpans converts the spans of an export request to the span model of the trace store.
+// Resource attributes are kept flat under Resource, so openchoreo.dev/component-uid and
+// openchoreo.dev/environment-uid scope the spans like the ones written by the collector.
+func ConvertSpans(request *coltracepb.ExportTraceServiceRequest) []traces.Span {
+	spans := []traces.Span{}
+	for _, resourceSpans := range request.GetResourceSpans() {
+		resource := attributesToMap(resourceSpans.GetResource().GetAttributes())
+		componentUid, _ := resource[ComponentUidResource].(string)
+
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			for _, span := range scopeSpans.GetSpans() {
+				startTime := time.Unix(0, int64(span.GetStartTimeUnixNano())).UTC()
+				endTime := time.Unix(0, int64(span.GetEndTimeUnixNano())).UTC()
+
+				spans = append(spans, traces.Span{
+					TraceID:         hex.EncodeToString(span.GetTraceId()),
+					SpanID:          hex.EncodeToString(span.GetSpanId()),
+					ParentSpanID:    hex.EncodeToString(span.GetParentSpanId()),
+					Name:            span.GetName(),
+					Service:         componentUid,
+					StartTime:       startTime,
+					EndTime:         endTime,
+					DurationInNanos: endTime.Sub(startTime).Nanoseconds(),
+					Kind:            span.GetKind().String(),
+					Status:          strconv.Itoa(int(span.GetStatus().GetCode())),
+					StatusMessage:   span.GetStatus().GetMessage(),
+					Attributes:      attributesToMap(span.GetAttributes()),
+					Resource:        resource,
+					Events:          convertEvents(span.GetEvents()),
+					Links:           convertLinks(span.GetLinks()),
+				})
+			}
+		}
+	}
+	return spans
+}
+
+// convertEvents converts the events of an OTLP span
+func convertEvents(events []*tracepb.Span_Event) []traces.SpanEvent {
+	if len(events) == 0 {
+		return nil
+	}
+
+	result := make([]traces.SpanEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, traces.SpanEvent{
+			Name:       event.GetName(),
+			Time:       time.Unix(0, int64(event.GetTimeUnixNano())).UTC(),
+			Attributes: attributesToMap(event.GetAttributes()),
+		})
+	}
+	traces.ClassifySpanEvents(result)
+	return result
+}
+
+// convertLinks converts the links of an OTLP span, with hex encoded IDs like the spans
+func convertLinks(links []*tracepb.Span_Link) []traces.SpanLink {
+	if len(links) == 0 {
+		return nil
+	}
+
+	result := make([]traces.SpanLink, 0, len(links))
+	for _, link := range links {
+		result = append(result, traces.SpanLink{
+			TraceID:    hex.EncodeToString(link.GetTraceId()),
+			SpanID:     hex.EncodeToString(link.GetSpanId()),
+			Attributes: attributesToMap(link.GetAttributes()),
+		})
+	}
+	return result
+}
+
+// attributesToMap converts OTLP attributes to a map with JSON compatible values
+func attributesToMap(attributes []*commonpb.KeyValue) map[string]interface{} {
+	result := make(map[string]interface{}, len(attributes))
+	for _, attribute := range attributes {
+		result[attribute.GetKey()] = anyValue(attribute.GetValue())
+	}
+	return result
+}
+
+// anyValue converts an OTLP attribute value. Integers become float64 and bytes a base64 string,
+// as they would after a round trip through the JSON documents of the trace store.
+func anyValue(value *commonpb.AnyValue) interface{} {
+	switch v := value.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return v.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return v.BoolValue
+	case *commonpb.AnyValue_IntValue:
+		return float64(v.IntValue)
+	case *commonpb.AnyValue_DoubleValue:
+		return v.DoubleValue
+	case *commonpb.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case *commonpb.AnyValue_ArrayValue:
+		values := make([]interface{}, 0, len(v.ArrayValue.GetValues()))
+		for _, item := range v.ArrayValue.GetValues() {
+			values = append(values, anyValue(item))
+		}
+		return values
+	case *commonpb.AnyValue_KvlistValue:
+		return attributesToMap(v.KvlistValue.GetValues())
+	default:
+		return nil
+	}
+}
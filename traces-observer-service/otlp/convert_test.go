@@ -0,0 +1,141 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+func stringValue(value string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}
+}
+
+func TestConvertSpans(t *testing.T) {
+	request := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: &resourcepb.Resource{Attributes: []*commonpb.KeyValue{
+				{Key: ComponentUidResource, Value: stringValue("component-1")},
+				{Key: EnvironmentUidResource, Value: stringValue("env-1")},
+			}},
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: []*tracepb.Span{{
+					TraceId:           []byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c},
+					SpanId:            []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x75},
+					ParentSpanId:      []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+					Name:              "execute_tool search",
+					Kind:              tracepb.Span_SPAN_KIND_CLIENT,
+					StartTimeUnixNano: 1700000000000000000,
+					EndTimeUnixNano:   1700000000250000000,
+					Status:            &tracepb.Status{Code: tracepb.Status_STATUS_CODE_ERROR, Message: "timeout"},
+					Attributes: []*commonpb.KeyValue{
+						{Key: "gen_ai.tool.name", Value: stringValue("search")},
+						{Key: "retries", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 3}}},
+						{Key: "score", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 0.5}}},
+						{Key: "cached", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: false}}},
+						{Key: "payload", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: []byte("hi")}}},
+						{Key: "tags", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{
+							Values: []*commonpb.AnyValue{stringValue("a"), stringValue("b")},
+						}}}},
+						{Key: "request", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{
+							Values: []*commonpb.KeyValue{{Key: "query", Value: stringValue("weather")}},
+						}}}},
+					},
+					Events: []*tracepb.Span_Event{{
+						Name:         "exception",
+						TimeUnixNano: 1700000000200000000,
+						Attributes:   []*commonpb.KeyValue{{Key: "exception.message", Value: stringValue("timeout")}},
+					}},
+					Links: []*tracepb.Span_Link{{
+						TraceId: []byte{0x0a, 0xf7, 0x65, 0x19, 0x16, 0xcd, 0x43, 0xdd, 0x84, 0x48, 0xeb, 0x21, 0x1c, 0x80, 0x31, 0x9c},
+						SpanId:  []byte{0xb7, 0xad, 0x6b, 0x71, 0x69, 0x20, 0x33, 0x31},
+					}},
+				}},
+			}},
+		}},
+	}
+
+	spans := ConvertSpans(request)
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+
+	start := time.Unix(0, 1700000000000000000).UTC()
+	expected := traces.Span{
+		TraceID:         "5b8efff798038103d269b633813fc60c",
+		SpanID:          "eee19b7ec3c1b175",
+		ParentSpanID:    "eee19b7ec3c1b174",
+		Name:            "execute_tool search",
+		Service:         "component-1",
+		StartTime:       start,
+		EndTime:         start.Add(250 * time.Millisecond),
+		DurationInNanos: int64(250 * time.Millisecond),
+		Kind:            "SPAN_KIND_CLIENT",
+		Status:          "2",
+		StatusMessage:   "timeout",
+		Attributes: map[string]interface{}{
+			"gen_ai.tool.name": "search",
+			"retries":          float64(3),
+			"score":            0.5,
+			"cached":           false,
+			"payload":          "aGk=",
+			"tags":             []interface{}{"a", "b"},
+			"request":          map[string]interface{}{"query": "weather"},
+		},
+		Resource: map[string]interface{}{
+			ComponentUidResource:   "component-1",
+			EnvironmentUidResource: "env-1",
+		},
+		Events: []traces.SpanEvent{{
+			Name:       "exception",
+			Time:       start.Add(200 * time.Millisecond),
+			Kind:       traces.SpanEventKindException,
+			Attributes: map[string]interface{}{"exception.message": "timeout"},
+		}},
+		Links: []traces.SpanLink{{
+			TraceID:    "0af7651916cd43dd8448eb211c80319c",
+			SpanID:     "b7ad6b7169203331",
+			Attributes: map[string]interface{}{},
+		}},
+	}
+	if !reflect.DeepEqual(spans[0], expected) {
+		t.Errorf("span = %+v\nwant %+v", spans[0], expected)
+	}
+}
+
+func TestConvertSpansOfRootSpan(t *testing.T) {
+	request, _ := testRequest()
+	spans := ConvertSpans(request)
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	// A span without a parent is a root span, and spans without events or links have none
+	if spans[0].ParentSpanID != "" || spans[0].Events != nil || spans[0].Links != nil {
+		t.Errorf("span = %+v, want a root span without events or links", spans[0])
+	}
+	if spans[0].Status != "0" {
+		t.Errorf("status = %q, want the unset status code 0", spans[0].Status)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package otlp decodes OTLP/HTTP trace export requests into spans
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// OTLP/HTTP content types
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+// ErrUnsupportedContentType is returned for a request that is neither protobuf nor JSON encoded
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+// MediaType returns the OTLP encoding of a Content-Type header value
+func MediaType(contentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || (mediaType != ContentTypeProtobuf && mediaType != ContentTypeJSON) {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedContentType, contentType)
+	}
+	return mediaType, nil
+}
+
+// DecodeTraces decodes an export request body in the given OTLP encoding
+func DecodeTraces(body []byte, mediaType string) (*coltracepb.ExportTraceServiceRequest, error) {
+	request := &coltracepb.ExportTraceServiceRequest{}
+	switch mediaType {
+	case ContentTypeProtobuf:
+		if err := proto.Unmarshal(body, request); err != nil {
+			return nil, fmt.Errorf("failed to decode protobuf request: %w", err)
+		}
+	case ContentTypeJSON:
+		body, err := hexIDsToBase64(body)
+		if err != nil {
+			return nil, err
+		}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, request); err != nil {
+			return nil, fmt.Errorf("failed to decode JSON request: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedContentType, mediaType)
+	}
+	return request, nil
+}
+
+// EncodeResponse encodes an empty (fully successful) export response in the given OTLP encoding
+func EncodeResponse(mediaType string) ([]byte, error) {
+	response := &coltracepb.ExportTraceServiceResponse{}
+	if mediaType == ContentTypeJSON {
+		return protojson.Marshal(response)
+	}
+	return proto.Marshal(response)
+}
+
+// hexIDsToBase64 rewrites the trace and span IDs of an OTLP/JSON request, which are hex encoded,
+// to the base64 encoding that the protobuf JSON mapping uses for bytes fields
+func hexIDsToBase64(body []byte) ([]byte, error) {
+	var request map[string]interface{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON request: %w", err)
+	}
+
+	for _, resourceSpans := range objects(request["resourceSpans"]) {
+		for _, scopeSpans := range objects(resourceSpans["scopeSpans"]) {
+			for _, span := range objects(scopeSpans["spans"]) {
+				if err := convertIDs(span, "traceId", "spanId", "parentSpanId"); err != nil {
+					return nil, err
+				}
+				for _, link := range objects(span["links"]) {
+					if err := convertIDs(link, "traceId", "spanId"); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	return json.Marshal(request)
+}
+
+// objects returns the JSON objects of an array, skipping other values
+func objects(value interface{}) []map[string]interface{} {
+	items, _ := value.([]interface{})
+	result := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if object, ok := item.(map[string]interface{}); ok {
+			result = append(result, object)
+		}
+	}
+	return result
+}
+
+func convertIDs(object map[string]interface{}, keys ...string) error {
+	for _, key := range keys {
+		id, ok := object[key].(string)
+		if !ok || id == "" {
+			continue
+		}
+		decoded, err := hex.DecodeString(id)
+		if err != nil {
+			return fmt.Errorf("failed to decode JSON request: %s is not hex encoded: %w", key, err)
+		}
+		object[key] = base64.StdEncoding.EncodeToString(decoded)
+	}
+	return nil
+}
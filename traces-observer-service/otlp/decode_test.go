@@ -0,0 +1,166 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHexIDsToBase64(t *testing.T) {
+	body := `{"resourceSpans":[{"scopeSpans":[{"spans":[{` +
+		`"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174","parentSpanId":"",` +
+		`"name":"chat","links":[{"traceId":"0af7651916cd43dd8448eb211c80319c","spanId":"b7ad6b7169203331"}]}]}]}]}`
+
+	converted, err := hexIDsToBase64([]byte(body))
+	if err != nil {
+		t.Fatalf("hexIDsToBase64() error = %v", err)
+	}
+
+	var request struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []map[string]interface{} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	if err := json.Unmarshal(converted, &request); err != nil {
+		t.Fatalf("converted request is not valid JSON: %v", err)
+	}
+	span := request.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	expected := map[string]interface{}{
+		"traceId":      "W47/95gDgQPSabYzgT/GDA==",
+		"spanId":       "7uGbfsPBsXQ=",
+		"parentSpanId": "",
+		"name":         "chat",
+		"links": []interface{}{map[string]interface{}{
+			"traceId": "CvdlGRbNQ92ESOshHIAxnA==",
+			"spanId":  "t61rcWkgMzE=",
+		}},
+	}
+	if !reflect.DeepEqual(span, expected) {
+		t.Errorf("span = %v, want %v", span, expected)
+	}
+}
+
+func TestHexIDsToBase64Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{name: "invalid JSON", body: `{"resourceSpans":`, expected: "failed to decode JSON request"},
+		{name: "span ID that is not hex", body: `{"resourceSpans":[{"scopeSpans":[{"spans":[{"spanId":"not-hex"}]}]}]}`, expected: "spanId is not hex encoded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := hexIDsToBase64([]byte(tt.body))
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("hexIDsToBase64() error = %v, want %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+// testRequest returns an export request with one span, and the same request in OTLP/JSON
+func testRequest() (*coltracepb.ExportTraceServiceRequest, string) {
+	request := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: &resourcepb.Resource{Attributes: []*commonpb.KeyValue{{
+				Key:   ComponentUidResource,
+				Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "component-1"}},
+			}}},
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: []*tracepb.Span{{
+					TraceId:           []byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c},
+					SpanId:            []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+					Name:              "chat",
+					StartTimeUnixNano: 1700000000000000000,
+					EndTimeUnixNano:   1700000001000000000,
+				}},
+			}},
+		}},
+	}
+	body := `{"resourceSpans":[{"resource":{"attributes":[` +
+		`{"key":"openchoreo.dev/component-uid","value":{"stringValue":"component-1"}}]},` +
+		`"scopeSpans":[{"spans":[{"traceId":"5b8efff798038103d269b633813fc60c","spanId":"eee19b7ec3c1b174",` +
+		`"name":"chat","startTimeUnixNano":"1700000000000000000","endTimeUnixNano":"1700000001000000000"}]}]}]}`
+	return request, body
+}
+
+func TestDecodeTraces(t *testing.T) {
+	expected, jsonBody := testRequest()
+	protobufBody, err := proto.Marshal(expected)
+	if err != nil {
+		t.Fatalf("failed to encode protobuf request: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		body      []byte
+		mediaType string
+	}{
+		{name: "protobuf", body: protobufBody, mediaType: ContentTypeProtobuf},
+		{name: "JSON with hex encoded IDs", body: []byte(jsonBody), mediaType: ContentTypeJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := DecodeTraces(tt.body, tt.mediaType)
+			if err != nil {
+				t.Fatalf("DecodeTraces() error = %v", err)
+			}
+			if !proto.Equal(request, expected) {
+				t.Errorf("request = %v, want %v", request, expected)
+			}
+		})
+	}
+
+	if _, err := DecodeTraces([]byte(jsonBody), ContentTypeProtobuf); err == nil {
+		t.Error("DecodeTraces() of a JSON body as protobuf should fail")
+	}
+	if _, err := DecodeTraces(protobufBody, "text/plain"); !errors.Is(err, ErrUnsupportedContentType) {
+		t.Errorf("DecodeTraces() error = %v, want %v", err, ErrUnsupportedContentType)
+	}
+}
+
+func TestMediaType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		expected    string
+		wantErr     bool
+	}{
+		{contentType: "application/x-protobuf", expected: ContentTypeProtobuf},
+		{contentType: "application/json; charset=utf-8", expected: ContentTypeJSON},
+		{contentType: "text/plain", wantErr: true},
+		{contentType: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		mediaType, err := MediaType(tt.contentType)
+		if (err != nil) != tt.wantErr || mediaType != tt.expected {
+			t.Errorf("MediaType(%q) = %q, %v", tt.contentType, mediaType, err)
+		}
+	}
+}
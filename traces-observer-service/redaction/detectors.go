@@ -0,0 +1,180 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redaction
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Built-in detector names
+const (
+	DetectorEmail      = "email"
+	DetectorPhone      = "phone"
+	DetectorCreditCard = "credit_card"
+	DetectorIBAN       = "iban"
+)
+
+// detector finds one kind of sensitive value in text
+type detector struct {
+	name    string
+	pattern *regexp.Regexp
+	valid   func(match string) bool // Optional check of a match, e.g. a checksum, to avoid false positives
+	exclude *regexp.Regexp          // Optional pattern of values whose parts must not be matched, e.g. IP addresses
+}
+
+// builtinDetectors are applied in this order, so that card numbers and IBANs are
+// replaced before the phone detector can match parts of them
+var builtinDetectors = []*detector{
+	{
+		name: DetectorCreditCard,
+		// 13 to 19 digits of the major card networks, optionally grouped with spaces or dashes
+		pattern: regexp.MustCompile(`\b[2-6](?:[ -]?\d){12,18}\b`),
+		valid:   luhnValid,
+	},
+	{
+		name:    DetectorIBAN,
+		pattern: regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`),
+		valid:   ibanValid,
+	},
+	{
+		name:    DetectorEmail,
+		pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+	},
+	{
+		name: DetectorPhone,
+		// Grouped numbers such as +1 (555) 123-4567 or 555.123.4567, or a compact international number
+		pattern: regexp.MustCompile(`(?:\+\d{1,3}[ .\-]?)?(?:\(\d{1,4}\)[ .\-]?|\b\d{2,4}[ .\-])\d{3,4}[ .\-]\d{3,4}\b|\+\d{8,15}\b`),
+		valid: func(match string) bool {
+			digits := countDigits(match)
+			return digits >= 7 && digits <= 15
+		},
+		// Parts of IPv4 addresses such as 192.168.100.200 look like grouped phone numbers
+		exclude: regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}\b`),
+	},
+}
+
+// IsBuiltinDetector reports whether name is the name of a built-in detector
+func IsBuiltinDetector(name string) bool {
+	for _, d := range builtinDetectors {
+		if d.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// replace replaces the valid matches of the detector in text with a marker naming the detector,
+// returning the new text and the number of replaced matches
+func (d *detector) replace(text string) (string, int) {
+	var excluded [][]int
+	if d.exclude != nil {
+		excluded = d.exclude.FindAllStringIndex(text, -1)
+	}
+
+	var result strings.Builder
+	count := 0
+	last := 0
+	for _, loc := range d.pattern.FindAllStringIndex(text, -1) {
+		match := text[loc[0]:loc[1]]
+		if overlaps(excluded, loc) || (d.valid != nil && !d.valid(match)) {
+			continue
+		}
+		result.WriteString(text[last:loc[0]])
+		result.WriteString(marker(d.name))
+		last = loc[1]
+		count++
+	}
+	if count == 0 {
+		return text, 0
+	}
+	result.WriteString(text[last:])
+	return result.String(), count
+}
+
+// overlaps reports whether the range loc overlaps one of the ranges
+func overlaps(ranges [][]int, loc []int) bool {
+	for _, r := range ranges {
+		if loc[0] < r[1] && r[0] < loc[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// marker is the text that takes the place of a redacted value. It contains no quotes or
+// backslashes, so values redacted inside JSON encoded attributes keep the JSON valid.
+func marker(name string) string {
+	return "[REDACTED:" + name + "]"
+}
+
+func countDigits(s string) int {
+	count := 0
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			count++
+		}
+	}
+	return count
+}
+
+// luhnValid checks the Luhn checksum of the digits of a card number
+func luhnValid(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// ibanValid checks the length and the ISO 13616 mod 97 checksum of an IBAN
+func ibanValid(iban string) bool {
+	iban = strings.ReplaceAll(iban, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+	// Move the country code and check digits to the end and convert letters to numbers (A=10 ... Z=35)
+	var digits strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+	value, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(value, big.NewInt(97)).Int64() == 1
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redaction
+
+import "testing"
+
+func TestLuhnValid(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected bool
+	}{
+		{number: "4111111111111111", expected: true},
+		{number: "4111 1111 1111 1111", expected: true},
+		{number: "5500-0000-0000-0004", expected: true},
+		{number: "378282246310005", expected: true},
+		{number: "4111111111111112", expected: false},
+		{number: "1234567812345678", expected: false},
+	}
+	for _, tt := range tests {
+		if got := luhnValid(tt.number); got != tt.expected {
+			t.Errorf("luhnValid(%q) = %v, want %v", tt.number, got, tt.expected)
+		}
+	}
+}
+
+func TestIbanValid(t *testing.T) {
+	tests := []struct {
+		iban     string
+		expected bool
+	}{
+		{iban: "GB82WEST12345698765432", expected: true},
+		{iban: "GB82 WEST 1234 5698 7654 32", expected: true},
+		{iban: "DE89370400440532013000", expected: true},
+		{iban: "NO9386011117947", expected: true},
+		{iban: "GB82WEST12345698765433", expected: false}, // wrong check digits
+		{iban: "GB82WEST1234", expected: false},           // too short
+		{iban: "DE89370400440532013000123456789012345", expected: false},
+	}
+	for _, tt := range tests {
+		if got := ibanValid(tt.iban); got != tt.expected {
+			t.Errorf("ibanValid(%q) = %v, want %v", tt.iban, got, tt.expected)
+		}
+	}
+}
+
+func TestDetectorReplace(t *testing.T) {
+	tests := []struct {
+		name          string
+		detector      string
+		text          string
+		expected      string
+		expectedCount int
+	}{
+		{
+			name:          "email",
+			detector:      DetectorEmail,
+			text:          "contact jane.doe+ai@example.co.uk or ops@example.com",
+			expected:      "contact [REDACTED:email] or [REDACTED:email]",
+			expectedCount: 2,
+		},
+		{
+			name:          "card numbers with a valid checksum",
+			detector:      DetectorCreditCard,
+			text:          "card 4111 1111 1111 1111, backup 4111-1111-1111-1111",
+			expected:      "card [REDACTED:credit_card], backup [REDACTED:credit_card]",
+			expectedCount: 2,
+		},
+		{
+			name:          "card numbers with an invalid checksum are kept",
+			detector:      DetectorCreditCard,
+			text:          "order 4111111111111112",
+			expected:      "order 4111111111111112",
+			expectedCount: 0,
+		},
+		{
+			name:          "IBAN with a valid checksum",
+			detector:      DetectorIBAN,
+			text:          "pay to GB82 WEST 1234 5698 7654 32 today",
+			expected:      "pay to [REDACTED:iban] today",
+			expectedCount: 1,
+		},
+		{
+			name:          "IBAN with an invalid checksum is kept",
+			detector:      DetectorIBAN,
+			text:          "ref GB82WEST12345698765433",
+			expected:      "ref GB82WEST12345698765433",
+			expectedCount: 0,
+		},
+		{
+			name:          "grouped phone numbers",
+			detector:      DetectorPhone,
+			text:          "call +1 (555) 123-4567 or 555.123.4567",
+			expected:      "call [REDACTED:phone] or [REDACTED:phone]",
+			expectedCount: 2,
+		},
+		{
+			name:          "compact international phone number",
+			detector:      DetectorPhone,
+			text:          "whatsapp +442071838750",
+			expected:      "whatsapp [REDACTED:phone]",
+			expectedCount: 1,
+		},
+		{
+			name:          "IPv4 addresses are not phone numbers",
+			detector:      DetectorPhone,
+			text:          "connect to 192.168.100.200 and 10.100.200.1",
+			expected:      "connect to 192.168.100.200 and 10.100.200.1",
+			expectedCount: 0,
+		},
+		{
+			name:          "numbers with too many digits are not phone numbers",
+			detector:      DetectorPhone,
+			text:          "order +12345678901234567890",
+			expected:      "order +12345678901234567890",
+			expectedCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := builtinDetector(t, tt.detector)
+			got, count := d.replace(tt.text)
+			if got != tt.expected {
+				t.Errorf("replace(%q) = %q, want %q", tt.text, got, tt.expected)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("replace(%q) count = %d, want %d", tt.text, count, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func builtinDetector(t *testing.T, name string) *detector {
+	t.Helper()
+	for _, d := range builtinDetectors {
+		if d.name == name {
+			return d
+		}
+	}
+	t.Fatalf("unknown detector %q", name)
+	return nil
+}
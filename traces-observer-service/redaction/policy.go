@@ -0,0 +1,165 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redaction
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// DefaultPolicyName names the policy applied to organizations without a policy of their own
+const DefaultPolicyName = "default"
+
+// Config is the JSON configuration of the redaction policies
+type Config struct {
+	// Default is applied to organizations without a policy of their own; nothing is redacted when it is nil
+	Default *PolicyConfig `json:"default"`
+	// Organizations maps organization names to their policies, which replace the default policy
+	Organizations map[string]*PolicyConfig `json:"organizations"`
+}
+
+// PolicyConfig is the JSON configuration of a redaction policy
+type PolicyConfig struct {
+	// Detectors are the built-in detectors to apply: email, phone, credit_card and iban
+	Detectors []string `json:"detectors"`
+	// Patterns are additional regular expressions whose matches are redacted
+	Patterns []PatternConfig `json:"patterns"`
+	// AllowAttributes are span attributes returned as is. A trailing * matches any suffix.
+	AllowAttributes []string `json:"allowAttributes"`
+	// DenyAttributes are span attributes whose whole value is redacted; they take precedence
+	// over AllowAttributes. A trailing * matches any suffix.
+	DenyAttributes []string `json:"denyAttributes"`
+}
+
+// PatternConfig is a named regular expression
+type PatternConfig struct {
+	Name  string `json:"name"`
+	Regex string `json:"regex"`
+}
+
+// LoadConfig reads the redaction policies from a JSON file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read redaction policy file: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse redaction policy file: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Redactor selects the redaction policy of an organization
+type Redactor struct {
+	defaultPolicy *Policy
+	orgPolicies   map[string]*Policy
+}
+
+// NewRedactor compiles the policies of the configuration. A nil configuration redacts nothing.
+func NewRedactor(cfg *Config) (*Redactor, error) {
+	r := &Redactor{orgPolicies: make(map[string]*Policy)}
+	if cfg == nil {
+		return r, nil
+	}
+	if cfg.Default != nil {
+		policy, err := newPolicy(DefaultPolicyName, cfg.Default)
+		if err != nil {
+			return nil, err
+		}
+		r.defaultPolicy = policy
+	}
+	for orgName, policyCfg := range cfg.Organizations {
+		if policyCfg == nil {
+			policyCfg = &PolicyConfig{}
+		}
+		policy, err := newPolicy(orgName, policyCfg)
+		if err != nil {
+			return nil, err
+		}
+		r.orgPolicies[orgName] = policy
+	}
+	return r, nil
+}
+
+// PolicyFor returns the policy of an organization, or nil when nothing is redacted for it
+func (r *Redactor) PolicyFor(orgName string) *Policy {
+	if r == nil {
+		return nil
+	}
+	if policy, ok := r.orgPolicies[orgName]; ok {
+		return policy
+	}
+	return r.defaultPolicy
+}
+
+// Policy is a compiled redaction policy
+type Policy struct {
+	name            string
+	detectors       []*detector
+	allowAttributes []string
+	denyAttributes  []string
+}
+
+func newPolicy(name string, cfg *PolicyConfig) (*Policy, error) {
+	policy := &Policy{
+		name:            name,
+		allowAttributes: cfg.AllowAttributes,
+		denyAttributes:  cfg.DenyAttributes,
+	}
+	for _, d := range builtinDetectors {
+		for _, detectorName := range cfg.Detectors {
+			if detectorName == d.name {
+				policy.detectors = append(policy.detectors, d)
+				break
+			}
+		}
+	}
+	for _, detectorName := range cfg.Detectors {
+		if !IsBuiltinDetector(detectorName) {
+			return nil, fmt.Errorf("redaction policy %s: unknown detector: %s", name, detectorName)
+		}
+	}
+	for _, pattern := range cfg.Patterns {
+		if pattern.Name == "" {
+			return nil, fmt.Errorf("redaction policy %s: pattern name is required", name)
+		}
+		re, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("redaction policy %s: invalid pattern %s: %w", name, pattern.Name, err)
+		}
+		policy.detectors = append(policy.detectors, &detector{name: pattern.Name, pattern: re})
+	}
+	return policy, nil
+}
+
+// matchesAttribute reports whether an attribute key matches one of the names, where a trailing * matches any suffix
+func matchesAttribute(names []string, key string) bool {
+	for _, name := range names {
+		if prefix, ok := strings.CutSuffix(name, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if name == key {
+			return true
+		}
+	}
+	return false
+}
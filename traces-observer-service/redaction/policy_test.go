@@ -0,0 +1,140 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redaction
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewRedactorErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *Config
+		expected string
+	}{
+		{
+			name:     "unknown detector",
+			cfg:      &Config{Default: &PolicyConfig{Detectors: []string{"email", "ssn"}}},
+			expected: "redaction policy default: unknown detector: ssn",
+		},
+		{
+			name:     "pattern without a name",
+			cfg:      &Config{Organizations: map[string]*PolicyConfig{"acme": {Patterns: []PatternConfig{{Regex: `\d+`}}}}},
+			expected: "redaction policy acme: pattern name is required",
+		},
+		{
+			name:     "invalid pattern",
+			cfg:      &Config{Organizations: map[string]*PolicyConfig{"acme": {Patterns: []PatternConfig{{Name: "ticket", Regex: `(`}}}}},
+			expected: "redaction policy acme: invalid pattern ticket",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRedactor(tt.cfg)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("error %q does not contain %q", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyFor(t *testing.T) {
+	redactor, err := NewRedactor(&Config{
+		Default: &PolicyConfig{Detectors: []string{DetectorEmail}},
+		Organizations: map[string]*PolicyConfig{
+			"acme":   {Detectors: []string{DetectorPhone}},
+			"globex": nil,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewRedactor() error = %v", err)
+	}
+
+	tests := []struct {
+		orgName  string
+		expected string
+	}{
+		{orgName: "acme", expected: "acme"},
+		{orgName: "globex", expected: "globex"},
+		{orgName: "initech", expected: DefaultPolicyName},
+	}
+	for _, tt := range tests {
+		policy := redactor.PolicyFor(tt.orgName)
+		if policy == nil || policy.name != tt.expected {
+			t.Errorf("PolicyFor(%q) = %v, want policy %q", tt.orgName, policy, tt.expected)
+		}
+	}
+
+	// Organization policies replace the default policy rather than extending it
+	if policy := redactor.PolicyFor("globex"); len(policy.detectors) != 0 {
+		t.Errorf("policy of globex has %d detectors, want 0", len(policy.detectors))
+	}
+
+	empty, err := NewRedactor(nil)
+	if err != nil {
+		t.Fatalf("NewRedactor(nil) error = %v", err)
+	}
+	if policy := empty.PolicyFor("acme"); policy != nil {
+		t.Errorf("PolicyFor() without a configuration = %v, want nil", policy)
+	}
+	var nilRedactor *Redactor
+	if policy := nilRedactor.PolicyFor("acme"); policy != nil {
+		t.Errorf("PolicyFor() of a nil redactor = %v, want nil", policy)
+	}
+}
+
+func TestPolicyDetectorOrder(t *testing.T) {
+	// Built-in detectors run in their fixed order whatever the configured order, and custom patterns run last
+	policy, err := newPolicy("acme", &PolicyConfig{
+		Detectors: []string{DetectorPhone, DetectorEmail, DetectorCreditCard},
+		Patterns:  []PatternConfig{{Name: "ticket", Regex: `TICKET-\d+`}},
+	})
+	if err != nil {
+		t.Fatalf("newPolicy() error = %v", err)
+	}
+	var names []string
+	for _, d := range policy.detectors {
+		names = append(names, d.name)
+	}
+	expected := []string{DetectorCreditCard, DetectorEmail, DetectorPhone, "ticket"}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Errorf("detectors = %v, want %v", names, expected)
+	}
+}
+
+func TestMatchesAttribute(t *testing.T) {
+	names := []string{"user.email", "gen_ai.prompt.*"}
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "user.email", expected: true},
+		{key: "user.email.verified", expected: false},
+		{key: "gen_ai.prompt.0.content", expected: true},
+		{key: "gen_ai.prompt.", expected: true},
+		{key: "gen_ai.completion.0.content", expected: false},
+	}
+	for _, tt := range tests {
+		if got := matchesAttribute(names, tt.key); got != tt.expected {
+			t.Errorf("matchesAttribute(%q) = %v, want %v", tt.key, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redaction
+
+import (
+	"sort"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+// DeniedAttributeType is the kind recorded for the values of denied attributes
+const DeniedAttributeType = "attribute"
+
+// RedactSpans redacts the spans in place, see RedactSpan
+func (p *Policy) RedactSpans(spans []traces.Span) {
+	if p == nil {
+		return
+	}
+	for i := range spans {
+		p.RedactSpan(&spans[i])
+	}
+}
+
+// RedactSpan redacts the attributes, events and status message of a span and rebuilds its AMP
+// attributes from the redacted attributes, so that the input, output and prompt messages derived
+// from them are redacted too. The attribute maps and events are replaced rather than modified, as
+// they may be shared with the trace store. The span is marked with the kinds and number of redacted
+// values when anything was redacted.
+func (p *Policy) RedactSpan(span *traces.Span) {
+	if p == nil || (len(span.Attributes) == 0 && len(span.Events) == 0 && span.StatusMessage == "") {
+		return
+	}
+
+	counts := make(map[string]int)
+	attributes := p.redactAttributes(span.Attributes, counts)
+
+	// Exception messages and streamed tokens may hold the same values as the attributes
+	var events []traces.SpanEvent
+	if len(span.Events) > 0 {
+		events = make([]traces.SpanEvent, len(span.Events))
+		for i, event := range span.Events {
+			events[i] = event
+			events[i].Attributes = p.redactAttributes(event.Attributes, counts)
+		}
+	}
+	statusMessage := p.redactString(span.StatusMessage, counts)
+
+	if len(counts) == 0 {
+		return
+	}
+
+	span.Attributes = attributes
+	span.Events = events
+	span.StatusMessage = statusMessage
+	traces.PopulateAmpAttributes(span)
+	span.Redaction = newRedaction(p.name, counts)
+}
+
+// redactAttributes returns a copy of attributes with denied attributes and detected values replaced
+func (p *Policy) redactAttributes(attrs map[string]interface{}, counts map[string]int) map[string]interface{} {
+	if attrs == nil {
+		return nil
+	}
+
+	attributes := make(map[string]interface{}, len(attrs))
+	for key, value := range attrs {
+		switch {
+		case matchesAttribute(p.denyAttributes, key):
+			attributes[key] = marker(DeniedAttributeType)
+			counts[DeniedAttributeType]++
+		case matchesAttribute(p.allowAttributes, key):
+			attributes[key] = value
+		default:
+			attributes[key] = p.redactValue(value, counts)
+		}
+	}
+	return attributes
+}
+
+// redactValue returns a copy of an attribute value with the detected values of its strings replaced
+func (p *Policy) redactValue(value interface{}, counts map[string]int) interface{} {
+	switch v := value.(type) {
+	case string:
+		return p.redactString(v, counts)
+	case []string:
+		result := make([]string, len(v))
+		for i, item := range v {
+			result[i] = p.redactString(item, counts)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = p.redactValue(item, counts)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = p.redactValue(item, counts)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func (p *Policy) redactString(text string, counts map[string]int) string {
+	for _, d := range p.detectors {
+		var count int
+		text, count = d.replace(text)
+		if count > 0 {
+			counts[d.name] += count
+		}
+	}
+	return text
+}
+
+func newRedaction(policyName string, counts map[string]int) *traces.Redaction {
+	redaction := &traces.Redaction{Policy: policyName}
+	for name, count := range counts {
+		redaction.Count += count
+		redaction.Types = append(redaction.Types, name)
+	}
+	sort.Strings(redaction.Types)
+	return redaction
+}
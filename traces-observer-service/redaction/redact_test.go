@@ -0,0 +1,160 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redaction
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/traces"
+)
+
+func newTestPolicy(t *testing.T, cfg *PolicyConfig) *Policy {
+	t.Helper()
+	policy, err := newPolicy("test", cfg)
+	if err != nil {
+		t.Fatalf("newPolicy() error = %v", err)
+	}
+	return policy
+}
+
+func TestRedactAttributes(t *testing.T) {
+	policy := newTestPolicy(t, &PolicyConfig{
+		Detectors:       []string{DetectorEmail, DetectorCreditCard},
+		AllowAttributes: []string{"user.*", "session.id"},
+		DenyAttributes:  []string{"user.ssn", "http.request.header.*"},
+	})
+
+	tests := []struct {
+		name     string
+		key      string
+		value    interface{}
+		expected interface{}
+	}{
+		{name: "denied attribute", key: "http.request.header.authorization", value: "Bearer abc", expected: "[REDACTED:attribute]"},
+		{name: "deny takes precedence over allow", key: "user.ssn", value: "078-05-1120", expected: "[REDACTED:attribute]"},
+		{name: "allowed attribute is kept", key: "user.email", value: "jane@example.com", expected: "jane@example.com"},
+		{name: "string value", key: "input.value", value: "mail jane@example.com", expected: "mail [REDACTED:email]"},
+		{
+			name:     "string slice",
+			key:      "tags",
+			value:    []string{"jane@example.com", "vip"},
+			expected: []string{"[REDACTED:email]", "vip"},
+		},
+		{
+			name:     "nested values",
+			key:      "payload",
+			value:    map[string]interface{}{"cards": []interface{}{"4111111111111111", 42}},
+			expected: map[string]interface{}{"cards": []interface{}{"[REDACTED:credit_card]", 42}},
+		},
+		{name: "non string value", key: "retries", value: 3, expected: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := make(map[string]int)
+			attributes := policy.redactAttributes(map[string]interface{}{tt.key: tt.value}, counts)
+			if !reflect.DeepEqual(attributes[tt.key], tt.expected) {
+				t.Errorf("attribute %s = %#v, want %#v", tt.key, attributes[tt.key], tt.expected)
+			}
+		})
+	}
+}
+
+func TestRedactSpanRebuildsAmpAttributes(t *testing.T) {
+	policy := newTestPolicy(t, &PolicyConfig{Detectors: []string{DetectorEmail, DetectorPhone}})
+
+	inputMessages := `[{"role":"user","parts":[{"type":"text","content":"Email \"jane@example.com\" or call +1 (555) 123-4567"}]}]`
+	outputMessages := `[{"role":"assistant","parts":[{"type":"text","content":"Sent to jane@example.com"}]}]`
+	attributes := map[string]interface{}{
+		"traceloop.span.kind":    "llm",
+		"gen_ai.input.messages":  inputMessages,
+		"gen_ai.output.messages": outputMessages,
+	}
+	span := traces.Span{
+		Status:        "2",
+		StatusMessage: "could not reach jane@example.com",
+		Attributes:    attributes,
+		Events: []traces.SpanEvent{{
+			Name:       "exception",
+			Attributes: map[string]interface{}{"exception.message": "bounced: jane@example.com"},
+		}},
+	}
+	traces.PopulateAmpAttributes(&span)
+
+	policy.RedactSpan(&span)
+
+	// JSON encoded attribute values stay valid JSON
+	var messages []map[string]interface{}
+	if err := json.Unmarshal([]byte(span.Attributes["gen_ai.input.messages"].(string)), &messages); err != nil {
+		t.Fatalf("redacted input messages are not valid JSON: %v", err)
+	}
+
+	input, ok := span.AmpAttributes.Input.([]traces.PromptMessage)
+	if !ok || len(input) != 1 {
+		t.Fatalf("AmpAttributes.Input = %#v, want one prompt message", span.AmpAttributes.Input)
+	}
+	if expected := `Email "[REDACTED:email]" or call [REDACTED:phone]`; input[0].Content != expected {
+		t.Errorf("input content = %q, want %q", input[0].Content, expected)
+	}
+	output, ok := span.AmpAttributes.Output.([]traces.PromptMessage)
+	if !ok || len(output) != 1 {
+		t.Fatalf("AmpAttributes.Output = %#v, want one prompt message", span.AmpAttributes.Output)
+	}
+	if expected := "Sent to [REDACTED:email]"; output[0].Content != expected {
+		t.Errorf("output content = %q, want %q", output[0].Content, expected)
+	}
+
+	if expected := "could not reach [REDACTED:email]"; span.StatusMessage != expected {
+		t.Errorf("status message = %q, want %q", span.StatusMessage, expected)
+	}
+	if expected := "bounced: [REDACTED:email]"; span.Events[0].Attributes["exception.message"] != expected {
+		t.Errorf("event message = %q, want %q", span.Events[0].Attributes["exception.message"], expected)
+	}
+	if span.AmpAttributes.Status == nil || !strings.Contains(span.AmpAttributes.Status.ErrorMessage, "[REDACTED:email]") {
+		t.Errorf("AmpAttributes.Status = %#v, want a redacted error message", span.AmpAttributes.Status)
+	}
+
+	expectedRedaction := &traces.Redaction{Policy: "test", Count: 5, Types: []string{DetectorEmail, DetectorPhone}}
+	if !reflect.DeepEqual(span.Redaction, expectedRedaction) {
+		t.Errorf("redaction = %#v, want %#v", span.Redaction, expectedRedaction)
+	}
+
+	// The attribute map may be shared with the trace store, so it must not be modified
+	if attributes["gen_ai.input.messages"] != inputMessages {
+		t.Errorf("original attributes were modified: %v", attributes["gen_ai.input.messages"])
+	}
+}
+
+func TestRedactSpanWithoutMatches(t *testing.T) {
+	policy := newTestPolicy(t, &PolicyConfig{Detectors: []string{DetectorEmail}})
+	attributes := map[string]interface{}{"input.value": "hello"}
+	span := traces.Span{Attributes: attributes}
+
+	policy.RedactSpan(&span)
+
+	if span.Redaction != nil {
+		t.Errorf("redaction = %#v, want nil", span.Redaction)
+	}
+	if span.AmpAttributes != nil {
+		t.Errorf("AmpAttributes = %#v, want nil as nothing was redacted", span.AmpAttributes)
+	}
+
+	var nilPolicy *Policy
+	nilPolicy.RedactSpans([]traces.Span{{Attributes: map[string]interface{}{"input.value": "jane@example.com"}}})
+}
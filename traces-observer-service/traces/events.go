@@ -0,0 +1,61 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import "strings"
+
+// exceptionEventName is the name of the event that records an exception, see
+// https://opentelemetry.io/docs/specs/semconv/exceptions/exceptions-spans/
+const exceptionEventName = "exception"
+
+// tokenEventNames are the (lower case) names of the events that instrumentations record for streamed tokens
+var tokenEventNames = map[string]bool{
+	"llm.content.completion.chunk": true, // Traceloop
+	"first token stream event":     true, // OpenInference
+	"new_token":                    true, // LangChain
+}
+
+// ClassifySpanEvents sets the kind of the span events that do not have one
+func ClassifySpanEvents(events []SpanEvent) {
+	for i := range events {
+		if events[i].Kind == "" {
+			events[i].Kind = spanEventKind(events[i].Name)
+		}
+	}
+}
+
+// spanEventKind determines the semantic kind of a span event from its name
+func spanEventKind(name string) SpanEventKind {
+	switch {
+	case name == exceptionEventName:
+		return SpanEventKindException
+	case tokenEventNames[strings.ToLower(name)]:
+		return SpanEventKindToken
+	default:
+		return SpanEventKindCustom
+	}
+}
+
+// lastExceptionEvent returns the last exception event of a span, which is the one that ended it, or nil if there is none
+func lastExceptionEvent(events []SpanEvent) *SpanEvent {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Name == exceptionEventName {
+			return &events[i]
+		}
+	}
+	return nil
+}
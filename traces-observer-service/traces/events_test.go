@@ -0,0 +1,103 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClassifySpanEvents(t *testing.T) {
+	events := []SpanEvent{
+		{Name: "exception"},
+		{Name: "llm.content.completion.chunk"},
+		{Name: "First Token Stream Event"},
+		{Name: "cache.miss"},
+		{Name: "new_token", Kind: SpanEventKindCustom},
+	}
+	ClassifySpanEvents(events)
+
+	expected := []SpanEventKind{
+		SpanEventKindException,
+		SpanEventKindToken,
+		SpanEventKindToken,
+		SpanEventKindCustom,
+		SpanEventKindCustom, // kinds that are already set are kept
+	}
+	for i, event := range events {
+		if event.Kind != expected[i] {
+			t.Errorf("event %q has kind %q, want %q", event.Name, event.Kind, expected[i])
+		}
+	}
+}
+
+func TestExtractSpanStatusFromExceptionEvents(t *testing.T) {
+	retried := SpanEvent{
+		Name: "exception",
+		Attributes: map[string]interface{}{
+			"exception.type":    "openai.RateLimitError",
+			"exception.message": "Rate limit reached, retrying",
+		},
+	}
+	failed := SpanEvent{
+		Name: "exception",
+		Attributes: map[string]interface{}{
+			"exception.type":       "ValueError",
+			"exception.message":    "unknown city: Atlantis",
+			"exception.stacktrace": "Traceback (most recent call last): ...",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		span     Span
+		expected SpanStatus
+	}{
+		{
+			name:     "error span takes the type and message of the last exception",
+			span:     Span{Status: "2", Events: []SpanEvent{retried, {Name: "retry"}, failed}},
+			expected: SpanStatus{Error: true, ErrorType: "ValueError", ErrorMessage: "unknown city: Atlantis"},
+		},
+		{
+			name: "error.type attribute takes precedence over the exception type",
+			span: Span{
+				Status:     "2",
+				Attributes: map[string]interface{}{"error.type": "ToolError"},
+				Events:     []SpanEvent{failed},
+			},
+			expected: SpanStatus{Error: true, ErrorType: "ToolError", ErrorMessage: "unknown city: Atlantis"},
+		},
+		{
+			name:     "error span without exception events uses the status message",
+			span:     Span{Status: "2", StatusMessage: "deadline exceeded"},
+			expected: SpanStatus{Error: true, ErrorMessage: "deadline exceeded"},
+		},
+		{
+			name:     "handled exceptions do not make a span an error",
+			span:     Span{Status: "1", Events: []SpanEvent{retried}},
+			expected: SpanStatus{Error: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractSpanStatus(tt.span); !reflect.DeepEqual(*got, tt.expected) {
+				t.Errorf("extractSpanStatus() = %#v, want %#v", *got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// spanTextAttributePrefixes are the attributes searched by TraceFilters.Search,
+// covering the input and output attributes of the supported instrumentations
+var spanTextAttributePrefixes = []string{
+	"traceloop.entity.input",
+	"traceloop.entity.output",
+	"gen_ai.prompt",
+	"gen_ai.completion",
+	"gen_ai.input.messages",
+	"gen_ai.output.messages",
+	"llm.input_messages",
+	"llm.output_messages",
+	"input.value",
+	"output.value",
+}
+
+// SpanModelAttributes are the attributes that hold the model used by a span
+var SpanModelAttributes = []string{
+	"gen_ai.request.model",
+	"gen_ai.response.model",
+	"llm.model_name",
+}
+
+// SpanToolNameAttributes are the attributes that hold the name of the tool called by a span
+var SpanToolNameAttributes = []string{
+	"gen_ai.tool.name",
+	"tool.name",
+	"function.name",
+	"tool_name",
+}
+
+// IsValidSpanType checks whether the given value is a known semantic span kind
+func IsValidSpanType(spanType string) bool {
+	switch SpanType(spanType) {
+	case SpanTypeLLM, SpanTypeEmbedding, SpanTypeTool, SpanTypeRetriever, SpanTypeRerank,
+		SpanTypeAgent, SpanTypeChain, SpanTypeCrewAITask, SpanTypeUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasSpanFilters reports whether any filter that selects traces by their spans is set
+func (f TraceFilters) HasSpanFilters() bool {
+	return f.SpanKind != "" || f.ErrorsOnly || f.Model != "" || f.ToolName != "" ||
+		f.Search != "" || len(f.Attributes) > 0
+}
+
+// MatchesSpan checks the span filters that depend on span processing against a span.
+// Model, tool name and attribute filters are checked by MatchesSpanAttributes, which
+// stores with a query language apply in the query instead.
+func (f TraceFilters) MatchesSpan(span Span) bool {
+	if f.SpanKind != "" && DetermineSpanType(span) != f.SpanKind {
+		return false
+	}
+	if f.ErrorsOnly && !SpanHasError(span) {
+		return false
+	}
+	if f.Search != "" && !spanContainsText(span, f.Search) {
+		return false
+	}
+	return true
+}
+
+// MatchesSpanAttributes checks the model, tool name and attribute filters against a span
+func (f TraceFilters) MatchesSpanAttributes(span Span) bool {
+	if f.Model != "" && !anyAttributeEquals(span.Attributes, SpanModelAttributes, f.Model) {
+		return false
+	}
+	if f.ToolName != "" && !anyAttributeEquals(span.Attributes, SpanToolNameAttributes, f.ToolName) {
+		return false
+	}
+	for key, value := range f.Attributes {
+		if !anyAttributeEquals(span.Attributes, []string{key}, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// anyAttributeEquals checks whether any of the given attributes has the value. Non-string
+// values are compared in their JSON form, so numbers and booleans can be matched as well.
+func anyAttributeEquals(attrs map[string]interface{}, keys []string, value string) bool {
+	for _, key := range keys {
+		attrValue, ok := attrs[key]
+		if !ok {
+			continue
+		}
+		if attrStr, ok := attrValue.(string); ok {
+			if attrStr == value {
+				return true
+			}
+			continue
+		}
+		if attrBytes, err := json.Marshal(attrValue); err == nil && string(attrBytes) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// spanContainsText checks whether any input or output attribute of the span contains the text, ignoring case
+func spanContainsText(span Span, text string) bool {
+	text = strings.ToLower(text)
+	for key, value := range span.Attributes {
+		if !hasAnyPrefix(key, spanTextAttributePrefixes) {
+			continue
+		}
+		var valueStr string
+		switch v := value.(type) {
+		case string:
+			valueStr = v
+		default:
+			valueBytes, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			valueStr = string(valueBytes)
+		}
+		if strings.Contains(strings.ToLower(valueStr), text) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// langGraphDefaultGraphName is the span name of a LangGraph graph run unless the graph is given a run name
+const langGraphDefaultGraphName = "LangGraph"
+
+// langGraphMetadataPrefixes are the prefixes of the attributes in which instrumentations record the
+// LangChain run metadata (langgraph_node, langgraph_step, ...) when it is not a single JSON attribute
+var langGraphMetadataPrefixes = []string{
+	"metadata.",
+	"traceloop.association.properties.",
+}
+
+// IsLangGraphSpan checks if a span is the run of a LangGraph graph or of one of its nodes.
+// Spans nested in a node (LLM calls, tools, ...) carry the metadata of the node as well, so
+// a span is only a node span when it is named after the node in its langgraph_node metadata.
+func IsLangGraphSpan(span Span) bool {
+	if span.Attributes == nil {
+		return false
+	}
+
+	// Spans with a more specific OpenInference kind (LLM, TOOL, ...) are not graph or node runs
+	if IsOpenInferenceSpan(span.Attributes) && determineOpenInferenceSpanType(span.Attributes) != SpanTypeChain {
+		return false
+	}
+
+	return isLangGraphGraphSpan(span) || langGraphNode(span) != ""
+}
+
+// isLangGraphGraphSpan checks if a span is the run of a LangGraph graph
+// OpenInference names the span after the graph, Traceloop names it {graph}.workflow
+func isLangGraphGraphSpan(span Span) bool {
+	if span.Name == langGraphDefaultGraphName || span.Name == langGraphDefaultGraphName+".workflow" {
+		return true
+	}
+	if entityName, ok := span.Attributes["traceloop.entity.name"].(string); ok && entityName == langGraphDefaultGraphName {
+		return true
+	}
+	return false
+}
+
+// langGraphNode returns the name of the node run by a span, or "" if the span does not run a node
+func langGraphNode(span Span) string {
+	node, _ := langGraphMetadata(span.Attributes)["langgraph_node"].(string)
+	if node == "" {
+		return ""
+	}
+
+	// Traceloop names node spans {node}.task and records the node as the entity name
+	if span.Name == node || span.Name == node+".task" {
+		return node
+	}
+	if entityName, ok := span.Attributes["traceloop.entity.name"].(string); ok && entityName == node {
+		return node
+	}
+	return ""
+}
+
+// langGraphMetadata returns the LangGraph run metadata of a span
+// OpenInference records it as a JSON object in the metadata attribute, Traceloop as association properties
+func langGraphMetadata(attrs map[string]interface{}) map[string]interface{} {
+	metadata := make(map[string]interface{})
+
+	if metadataJSON, ok := attrs["metadata"].(string); ok && metadataJSON != "" {
+		var parsed map[string]interface{}
+		if err := json.Unmarshal([]byte(metadataJSON), &parsed); err == nil {
+			for key, value := range parsed {
+				if strings.HasPrefix(key, "langgraph_") {
+					metadata[key] = value
+				}
+			}
+		}
+	}
+
+	for _, prefix := range langGraphMetadataPrefixes {
+		for _, key := range []string{"langgraph_node", "langgraph_step", "langgraph_triggers"} {
+			if value, ok := attrs[prefix+key]; ok {
+				if _, exists := metadata[key]; !exists {
+					metadata[key] = value
+				}
+			}
+		}
+	}
+
+	return metadata
+}
+
+// PopulateLangGraphAttributes extracts and populates the attributes of a LangGraph graph or node span
+func PopulateLangGraphAttributes(ampAttrs *AmpAttributes, span Span) {
+	// Extract input and output using the generic method
+	ampAttrs.Input, ampAttrs.Output = ExtractLangGraphSpanInputOutput(span.Attributes)
+
+	// Set LangGraph-specific data
+	graphData := LangGraphData{}
+
+	// Extract the graph name from traceloop.workflow.name, which is only set by Traceloop
+	if workflowName, ok := span.Attributes["traceloop.workflow.name"].(string); ok {
+		graphData.Graph = workflowName
+	}
+
+	if isLangGraphGraphSpan(span) {
+		if graphData.Graph == "" {
+			graphData.Graph = langGraphDefaultGraphName
+		}
+		ampAttrs.Data = graphData
+		return
+	}
+
+	graphData.Node = langGraphNode(span)
+
+	metadata := langGraphMetadata(span.Attributes)
+
+	// Extract the step, which is a number in JSON metadata and a string in association properties
+	switch step := metadata["langgraph_step"].(type) {
+	case float64:
+		graphData.Step = int(step)
+	case string:
+		if stepNum, err := strconv.Atoi(step); err == nil {
+			graphData.Step = stepNum
+		}
+	}
+
+	// Extract the triggers, which are a list in JSON metadata and may be a JSON string in association properties
+	switch triggers := metadata["langgraph_triggers"].(type) {
+	case []interface{}:
+		for _, trigger := range triggers {
+			if triggerStr, ok := trigger.(string); ok {
+				graphData.Triggers = append(graphData.Triggers, triggerStr)
+			}
+		}
+	case string:
+		var triggerList []string
+		if err := json.Unmarshal([]byte(triggers), &triggerList); err == nil {
+			graphData.Triggers = triggerList
+		}
+	}
+
+	ampAttrs.Data = graphData
+}
+
+// ExtractLangGraphSpanInputOutput extracts input and output from LangGraph graph and node spans
+// Traceloop spans use the traceloop.entity.* attributes, see extractSpanInputOutput
+// OpenInference spans record the graph state:
+// Input path: input.value (the state passed to the graph or node, as-is)
+// Output path: output.value -> messages[-1] -> kwargs -> content (or content)
+// Returns nil when attributes are not found
+func ExtractLangGraphSpanInputOutput(attrs map[string]interface{}) (input interface{}, output interface{}) {
+	// Return nil if no attributes
+	if attrs == nil {
+		return nil, nil
+	}
+
+	_, hasTraceloopInput := attrs["traceloop.entity.input"]
+	_, hasTraceloopOutput := attrs["traceloop.entity.output"]
+	if hasTraceloopInput || hasTraceloopOutput {
+		return extractSpanInputOutput(attrs)
+	}
+
+	if inputStr := openInferenceValue(attrs, "input.value"); inputStr != "" {
+		input = inputStr
+	}
+	if outputStr := openInferenceValue(attrs, "output.value"); outputStr != "" {
+		output = extractLangGraphStateOutput(outputStr)
+	}
+
+	return input, output
+}
+
+// ExtractLangGraphRootSpanInputOutput extracts input and output from the graph span of a LangGraph trace
+// Returns nil when attributes are not found
+func ExtractLangGraphRootSpanInputOutput(rootSpan *Span) (input interface{}, output interface{}) {
+	if rootSpan == nil || rootSpan.Attributes == nil {
+		return nil, nil
+	}
+
+	// Use the generic extraction method
+	return ExtractLangGraphSpanInputOutput(rootSpan.Attributes)
+}
+
+// extractLangGraphStateOutput returns the content of the last message of a graph state,
+// or the state as-is when it has no messages
+func extractLangGraphStateOutput(outputStr string) string {
+	var state map[string]interface{}
+	if err := json.Unmarshal([]byte(outputStr), &state); err != nil {
+		return outputStr
+	}
+
+	messages, ok := state["messages"].([]interface{})
+	if !ok || len(messages) == 0 {
+		return outputStr
+	}
+
+	lastMessage := messages[len(messages)-1]
+	message, ok := lastMessage.(map[string]interface{})
+	if !ok {
+		if msgBytes, err := json.Marshal(lastMessage); err == nil {
+			return string(msgBytes)
+		}
+		return outputStr
+	}
+
+	// Serialized LangChain messages hold their fields in kwargs
+	if kwargs, ok := message["kwargs"].(map[string]interface{}); ok {
+		message = kwargs
+	}
+
+	switch content := message["content"].(type) {
+	case string:
+		return content
+	case nil:
+		if msgBytes, err := json.Marshal(lastMessage); err == nil {
+			return string(msgBytes)
+		}
+	default:
+		// content is a list of content blocks, return it as JSON
+		if contentBytes, err := json.Marshal(content); err == nil {
+			return string(contentBytes)
+		}
+	}
+	return outputStr
+}
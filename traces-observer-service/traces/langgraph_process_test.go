@@ -0,0 +1,143 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsLangGraphSpan(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		spanID   string
+		expected bool
+	}{
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60001", true},  // graph run
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60002", true},  // agent node
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60003", false}, // LLM call in the agent node
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60004", true},  // tools node
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60005", false}, // tool call in the tools node
+		{"traceloop_langgraph_trace.json", "e2c22d3d4b7736bd", true},      // graph run
+		{"traceloop_langgraph_trace.json", "c189ec26ae2a0bb5", true},      // agent node
+		{"traceloop_langgraph_trace.json", "9f3a1b2c4d5e6f70", false},     // LLM call in the agent node
+		{"openinference_rag_trace.json", "b1b2c3d4e5f60001", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture+"/"+tt.spanID, func(t *testing.T) {
+			span := loadSpanFixture(t, tt.fixture)[tt.spanID]
+			if got := IsLangGraphSpan(span); got != tt.expected {
+				t.Errorf("IsLangGraphSpan() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPopulateLangGraphAttributes(t *testing.T) {
+	tests := []struct {
+		name           string
+		fixture        string
+		spanID         string
+		expectedInput  interface{}
+		expectedOutput interface{}
+		expectedData   LangGraphData
+	}{
+		{
+			name:           "OpenInference graph run",
+			fixture:        "openinference_langgraph_trace.json",
+			spanID:         "a1b2c3d4e5f60001",
+			expectedInput:  `{"messages": [["user", "What is the weather in Colombo?"]]}`,
+			expectedOutput: "It is 31°C and sunny in Colombo.",
+			expectedData:   LangGraphData{Graph: "LangGraph"},
+		},
+		{
+			name:           "OpenInference node calling a tool",
+			fixture:        "openinference_langgraph_trace.json",
+			spanID:         "a1b2c3d4e5f60002",
+			expectedInput:  `{"messages": [{"content": "What is the weather in Colombo?", "type": "human"}]}`,
+			expectedOutput: "",
+			expectedData:   LangGraphData{Node: "agent", Step: 1, Triggers: []string{"branch:to:agent"}},
+		},
+		{
+			name:           "OpenInference tools node",
+			fixture:        "openinference_langgraph_trace.json",
+			spanID:         "a1b2c3d4e5f60004",
+			expectedInput:  `{"messages": [{"content": "", "type": "ai"}]}`,
+			expectedOutput: "31°C, sunny",
+			expectedData:   LangGraphData{Node: "tools", Step: 2, Triggers: []string{"branch:agent:tools_condition:tools"}},
+		},
+		{
+			name:           "OpenInference node answering with content blocks",
+			fixture:        "openinference_langgraph_trace.json",
+			spanID:         "a1b2c3d4e5f60006",
+			expectedOutput: `[{"text":"It is 31°C and sunny in Colombo.","type":"text"}]`,
+			expectedData:   LangGraphData{Node: "agent", Step: 3, Triggers: []string{"branch:to:agent"}},
+		},
+		{
+			name:           "Traceloop graph run",
+			fixture:        "traceloop_langgraph_trace.json",
+			spanID:         "e2c22d3d4b7736bd",
+			expectedInput:  `{"inputs":{"messages":[["user","Hi"]]},"metadata":{}}`,
+			expectedOutput: "Hello! How can I help?",
+			expectedData:   LangGraphData{Graph: "LangGraph"},
+		},
+		{
+			name:           "Traceloop node",
+			fixture:        "traceloop_langgraph_trace.json",
+			spanID:         "c189ec26ae2a0bb5",
+			expectedOutput: "Hello! How can I help?",
+			expectedData:   LangGraphData{Graph: "LangGraph", Node: "agent", Step: 1, Triggers: []string{"branch:to:agent"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span := processedSpan(t, loadSpanFixture(t, tt.fixture), tt.spanID)
+
+			if span.AmpAttributes.Kind != string(SpanTypeChain) {
+				t.Errorf("Kind = %q, want %q", span.AmpAttributes.Kind, SpanTypeChain)
+			}
+			if !reflect.DeepEqual(span.AmpAttributes.Input, tt.expectedInput) {
+				t.Errorf("Input = %#v, want %#v", span.AmpAttributes.Input, tt.expectedInput)
+			}
+			if !reflect.DeepEqual(span.AmpAttributes.Output, tt.expectedOutput) {
+				t.Errorf("Output = %#v, want %#v", span.AmpAttributes.Output, tt.expectedOutput)
+			}
+			if !reflect.DeepEqual(span.AmpAttributes.Data, tt.expectedData) {
+				t.Errorf("Data = %#v, want %#v", span.AmpAttributes.Data, tt.expectedData)
+			}
+		})
+	}
+}
+
+func TestPopulateAmpAttributesLangGraphInnerSpans(t *testing.T) {
+	// Spans nested in a node keep the kind and data of their own instrumentation
+	span := processedSpan(t, loadSpanFixture(t, "traceloop_langgraph_trace.json"), "9f3a1b2c4d5e6f70")
+
+	if span.AmpAttributes.Kind != string(SpanTypeLLM) {
+		t.Fatalf("Kind = %q, want %q", span.AmpAttributes.Kind, SpanTypeLLM)
+	}
+	expectedOutput := []PromptMessage{{Role: "assistant", Content: "Hello! How can I help?"}}
+	if !reflect.DeepEqual(span.AmpAttributes.Output, expectedOutput) {
+		t.Errorf("Output = %#v, want %#v", span.AmpAttributes.Output, expectedOutput)
+	}
+	llmData, ok := span.AmpAttributes.Data.(LLMData)
+	if !ok || llmData.Model != "gpt-4o-mini" || llmData.Vendor != "openai" {
+		t.Errorf("Data = %#v, want LLMData for openai gpt-4o-mini", span.AmpAttributes.Data)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxMetricsBuckets is the maximum number of time buckets in a metrics response
+const MaxMetricsBuckets = 1000
+
+// targetMetricsBuckets is the number of buckets DefaultMetricsInterval aims for
+const targetMetricsBuckets = 60
+
+// metricsIntervals are the bucket widths DefaultMetricsInterval chooses from
+var metricsIntervals = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	3 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+}
+
+// DefaultMetricsInterval picks a bucket width that splits the time range into about targetMetricsBuckets buckets
+func DefaultMetricsInterval(start, end time.Time) time.Duration {
+	span := end.Sub(start)
+	for _, interval := range metricsIntervals {
+		if span/interval <= targetMetricsBuckets {
+			return interval
+		}
+	}
+	return metricsIntervals[len(metricsIntervals)-1]
+}
+
+// FormatMetricsInterval formats a bucket width as a time unit string, e.g. 5m or 1d
+func FormatMetricsInterval(interval time.Duration) string {
+	switch {
+	case interval%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", interval/(24*time.Hour))
+	case interval%time.Hour == 0:
+		return fmt.Sprintf("%dh", interval/time.Hour)
+	case interval%time.Minute == 0:
+		return fmt.Sprintf("%dm", interval/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", interval/time.Second)
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// openInferenceSpanKinds maps OpenInference span kinds (openinference.span.kind) to semantic span types
+var openInferenceSpanKinds = map[string]SpanType{
+	"LLM":       SpanTypeLLM,
+	"EMBEDDING": SpanTypeEmbedding,
+	"TOOL":      SpanTypeTool,
+	"RETRIEVER": SpanTypeRetriever,
+	"RERANKER":  SpanTypeRerank,
+	"AGENT":     SpanTypeAgent,
+	"CHAIN":     SpanTypeChain,
+	"GUARDRAIL": SpanTypeChain,
+	"EVALUATOR": SpanTypeChain,
+}
+
+// IsOpenInferenceSpan checks if a span is instrumented with the OpenInference semantic conventions
+// (e.g., Arize Phoenix / openinference-instrumentation-* packages), which set openinference.span.kind
+func IsOpenInferenceSpan(attrs map[string]interface{}) bool {
+	if attrs == nil {
+		return false
+	}
+
+	kind, ok := attrs["openinference.span.kind"].(string)
+	return ok && kind != ""
+}
+
+// determineOpenInferenceSpanType maps the openinference.span.kind of a span to its semantic type.
+// Returns SpanTypeUnknown for spans without a known OpenInference kind.
+func determineOpenInferenceSpanType(attrs map[string]interface{}) SpanType {
+	kind, _ := attrs["openinference.span.kind"].(string)
+	if spanType, ok := openInferenceSpanKinds[strings.ToUpper(kind)]; ok {
+		return spanType
+	}
+	return SpanTypeUnknown
+}
+
+// PopulateOpenInferenceAttributes extracts and populates the attributes of an OpenInference span of the given type
+func PopulateOpenInferenceAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}, spanType SpanType) {
+	switch spanType {
+	case SpanTypeLLM:
+		populateOpenInferenceLLMAttributes(ampAttrs, attrs)
+	case SpanTypeEmbedding:
+		populateOpenInferenceEmbeddingAttributes(ampAttrs, attrs)
+	case SpanTypeTool:
+		populateOpenInferenceToolAttributes(ampAttrs, attrs)
+	case SpanTypeRetriever:
+		populateOpenInferenceRetrieverAttributes(ampAttrs, attrs)
+	case SpanTypeRerank:
+		populateOpenInferenceRerankAttributes(ampAttrs, attrs)
+	case SpanTypeAgent:
+		populateOpenInferenceAgentAttributes(ampAttrs, attrs)
+	default:
+		ampAttrs.Input, ampAttrs.Output = ExtractOpenInferenceSpanInputOutput(attrs)
+	}
+}
+
+// ExtractOpenInferenceSpanInputOutput extracts input and output from the input.value and output.value attributes
+// This is a generic method that works for any OpenInference span (chain, agent, tool, ...)
+// Returns nil when attributes are not found
+func ExtractOpenInferenceSpanInputOutput(attrs map[string]interface{}) (input interface{}, output interface{}) {
+	// Return nil if no attributes
+	if attrs == nil {
+		return nil, nil
+	}
+
+	if inputStr := openInferenceValue(attrs, "input.value"); inputStr != "" {
+		input = inputStr
+	}
+	if outputStr := openInferenceValue(attrs, "output.value"); outputStr != "" {
+		output = outputStr
+	}
+
+	return input, output
+}
+
+// ExtractOpenInferenceRootSpanInputOutput extracts input and output from the root span of an OpenInference trace
+// Input: input.value, Output: output.value
+// Returns nil when attributes are not found
+func ExtractOpenInferenceRootSpanInputOutput(rootSpan *Span) (input interface{}, output interface{}) {
+	if rootSpan == nil || rootSpan.Attributes == nil {
+		return nil, nil
+	}
+
+	// Use the generic extraction method
+	return ExtractOpenInferenceSpanInputOutput(rootSpan.Attributes)
+}
+
+// populateOpenInferenceLLMAttributes extracts and populates LLM-specific attributes of an OpenInference span
+func populateOpenInferenceLLMAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
+	// Set common Input/Output fields from llm.input_messages.* and llm.output_messages.*
+	ampAttrs.Input = extractOpenInferenceMessages(attrs, "llm.input_messages.")
+	ampAttrs.Output = extractOpenInferenceMessages(attrs, "llm.output_messages.")
+
+	// Set LLM-specific data
+	ampAttrs.Data = LLMData{
+		Tools:       extractOpenInferenceToolDefinitions(attrs),
+		Model:       extractModelFromAttributes(attrs),
+		Vendor:      extractVendorFromAttributes(attrs),
+		Temperature: extractOpenInferenceTemperature(attrs),
+		TokenUsage:  extractTokenUsageFromAttributes(attrs),
+	}
+}
+
+// populateOpenInferenceEmbeddingAttributes extracts and populates embedding-specific attributes of an OpenInference span
+func populateOpenInferenceEmbeddingAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
+	// Set common Input field (texts to embed) from embedding.embeddings.{index}.embedding.text
+	var texts []string
+	embeddings := collectIndexedAttributes(attrs, "embedding.embeddings.")
+	for _, index := range sortedIndexes(embeddings) {
+		if text, ok := embeddings[index]["embedding.text"].(string); ok && text != "" {
+			texts = append(texts, text)
+		}
+	}
+	ampAttrs.Input = texts
+
+	// Set embedding-specific data
+	embeddingData := EmbeddingData{
+		Vendor:     extractVendorFromAttributes(attrs),
+		TokenUsage: extractTokenUsageFromAttributes(attrs),
+	}
+	if model, ok := attrs["embedding.model_name"].(string); ok {
+		embeddingData.Model = model
+	} else {
+		embeddingData.Model = extractModelFromAttributes(attrs)
+	}
+
+	ampAttrs.Data = embeddingData
+}
+
+// populateOpenInferenceToolAttributes extracts and populates tool-specific attributes of an OpenInference span
+func populateOpenInferenceToolAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
+	// Tool arguments and results are recorded as the input and output of the span
+	ampAttrs.Input, ampAttrs.Output = ExtractOpenInferenceSpanInputOutput(attrs)
+
+	// Set tool-specific data
+	toolData := ToolData{}
+	if name, ok := attrs["tool.name"].(string); ok {
+		toolData.Name = name
+	}
+
+	ampAttrs.Data = toolData
+}
+
+// populateOpenInferenceRetrieverAttributes extracts and populates retriever-specific attributes of an OpenInference span
+func populateOpenInferenceRetrieverAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
+	// Vector DB system and top_k use the same attributes as other instrumentations
+	populateRetrieverAttributes(ampAttrs, attrs)
+
+	// Input is the query, output the retrieved documents
+	if query := openInferenceValue(attrs, "input.value"); query != "" {
+		ampAttrs.Input = query
+	}
+	if documents := extractOpenInferenceDocuments(attrs, "retrieval.documents."); len(documents) > 0 {
+		ampAttrs.Output = documents
+	}
+}
+
+// populateOpenInferenceRerankAttributes extracts and populates reranker-specific attributes of an OpenInference span
+func populateOpenInferenceRerankAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
+	// Input is the query, output the reranked documents
+	if query, ok := attrs["reranker.query"].(string); ok && query != "" {
+		ampAttrs.Input = query
+	} else if query := openInferenceValue(attrs, "input.value"); query != "" {
+		ampAttrs.Input = query
+	}
+	if documents := extractOpenInferenceDocuments(attrs, "reranker.output_documents."); len(documents) > 0 {
+		ampAttrs.Output = documents
+	}
+}
+
+// populateOpenInferenceAgentAttributes extracts and populates agent-specific attributes of an OpenInference span
+func populateOpenInferenceAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
+	ampAttrs.Input, ampAttrs.Output = ExtractOpenInferenceSpanInputOutput(attrs)
+
+	// Set agent-specific data
+	agentData := AgentData{
+		Model:      extractModelFromAttributes(attrs),
+		TokenUsage: extractTokenUsageFromAttributes(attrs),
+	}
+	if name, ok := attrs["agent.name"].(string); ok {
+		agentData.Name = name
+	}
+
+	ampAttrs.Data = agentData
+}
+
+// extractOpenInferenceMessages extracts and orders messages in OpenInference format
+// Format: {prefix}{index}.message.{field}, where the content is either message.content or the text
+// parts in message.contents.{index}.message_content.*, and tool calls are recorded in
+// message.tool_calls.{index}.tool_call.{id,function.name,function.arguments}
+func extractOpenInferenceMessages(attrs map[string]interface{}, prefix string) []PromptMessage {
+	indexedMessages := collectIndexedAttributes(attrs, prefix)
+	if len(indexedMessages) == 0 {
+		return nil
+	}
+
+	messages := make([]PromptMessage, 0, len(indexedMessages))
+	for _, index := range sortedIndexes(indexedMessages) {
+		fields := indexedMessages[index]
+
+		msg := PromptMessage{}
+		if role, ok := fields["message.role"].(string); ok {
+			msg.Role = role
+		}
+
+		// Content is either a single string or a list of content parts
+		if content, ok := fields["message.content"].(string); ok && content != "" {
+			msg.Content = content
+		} else {
+			var parts []string
+			contents := collectIndexedAttributes(fields, "message.contents.")
+			for _, partIndex := range sortedIndexes(contents) {
+				part := contents[partIndex]
+				if partType, ok := part["message_content.type"].(string); ok && partType != "text" {
+					continue
+				}
+				if text, ok := part["message_content.text"].(string); ok && text != "" {
+					parts = append(parts, text)
+				}
+			}
+			msg.Content = strings.Join(parts, "\n")
+		}
+
+		// Tool calls made by the assistant
+		toolCalls := collectIndexedAttributes(fields, "message.tool_calls.")
+		for _, toolIndex := range sortedIndexes(toolCalls) {
+			toolCall := toolCalls[toolIndex]
+			tc := ToolCall{}
+			if id, ok := toolCall["tool_call.id"].(string); ok {
+				tc.ID = id
+			}
+			if name, ok := toolCall["tool_call.function.name"].(string); ok {
+				tc.Name = name
+			}
+			if args, ok := toolCall["tool_call.function.arguments"].(string); ok {
+				tc.Arguments = args
+			}
+			if tc.Name != "" {
+				msg.ToolCalls = append(msg.ToolCalls, tc)
+			}
+		}
+
+		// Only add messages with a role, like the other formats
+		if msg.Role != "" {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages
+}
+
+// extractOpenInferenceToolDefinitions extracts tool definitions in OpenInference format
+// Format: llm.tools.{index}.tool.json_schema, a JSON object that is either the tool itself
+// {"name": "...", "description": "...", "parameters": {...}} or wraps it as
+// {"type": "function", "function": {...}} like the OpenAI API does
+func extractOpenInferenceToolDefinitions(attrs map[string]interface{}) []ToolDefinition {
+	indexedTools := collectIndexedAttributes(attrs, "llm.tools.")
+	if len(indexedTools) == 0 {
+		return nil
+	}
+
+	tools := make([]ToolDefinition, 0, len(indexedTools))
+	for _, index := range sortedIndexes(indexedTools) {
+		schemaJSON, ok := indexedTools[index]["tool.json_schema"].(string)
+		if !ok || schemaJSON == "" {
+			continue
+		}
+
+		var schema map[string]interface{}
+		if err := json.Unmarshal([]byte(schemaJSON), &schema); err != nil {
+			continue
+		}
+		if function, ok := schema["function"].(map[string]interface{}); ok {
+			schema = function
+		}
+
+		tool := ToolDefinition{}
+		if name, ok := schema["name"].(string); ok {
+			tool.Name = name
+		}
+		if desc, ok := schema["description"].(string); ok {
+			tool.Description = desc
+		}
+
+		// Parameters are called input_schema by Anthropic
+		params, ok := schema["parameters"]
+		if !ok {
+			params, ok = schema["input_schema"]
+		}
+		if ok {
+			if paramsStr, isString := params.(string); isString {
+				tool.Parameters = paramsStr
+			} else if paramsBytes, err := json.Marshal(params); err == nil {
+				tool.Parameters = string(paramsBytes)
+			}
+		}
+
+		// Only add tool if it has a name
+		if tool.Name != "" {
+			tools = append(tools, tool)
+		}
+	}
+
+	return tools
+}
+
+// extractOpenInferenceDocuments extracts documents in OpenInference format
+// Format: {prefix}{index}.document.{id,content,score,metadata}
+func extractOpenInferenceDocuments(attrs map[string]interface{}, prefix string) []RetrievedDocument {
+	indexedDocuments := collectIndexedAttributes(attrs, prefix)
+	if len(indexedDocuments) == 0 {
+		return nil
+	}
+
+	documents := make([]RetrievedDocument, 0, len(indexedDocuments))
+	for _, index := range sortedIndexes(indexedDocuments) {
+		fields := indexedDocuments[index]
+
+		document := RetrievedDocument{}
+		if id, ok := fields["document.id"]; ok && id != nil {
+			document.ID = fmt.Sprint(id)
+		}
+		if content, ok := fields["document.content"].(string); ok {
+			document.Content = content
+		}
+		if score, ok := fields["document.score"].(float64); ok {
+			document.Score = &score
+		}
+		if metadata, ok := fields["document.metadata"]; ok && metadata != nil {
+			if metadataStr, isString := metadata.(string); isString {
+				document.Metadata = metadataStr
+			} else if metadataBytes, err := json.Marshal(metadata); err == nil {
+				document.Metadata = string(metadataBytes)
+			}
+		}
+
+		if document.ID != "" || document.Content != "" {
+			documents = append(documents, document)
+		}
+	}
+
+	return documents
+}
+
+// extractOpenInferenceTemperature extracts the temperature from the llm.invocation_parameters JSON object
+func extractOpenInferenceTemperature(attrs map[string]interface{}) *float64 {
+	paramsJSON, ok := attrs["llm.invocation_parameters"].(string)
+	if !ok || paramsJSON == "" {
+		return nil
+	}
+
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(paramsJSON), &params); err != nil {
+		return nil
+	}
+	if temp, ok := params["temperature"].(float64); ok {
+		return &temp
+	}
+	return nil
+}
+
+// openInferenceValue returns an input.value or output.value attribute as a string.
+// Values that are not strings are returned in their JSON form.
+func openInferenceValue(attrs map[string]interface{}, key string) string {
+	value, ok := attrs[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if valueStr, ok := value.(string); ok {
+		return valueStr
+	}
+	if valueBytes, err := json.Marshal(value); err == nil {
+		return string(valueBytes)
+	}
+	return ""
+}
+
+// collectIndexedAttributes groups flattened list attributes by their index.
+// For the prefix "llm.input_messages.", the attribute llm.input_messages.0.message.role
+// is returned as result[0]["message.role"].
+func collectIndexedAttributes(attrs map[string]interface{}, prefix string) map[int]map[string]interface{} {
+	result := make(map[int]map[string]interface{})
+	for key, value := range attrs {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+		indexStr, field, ok := strings.Cut(rest, ".")
+		if !ok || field == "" {
+			continue
+		}
+		index, err := strconv.Atoi(indexStr)
+		if err != nil || index < 0 {
+			continue
+		}
+		if result[index] == nil {
+			result[index] = make(map[string]interface{})
+		}
+		result[index][field] = value
+	}
+	return result
+}
+
+// sortedIndexes returns the indexes of grouped list attributes in ascending order
+func sortedIndexes(indexed map[int]map[string]interface{}) []int {
+	indexes := make([]int, 0, len(indexed))
+	for index := range indexed {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
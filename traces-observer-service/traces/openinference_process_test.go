@@ -0,0 +1,231 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// loadSpanFixture reads the spans of a trace from testdata and returns them by span ID
+func loadSpanFixture(t *testing.T, name string) map[string]Span {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+	var spans []Span
+	if err := json.Unmarshal(data, &spans); err != nil {
+		t.Fatalf("failed to parse fixture %s: %v", name, err)
+	}
+
+	spansByID := make(map[string]Span, len(spans))
+	for _, span := range spans {
+		spansByID[span.SpanID] = span
+	}
+	return spansByID
+}
+
+// processedSpan returns a copy of a fixture span with its AmpAttributes populated
+func processedSpan(t *testing.T, spans map[string]Span, spanID string) Span {
+	t.Helper()
+
+	span, ok := spans[spanID]
+	if !ok {
+		t.Fatalf("span %s not found in fixture", spanID)
+	}
+	PopulateAmpAttributes(&span)
+	return span
+}
+
+func TestDetermineSpanTypeOpenInference(t *testing.T) {
+	tests := []struct {
+		fixture  string
+		spanID   string
+		expected SpanType
+	}{
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60001", SpanTypeChain},
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60002", SpanTypeChain},
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60003", SpanTypeLLM},
+		{"openinference_langgraph_trace.json", "a1b2c3d4e5f60005", SpanTypeTool},
+		{"openinference_rag_trace.json", "b1b2c3d4e5f60001", SpanTypeAgent},
+		{"openinference_rag_trace.json", "b1b2c3d4e5f60002", SpanTypeEmbedding},
+		{"openinference_rag_trace.json", "b1b2c3d4e5f60003", SpanTypeRetriever},
+		{"openinference_rag_trace.json", "b1b2c3d4e5f60004", SpanTypeRerank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture+"/"+tt.spanID, func(t *testing.T) {
+			span := loadSpanFixture(t, tt.fixture)[tt.spanID]
+			if got := DetermineSpanType(span); got != tt.expected {
+				t.Errorf("DetermineSpanType() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPopulateOpenInferenceLLMAttributes(t *testing.T) {
+	span := processedSpan(t, loadSpanFixture(t, "openinference_langgraph_trace.json"), "a1b2c3d4e5f60003")
+
+	expectedInput := []PromptMessage{
+		{Role: "system", Content: "You are a helpful weather assistant."},
+		{Role: "user", Content: "What is the weather\nin Colombo?"},
+	}
+	if !reflect.DeepEqual(span.AmpAttributes.Input, expectedInput) {
+		t.Errorf("Input = %#v, want %#v", span.AmpAttributes.Input, expectedInput)
+	}
+
+	expectedOutput := []PromptMessage{
+		{
+			Role:      "assistant",
+			ToolCalls: []ToolCall{{ID: "call_1", Name: "get_weather", Arguments: `{"city": "Colombo"}`}},
+		},
+	}
+	if !reflect.DeepEqual(span.AmpAttributes.Output, expectedOutput) {
+		t.Errorf("Output = %#v, want %#v", span.AmpAttributes.Output, expectedOutput)
+	}
+
+	llmData, ok := span.AmpAttributes.Data.(LLMData)
+	if !ok {
+		t.Fatalf("Data = %T, want LLMData", span.AmpAttributes.Data)
+	}
+	if llmData.Model != "gpt-4o-mini-2024-07-18" {
+		t.Errorf("Model = %q, want gpt-4o-mini-2024-07-18", llmData.Model)
+	}
+	if llmData.Vendor != "openai" {
+		t.Errorf("Vendor = %q, want openai", llmData.Vendor)
+	}
+	if llmData.Temperature == nil || *llmData.Temperature != 0.2 {
+		t.Errorf("Temperature = %v, want 0.2", llmData.Temperature)
+	}
+
+	expectedTools := []ToolDefinition{
+		{
+			Name:        "get_weather",
+			Description: "Get the current weather of a city",
+			Parameters:  `{"properties":{"city":{"type":"string"}},"required":["city"],"type":"object"}`,
+		},
+		{Name: "get_time", Description: "Get the local time of a city", Parameters: `{"type":"object"}`},
+	}
+	if !reflect.DeepEqual(llmData.Tools, expectedTools) {
+		t.Errorf("Tools = %#v, want %#v", llmData.Tools, expectedTools)
+	}
+
+	expectedUsage := &LLMTokenUsage{InputTokens: 120, OutputTokens: 18, CacheReadInputTokens: 64, TotalTokens: 138}
+	if !reflect.DeepEqual(llmData.TokenUsage, expectedUsage) {
+		t.Errorf("TokenUsage = %#v, want %#v", llmData.TokenUsage, expectedUsage)
+	}
+}
+
+func TestPopulateOpenInferenceToolAttributes(t *testing.T) {
+	span := processedSpan(t, loadSpanFixture(t, "openinference_langgraph_trace.json"), "a1b2c3d4e5f60005")
+
+	if span.AmpAttributes.Input != `{"city": "Colombo"}` {
+		t.Errorf("Input = %v, want the tool arguments", span.AmpAttributes.Input)
+	}
+	if span.AmpAttributes.Output != "31°C, sunny" {
+		t.Errorf("Output = %v, want the tool result", span.AmpAttributes.Output)
+	}
+	if !reflect.DeepEqual(span.AmpAttributes.Data, ToolData{Name: "get_weather"}) {
+		t.Errorf("Data = %#v, want ToolData for get_weather", span.AmpAttributes.Data)
+	}
+}
+
+func TestPopulateOpenInferenceRetrievalAttributes(t *testing.T) {
+	spans := loadSpanFixture(t, "openinference_rag_trace.json")
+	score := func(value float64) *float64 { return &value }
+
+	t.Run("agent", func(t *testing.T) {
+		span := processedSpan(t, spans, "b1b2c3d4e5f60001")
+		if span.AmpAttributes.Input != "How do I rotate an API key?" {
+			t.Errorf("Input = %v, want the input value", span.AmpAttributes.Input)
+		}
+		if span.AmpAttributes.Output != "Create a new key, update the clients and revoke the old key." {
+			t.Errorf("Output = %v, want the output value", span.AmpAttributes.Output)
+		}
+		if !reflect.DeepEqual(span.AmpAttributes.Data, AgentData{Name: "docs-assistant"}) {
+			t.Errorf("Data = %#v, want AgentData for docs-assistant", span.AmpAttributes.Data)
+		}
+	})
+
+	t.Run("embedding", func(t *testing.T) {
+		span := processedSpan(t, spans, "b1b2c3d4e5f60002")
+		if !reflect.DeepEqual(span.AmpAttributes.Input, []string{"How do I rotate an API key?"}) {
+			t.Errorf("Input = %#v, want the embedded text", span.AmpAttributes.Input)
+		}
+		expectedData := EmbeddingData{
+			Model:      "text-embedding-3-small",
+			Vendor:     "openai",
+			TokenUsage: &LLMTokenUsage{InputTokens: 8, TotalTokens: 8},
+		}
+		if !reflect.DeepEqual(span.AmpAttributes.Data, expectedData) {
+			t.Errorf("Data = %#v, want %#v", span.AmpAttributes.Data, expectedData)
+		}
+	})
+
+	t.Run("retriever", func(t *testing.T) {
+		span := processedSpan(t, spans, "b1b2c3d4e5f60003")
+		if span.AmpAttributes.Input != "How do I rotate an API key?" {
+			t.Errorf("Input = %v, want the query", span.AmpAttributes.Input)
+		}
+		expectedDocuments := []RetrievedDocument{
+			{ID: "doc-17", Content: "API keys can be rotated from the settings page.", Score: score(0.91), Metadata: `{"source": "keys.md"}`},
+			{ID: "42", Content: "Revoke old keys once clients are updated.", Score: score(0.78)},
+		}
+		if !reflect.DeepEqual(span.AmpAttributes.Output, expectedDocuments) {
+			t.Errorf("Output = %#v, want %#v", span.AmpAttributes.Output, expectedDocuments)
+		}
+		if !reflect.DeepEqual(span.AmpAttributes.Data, RetrieverData{VectorDB: "chroma"}) {
+			t.Errorf("Data = %#v, want RetrieverData for chroma", span.AmpAttributes.Data)
+		}
+	})
+
+	t.Run("reranker", func(t *testing.T) {
+		span := processedSpan(t, spans, "b1b2c3d4e5f60004")
+		if span.AmpAttributes.Input != "How do I rotate an API key?" {
+			t.Errorf("Input = %v, want the query", span.AmpAttributes.Input)
+		}
+		expectedDocuments := []RetrievedDocument{
+			{ID: "doc-17", Content: "API keys can be rotated from the settings page.", Score: score(0.97)},
+		}
+		if !reflect.DeepEqual(span.AmpAttributes.Output, expectedDocuments) {
+			t.Errorf("Output = %#v, want %#v", span.AmpAttributes.Output, expectedDocuments)
+		}
+	})
+}
+
+func TestExtractModelTokenUsageOpenInference(t *testing.T) {
+	spans := loadSpanFixture(t, "openinference_langgraph_trace.json")
+	traceSpans := make([]Span, 0, len(spans))
+	for _, span := range spans {
+		traceSpans = append(traceSpans, span)
+	}
+
+	expectedUsage := []ModelTokenUsage{
+		{Vendor: "openai", Model: "gpt-4o-mini-2024-07-18", InputTokens: 120, OutputTokens: 18, TotalTokens: 138},
+	}
+	if got := ExtractModelTokenUsage(traceSpans); !reflect.DeepEqual(got, expectedUsage) {
+		t.Errorf("ExtractModelTokenUsage() = %#v, want %#v", got, expectedUsage)
+	}
+	if got := ExtractTokenUsage(traceSpans); !reflect.DeepEqual(got, &TokenUsage{InputTokens: 120, OutputTokens: 18, TotalTokens: 138}) {
+		t.Errorf("ExtractTokenUsage() = %#v, want 120 input and 18 output tokens", got)
+	}
+}
@@ -14,94 +14,19 @@
 // specific language governing permissions and limitations
 // under the License.
 
-package opensearch
+package traces
 
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
-	"time"
 )
 
-// ParseSpans converts OpenSearch response to Span structs
-func ParseSpans(response *SearchResponse) []Span {
-	spans := make([]Span, 0, len(response.Hits.Hits))
-
-	for _, hit := range response.Hits.Hits {
-		span := parseSpan(hit.Source)
-		spans = append(spans, span)
-	}
-
-	return spans
-}
-
-// parseSpan extracts span information from a source document
-func parseSpan(source map[string]interface{}) Span {
-	span := Span{}
-
-	// Try standard OTEL fields first
-	if traceID, ok := source["traceId"].(string); ok {
-		span.TraceID = traceID
-	}
-	if spanID, ok := source["spanId"].(string); ok {
-		span.SpanID = spanID
-	}
-	if parentSpanID, ok := source["parentSpanId"].(string); ok {
-		span.ParentSpanID = parentSpanID
-	}
-	if name, ok := source["name"].(string); ok {
-		span.Name = name
-	}
-	if kind, ok := source["kind"].(string); ok {
-		span.Kind = kind
-	}
-
-	// Extract component UID from resource
-	if resource, ok := source["resource"].(map[string]interface{}); ok {
-		if componentUid, ok := resource["openchoreo.dev/component-uid"].(string); ok {
-			span.Service = componentUid
-		}
-
-		// Store the complete resource object
-		span.Resource = resource
-	}
-
-	// Parse timestamps
-	if startTime, ok := source["startTime"].(string); ok {
-		if t, err := time.Parse(time.RFC3339Nano, startTime); err == nil {
-			span.StartTime = t
-		}
-	}
-	if endTime, ok := source["endTime"].(string); ok {
-		if t, err := time.Parse(time.RFC3339Nano, endTime); err == nil {
-			span.EndTime = t
-		}
-	}
-
-	// Parse duration - try durationInNanos field first
-	if duration, ok := source["durationInNanos"].(float64); ok {
-		span.DurationInNanos = int64(duration)
-	} else if !span.StartTime.IsZero() && !span.EndTime.IsZero() {
-		// Fallback: calculate duration from timestamps if durationInNanos not present
-		span.DurationInNanos = span.EndTime.Sub(span.StartTime).Nanoseconds()
-	}
-
-	// Parse status
-	if status, ok := source["status"].(map[string]interface{}); ok {
-		if code, ok := status["code"].(string); ok {
-			span.Status = code
-		} else if code, ok := status["code"].(float64); ok {
-			span.Status = fmt.Sprintf("%d", int(code))
-		}
-	}
-
-	// Parse attributes
-	if attributes, ok := source["attributes"].(map[string]interface{}); ok {
-		span.Attributes = attributes
-	}
-
+// PopulateAmpAttributes determines the semantic type of a span and adds the attributes of that type to its AmpAttributes
+func PopulateAmpAttributes(span *Span) {
 	// Determine and add the semantic span type to AmpAttributes
-	spanType := DetermineSpanType(span)
+	spanType := DetermineSpanType(*span)
 
 	ampAttrs := &AmpAttributes{
 		Kind: string(spanType),
@@ -109,35 +34,46 @@ func parseSpan(source map[string]interface{}) Span {
 
 	// Populate span-type-specific attributes
 	if span.Attributes != nil {
-		switch spanType {
-		case SpanTypeLLM:
-			populateLLMAttributes(ampAttrs, span.Attributes)
-		case SpanTypeTool:
-			populateToolAttributes(ampAttrs, span.Attributes, span.Status)
-		case SpanTypeEmbedding:
-			populateEmbeddingAttributes(ampAttrs, span.Attributes)
-		case SpanTypeRetriever:
-			populateRetrieverAttributes(ampAttrs, span.Attributes)
-		case SpanTypeAgent:
-			// Check if this is a CrewAI workflow span and delegate to CrewAI processor
-			if IsCrewAISpan(span.Attributes) {
-				PopulateCrewAIAgentAttributes(ampAttrs, span.Attributes)
-			} else {
-				populateAgentAttributes(ampAttrs, span.Attributes)
-			}
-		case SpanTypeCrewAITask:
-			populateCrewAITaskAttributes(ampAttrs, span.Attributes)
-		case SpanTypeChain:
-			populateChainAttributes(ampAttrs, span.Attributes)
+		switch {
+		case IsLangGraphSpan(*span):
+			// LangGraph graph and node spans are delegated to the LangGraph processor
+			PopulateLangGraphAttributes(ampAttrs, *span)
+		case IsOpenInferenceSpan(span.Attributes):
+			// OpenInference spans are delegated to the OpenInference processor
+			PopulateOpenInferenceAttributes(ampAttrs, span.Attributes, spanType)
+		default:
+			populateSpanTypeAttributes(ampAttrs, span, spanType)
 		}
-
 	}
 
 	// Extract error status for all span types
-	ampAttrs.Status = extractSpanStatus(span.Attributes, span.Status)
+	ampAttrs.Status = extractSpanStatus(*span)
 	span.AmpAttributes = ampAttrs
+}
 
-	return span
+// populateSpanTypeAttributes populates the attributes of a span of the given type in the gen_ai, Traceloop or CrewAI formats
+func populateSpanTypeAttributes(ampAttrs *AmpAttributes, span *Span, spanType SpanType) {
+	switch spanType {
+	case SpanTypeLLM:
+		populateLLMAttributes(ampAttrs, span.Attributes)
+	case SpanTypeTool:
+		populateToolAttributes(ampAttrs, span.Attributes, span.Status)
+	case SpanTypeEmbedding:
+		populateEmbeddingAttributes(ampAttrs, span.Attributes)
+	case SpanTypeRetriever:
+		populateRetrieverAttributes(ampAttrs, span.Attributes)
+	case SpanTypeAgent:
+		// Check if this is a CrewAI workflow span and delegate to CrewAI processor
+		if IsCrewAISpan(span.Attributes) {
+			PopulateCrewAIAgentAttributes(ampAttrs, span.Attributes)
+		} else {
+			populateAgentAttributes(ampAttrs, span.Attributes)
+		}
+	case SpanTypeCrewAITask:
+		populateCrewAITaskAttributes(ampAttrs, span.Attributes)
+	case SpanTypeChain:
+		populateChainAttributes(ampAttrs, span.Attributes)
+	}
 }
 
 // populateLLMAttributes extracts and populates LLM-specific attributes
@@ -152,16 +88,10 @@ func populateLLMAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}
 	}
 
 	// Extract model information
-	if responseModel, ok := attrs["gen_ai.response.model"].(string); ok {
-		llmData.Model = responseModel
-	} else if requestModel, ok := attrs["gen_ai.request.model"].(string); ok {
-		llmData.Model = requestModel
-	}
+	llmData.Model = extractModelFromAttributes(attrs)
 
-	// Extract vendor (gen_ai.system)
-	if vendor, ok := attrs["gen_ai.system"].(string); ok {
-		llmData.Vendor = vendor
-	}
+	// Extract vendor
+	llmData.Vendor = extractVendorFromAttributes(attrs)
 
 	// Extract temperature
 	if temp, ok := attrs["gen_ai.request.temperature"].(float64); ok {
@@ -406,61 +336,75 @@ func extractAgentSystemPrompt(attrs map[string]interface{}) string {
 }
 
 // extractSpanStatus determines the error status of a span
-func extractSpanStatus(attrs map[string]interface{}, spanStatus string) *SpanStatus {
+// The type and message of the error are taken from the exception event of the span when it has one
+func extractSpanStatus(span Span) *SpanStatus {
 	status := &SpanStatus{
 		Error: false,
 	}
 
-	if attrs != nil {
-		if errorType, ok := attrs["error.type"].(string); ok {
-			status.Error = true
-			status.ErrorType = errorType
-			return status
-		}
+	attrs := span.Attributes
+	if errorType, ok := attrs["error.type"].(string); ok {
+		status.Error = true
+		status.ErrorType = errorType
+	} else if toolStatus, ok := attrs["gen_ai.tool.status"].(string); ok && isErrorStatus(toolStatus) {
+		status.Error = true
+		status.ErrorType = "ToolExecutionError"
+	} else if httpStatus, ok := attrs["http.status_code"].(float64); ok && int(httpStatus) >= 400 {
+		status.Error = true
+		status.ErrorType = fmt.Sprintf("%d", int(httpStatus))
+	} else if isErrorStatus(span.Status) {
+		// Fallback to span status if no error attributes found
+		status.Error = true
+	}
 
-		if toolStatus, ok := attrs["gen_ai.tool.status"].(string); ok && isErrorStatus(toolStatus) {
-			status.Error = true
-			status.ErrorType = "ToolExecutionError"
-			return status
-		}
+	if !status.Error {
+		return status
+	}
 
-		if httpStatus, ok := attrs["http.status_code"].(float64); ok && int(httpStatus) >= 400 {
-			status.Error = true
-			status.ErrorType = fmt.Sprintf("%d", int(httpStatus))
-			return status
+	// Exception events describe the error better than the status, e.g. ValueError: invalid city
+	if exception := lastExceptionEvent(span.Events); exception != nil {
+		if exceptionType, ok := exception.Attributes["exception.type"].(string); ok && status.ErrorType == "" {
+			status.ErrorType = exceptionType
+		}
+		if exceptionMessage, ok := exception.Attributes["exception.message"].(string); ok {
+			status.ErrorMessage = exceptionMessage
 		}
 	}
-
-	// Fallback to span status if no error attributes found
-	if isErrorStatus(spanStatus) {
-		status.Error = true
+	if status.ErrorMessage == "" {
+		status.ErrorMessage = span.StatusMessage
 	}
 
 	return status
 }
 
 // extractTokenUsageFromAttributes extracts token usage from span attributes
-// Supports both standard gen_ai.usage.* and legacy prompt_tokens/completion_tokens attributes
+// Supports standard gen_ai.usage.*, legacy prompt_tokens/completion_tokens and OpenInference llm.token_count.* attributes
 func extractTokenUsageFromAttributes(attrs map[string]interface{}) *LLMTokenUsage {
 	var inputTokens, outputTokens, cacheReadTokens int
 
-	// Try to extract input tokens (gen_ai.usage.input_tokens or gen_ai.usage.prompt_tokens)
+	// Try to extract input tokens (gen_ai.usage.input_tokens, gen_ai.usage.prompt_tokens or llm.token_count.prompt)
 	if val, ok := attrs["gen_ai.usage.input_tokens"].(float64); ok {
 		inputTokens = int(val)
 	} else if val, ok := attrs["gen_ai.usage.prompt_tokens"].(float64); ok {
 		inputTokens = int(val)
+	} else if val, ok := attrs["llm.token_count.prompt"].(float64); ok {
+		inputTokens = int(val)
 	}
 
-	// Try to extract output tokens (gen_ai.usage.output_tokens or gen_ai.usage.completion_tokens)
+	// Try to extract output tokens (gen_ai.usage.output_tokens, gen_ai.usage.completion_tokens or llm.token_count.completion)
 	if val, ok := attrs["gen_ai.usage.output_tokens"].(float64); ok {
 		outputTokens = int(val)
 	} else if val, ok := attrs["gen_ai.usage.completion_tokens"].(float64); ok {
 		outputTokens = int(val)
+	} else if val, ok := attrs["llm.token_count.completion"].(float64); ok {
+		outputTokens = int(val)
 	}
 
 	// Try to extract cache read tokens
 	if val, ok := attrs["gen_ai.usage.cache_read_input_tokens"].(float64); ok {
 		cacheReadTokens = int(val)
+	} else if val, ok := attrs["llm.token_count.prompt_details.cache_read"].(float64); ok {
+		cacheReadTokens = int(val)
 	}
 
 	// Only return token usage if we found some tokens
@@ -503,14 +447,87 @@ func ExtractTokenUsage(spans []Span) *TokenUsage {
 	return nil
 }
 
+// extractModelFromAttributes returns the model of a GenAI span, preferring the response model
+// OpenInference spans record the model in llm.model_name
+func extractModelFromAttributes(attrs map[string]interface{}) string {
+	if responseModel, ok := attrs["gen_ai.response.model"].(string); ok {
+		return responseModel
+	} else if requestModel, ok := attrs["gen_ai.request.model"].(string); ok {
+		return requestModel
+	} else if modelName, ok := attrs["llm.model_name"].(string); ok {
+		return modelName
+	}
+	return ""
+}
+
+// extractVendorFromAttributes returns the vendor of a GenAI span (gen_ai.system)
+// OpenInference spans record the vendor in llm.provider and the API in llm.system
+func extractVendorFromAttributes(attrs map[string]interface{}) string {
+	for _, key := range []string{"gen_ai.system", "llm.provider", "llm.system"} {
+		if vendor, ok := attrs[key].(string); ok && vendor != "" {
+			return vendor
+		}
+	}
+	return ""
+}
+
+// ExtractModelTokenUsage aggregates token usage from GenAI spans in a trace per vendor and model.
+// The result is sorted by vendor and model; spans without a model are not included.
+func ExtractModelTokenUsage(spans []Span) []ModelTokenUsage {
+	type modelKey struct {
+		vendor string
+		model  string
+	}
+	usageByModel := make(map[modelKey]*ModelTokenUsage)
+
+	for _, span := range spans {
+		if span.Attributes == nil {
+			continue
+		}
+		model := extractModelFromAttributes(span.Attributes)
+		if model == "" {
+			continue
+		}
+		usage := extractTokenUsageFromAttributes(span.Attributes)
+		if usage == nil {
+			continue
+		}
+
+		vendor := extractVendorFromAttributes(span.Attributes)
+		key := modelKey{vendor: vendor, model: model}
+		modelUsage, ok := usageByModel[key]
+		if !ok {
+			modelUsage = &ModelTokenUsage{Vendor: vendor, Model: model}
+			usageByModel[key] = modelUsage
+		}
+		modelUsage.InputTokens += usage.InputTokens
+		modelUsage.OutputTokens += usage.OutputTokens
+		modelUsage.TotalTokens += usage.TotalTokens
+	}
+
+	if len(usageByModel) == 0 {
+		return nil
+	}
+
+	result := make([]ModelTokenUsage, 0, len(usageByModel))
+	for _, modelUsage := range usageByModel {
+		result = append(result, *modelUsage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Vendor != result[j].Vendor {
+			return result[i].Vendor < result[j].Vendor
+		}
+		return result[i].Model < result[j].Model
+	})
+	return result
+}
+
 // ExtractTraceStatus analyzes spans to determine trace status and error information
 func ExtractTraceStatus(spans []Span) *TraceStatus {
 	var errorCount int
 
 	for _, span := range spans {
-		// Use extractSpanStatus to check for errors
-		spanStatus := extractSpanStatus(span.Attributes, span.Status)
-		if spanStatus.Error {
+		if SpanHasError(span) {
 			errorCount++
 		}
 	}
@@ -520,6 +537,11 @@ func ExtractTraceStatus(spans []Span) *TraceStatus {
 	}
 }
 
+// SpanHasError checks whether a span has an error status, see extractSpanStatus
+func SpanHasError(span Span) bool {
+	return extractSpanStatus(span).Error
+}
+
 // isErrorStatus checks if a status string indicates an error
 func isErrorStatus(status string) bool {
 	// Check for common error status values
@@ -1277,6 +1299,11 @@ func DetermineSpanType(span Span) SpanType {
 		return SpanTypeCrewAITask
 	}
 
+	// LangGraph graph and node runs are chains, whatever instrumentation recorded them
+	if IsLangGraphSpan(span) {
+		return SpanTypeChain
+	}
+
 	// First, check if Traceloop has already set the span kind
 	if traceloopKind, ok := span.Attributes["traceloop.span.kind"].(string); ok {
 		switch traceloopKind {
@@ -1297,6 +1324,13 @@ func DetermineSpanType(span Span) SpanType {
 		}
 	}
 
+	// Then, check if OpenInference has set the span kind
+	if IsOpenInferenceSpan(span.Attributes) {
+		if spanType := determineOpenInferenceSpanType(span.Attributes); spanType != SpanTypeUnknown {
+			return spanType
+		}
+	}
+
 	// Fallback to attribute-based detection if traceloop.span.kind is not present
 	// Check for LLM operations
 	if hasLLMAttributes(span.Attributes) {
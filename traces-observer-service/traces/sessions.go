@@ -0,0 +1,99 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"sort"
+	"time"
+)
+
+// defaultSessionIDAttributes are the attributes that identify the session or conversation of a span
+var defaultSessionIDAttributes = []string{
+	"session.id",
+	"gen_ai.conversation.id",
+}
+
+// SessionIDAttributes returns the attributes that identify the session of a span.
+// A configured attribute takes precedence over the default ones.
+func SessionIDAttributes(configured string) []string {
+	attributes := make([]string, 0, len(defaultSessionIDAttributes)+1)
+	if configured != "" {
+		attributes = append(attributes, configured)
+	}
+	for _, attribute := range defaultSessionIDAttributes {
+		if attribute != configured {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+// ExtractSessionID returns the session ID of a span from the first session attribute it has
+func ExtractSessionID(attrs map[string]interface{}, sessionAttributes []string) string {
+	for _, attribute := range sessionAttributes {
+		if value, ok := attrs[attribute].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// SessionTraces holds the traces of a session found by scanning spans with a session ID
+type SessionTraces struct {
+	SessionID    string
+	TraceIDs     []string  // Traces of the session, in the order their first span was seen
+	LastActivity time.Time // Start time of the latest span of the session
+}
+
+// SortSessionsByActivity sorts sessions by their latest activity, most recent first
+func SortSessionsByActivity(sessions []*SessionTraces) {
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].LastActivity.Equal(sessions[j].LastActivity) {
+			return sessions[i].LastActivity.After(sessions[j].LastActivity)
+		}
+		return sessions[i].SessionID < sessions[j].SessionID
+	})
+}
+
+// BuildSessionOverview summarizes a session from its turns, which must be ordered by start time
+func BuildSessionOverview(sessionID string, turns []TraceOverview) SessionOverview {
+	overview := SessionOverview{
+		SessionID: sessionID,
+		TurnCount: len(turns),
+	}
+	if len(turns) == 0 {
+		return overview
+	}
+
+	overview.StartTime = turns[0].StartTime
+	var endTime time.Time
+	for _, turn := range turns {
+		if turn.TokenUsage != nil {
+			overview.TokenUsage.InputTokens += turn.TokenUsage.InputTokens
+			overview.TokenUsage.OutputTokens += turn.TokenUsage.OutputTokens
+			overview.TokenUsage.TotalTokens += turn.TokenUsage.TotalTokens
+		}
+		if turn.Status != nil && turn.Status.ErrorCount > 0 {
+			overview.ErrorCount++
+		}
+		if turnEnd, err := time.Parse(time.RFC3339Nano, turn.EndTime); err == nil && turnEnd.After(endTime) {
+			endTime = turnEnd
+			overview.EndTime = turn.EndTime
+		}
+	}
+	return overview
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// TraceStore reads spans from a trace storage backend.
+// Spans are returned with their AmpAttributes populated; summarizing traces is left to the caller.
+type TraceStore interface {
+	// FindRootSpans returns a page of the root spans matching params, sorted by start time and span ID
+	// in params.SortOrder. The page continues from params.Cursor, or starts at params.Offset when there
+	// is no cursor. A cursor that cannot be decoded is reported as ErrInvalidCursor.
+	FindRootSpans(ctx context.Context, params TraceQueryParams) (*RootSpanPage, error)
+
+	// FindTraceIDsBySpanFilters returns the IDs of up to limit traces that have at least one span
+	// matching the span filters and time range of params
+	FindTraceIDsBySpanFilters(ctx context.Context, params TraceQueryParams, limit int) (*SpanFilterMatches, error)
+
+	// GetTraceSpans returns the spans of the given traces that belong to the component and environment
+	// in params, grouped by trace ID and sorted by start time. The time range of params tells the store
+	// where to look for the traces; it does not filter their spans.
+	GetTraceSpans(ctx context.Context, traceIDs []string, params TraceQueryParams) (*TraceSpans, error)
+
+	// GetTrace returns the spans of a trace that belong to the component and environment in params,
+	// sorted by start time in params.SortOrder. The time range of params tells the store where to
+	// look for the trace, like for GetTraceSpans.
+	GetTrace(ctx context.Context, params TraceByIdAndServiceParams) ([]Span, error)
+
+	// FindSessionSpans returns the spans matching params that carry one of the session attributes,
+	// sorted by start time. When params.SessionID is set only the spans of that session are returned.
+	FindSessionSpans(ctx context.Context, params SessionQueryParams, sessionAttributes []string) (*SessionSpans, error)
+
+	// GetMetrics aggregates the request, error, latency and token metrics of a component
+	GetMetrics(ctx context.Context, params MetricsQueryParams) (*MetricsResponse, error)
+
+	// GetTokenUsage aggregates the daily token usage of components per vendor and model
+	GetTokenUsage(ctx context.Context, params TokenUsageQueryParams) (*TokenUsageResponse, error)
+
+	// WriteSpans stores spans, such as the spans received by the OTLP receiver
+	WriteSpans(ctx context.Context, spans []Span) error
+
+	// HealthCheck checks whether the store is reachable
+	HealthCheck(ctx context.Context) error
+}
+
+// SpanFilterMatches is the traces returned by TraceStore.FindTraceIDsBySpanFilters
+type SpanFilterMatches struct {
+	TraceIDs  []string
+	Truncated bool // The search stopped at a limit, so traces with matching spans may be missing
+}
+
+// TraceSpans is the spans returned by TraceStore.GetTraceSpans, grouped by trace ID
+type TraceSpans struct {
+	Spans     map[string][]Span
+	Truncated bool // The search stopped at a limit, so some spans of the traces may be missing
+}
+
+// SessionSpans is the spans returned by TraceStore.FindSessionSpans
+type SessionSpans struct {
+	Spans     []Span
+	Truncated bool // The search stopped at a limit, so spans of some sessions may be missing
+}
+
+// RootSpanPage is a page of root spans returned by TraceStore.FindRootSpans
+type RootSpanPage struct {
+	Spans      []Span
+	TotalCount int    // Number of distinct traces matching the query across all pages
+	NextCursor string // Cursor for the next page, empty on the last page
+}
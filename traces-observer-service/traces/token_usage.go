@@ -0,0 +1,42 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import "sort"
+
+// MaxTokenUsageComponents is the maximum number of components in a token usage query
+const MaxTokenUsageComponents = 500
+
+// MaxTokenUsageDays is the maximum number of days covered by a token usage query
+const MaxTokenUsageDays = 366
+
+// SortDailyTokenUsage sorts token usage by component, date, vendor and model
+func SortDailyTokenUsage(usage []DailyTokenUsage) {
+	sort.Slice(usage, func(i, j int) bool {
+		a, b := usage[i], usage[j]
+		if a.ComponentUid != b.ComponentUid {
+			return a.ComponentUid < b.ComponentUid
+		}
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.Vendor != b.Vendor {
+			return a.Vendor < b.Vendor
+		}
+		return a.Model < b.Model
+	})
+}
@@ -0,0 +1,393 @@
+// Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traces
+
+import "time"
+
+// MaxTraceComponents is the maximum number of components in a trace query
+const MaxTraceComponents = 500
+
+// TraceQueryParams holds parameters for trace queries
+type TraceQueryParams struct {
+	ComponentUids  []string // Traces of any of these components are matched
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Limit          int
+	Offset         int
+	SortOrder      string
+	Cursor         string       // Opaque cursor from a previous page, takes the place of Offset
+	OrgName        string       // Organization whose redaction policy applies to the results
+	Filters        TraceFilters // Optional filters on the traces
+	TraceIDs       []string     // Restricts results to these traces, set after span filters are resolved
+}
+
+// TraceFilters holds optional filters for trace queries.
+// Span filters (all except the duration filters) select traces that contain
+// at least one span matching all of them; duration filters apply to the whole trace.
+type TraceFilters struct {
+	SpanKind    SpanType          // Semantic span kind as determined by DetermineSpanType
+	ErrorsOnly  bool              // Only spans with an error status
+	Model       string            // Model requested or used by the span
+	ToolName    string            // Name of the tool called by the span
+	Search      string            // Case-insensitive text searched in span input and output attributes
+	Attributes  map[string]string // Span attributes that must have exactly these values
+	MinDuration time.Duration     // Minimum trace duration
+	MaxDuration time.Duration     // Maximum trace duration
+	TraceIDs    []string          // Only traces with these IDs
+}
+
+// MetricsQueryParams holds parameters for agent metrics queries
+type MetricsQueryParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      time.Time
+	EndTime        time.Time
+	Interval       time.Duration // Width of each time bucket
+}
+
+// TokenUsageQueryParams holds parameters for daily token usage queries
+type TokenUsageQueryParams struct {
+	ComponentUids  []string
+	EnvironmentUid string // Optional, all environments when empty
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// SessionQueryParams holds parameters for session queries
+type SessionQueryParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Limit          int
+	Offset         int
+	SessionID      string // Restricts results to a single session
+	OrgName        string // Organization whose redaction policy applies to the results
+}
+
+// TraceByIdAndServiceParams holds parameters for querying by both traceId and componentUid
+type TraceByIdAndServiceParams struct {
+	TraceID        string
+	ComponentUids  []string // Spans of any of these components are returned
+	EnvironmentUid string
+	StartTime      string // Start of the time range searched for the trace
+	EndTime        string // End of the time range searched for the trace
+	SortOrder      string
+	Limit          int
+	OrgName        string // Organization whose redaction policy applies to the results
+}
+
+// Span represents a single trace span
+type Span struct {
+	TraceID         string                 `json:"traceId"`
+	SpanID          string                 `json:"spanId"`
+	ParentSpanID    string                 `json:"parentSpanId,omitempty"`
+	Name            string                 `json:"name"`
+	Service         string                 `json:"service"`
+	StartTime       time.Time              `json:"startTime"`
+	EndTime         time.Time              `json:"endTime,omitempty"`
+	DurationInNanos int64                  `json:"durationInNanos"` // in nanoseconds
+	Kind            string                 `json:"kind,omitempty"`
+	Status          string                 `json:"status,omitempty"`
+	StatusMessage   string                 `json:"statusMessage,omitempty"` // Description of the status, usually set for errors
+	Attributes      map[string]interface{} `json:"attributes,omitempty"`
+	Resource        map[string]interface{} `json:"resource,omitempty"`
+	Events          []SpanEvent            `json:"events,omitempty"`        // Events recorded during the span, in recording order
+	Links           []SpanLink             `json:"links,omitempty"`         // Links to spans of the same or other traces
+	AmpAttributes   *AmpAttributes         `json:"ampAttributes,omitempty"` // Custom AMP-specific attributes
+	Redaction       *Redaction             `json:"redaction,omitempty"`     // Set when sensitive values were redacted
+}
+
+// SpanEvent represents an event recorded during a span, such as an exception or a streamed token
+type SpanEvent struct {
+	Name       string                 `json:"name"`
+	Time       time.Time              `json:"time"`
+	Kind       SpanEventKind          `json:"kind"` // Semantic kind of the event, see ClassifySpanEvents
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// SpanEventKind represents the semantic kind of a span event
+type SpanEventKind string
+
+const (
+	SpanEventKindException SpanEventKind = "exception" // Exception recorded with exception.type, exception.message and exception.stacktrace
+	SpanEventKindToken     SpanEventKind = "token"     // Token or chunk streamed by an LLM
+	SpanEventKindCustom    SpanEventKind = "custom"    // Any other event
+)
+
+// SpanLink represents a link from a span to another span, e.g. the span that handed work off to an async agent
+type SpanLink struct {
+	TraceID    string                 `json:"traceId"`
+	SpanID     string                 `json:"spanId"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Redaction records that sensitive values were removed from a span or trace, without revealing them
+type Redaction struct {
+	Policy string   `json:"policy"` // Name of the redaction policy that was applied
+	Count  int      `json:"count"`  // Number of redacted values
+	Types  []string `json:"types"`  // Kinds of the redacted values, e.g. email, phone or attribute
+}
+
+// AmpAttributes holds custom attributes added by the AMP platform
+type AmpAttributes struct {
+	Kind   string      `json:"kind"`             // Semantic span kind: llm, tool, embedding, retriever, rerank, agent, task, unknown
+	Input  interface{} `json:"input,omitempty"`  // Input data (type varies by kind)
+	Output interface{} `json:"output,omitempty"` // Output data (type varies by kind)
+	Status *SpanStatus `json:"status,omitempty"` // Execution status with error information
+	Data   interface{} `json:"data,omitempty"`   // Kind-specific data: *LLMData, *ToolData, *EmbeddingData, *RetrieverData, etc.
+}
+
+// LLMData contains LLM-specific span information
+type LLMData struct {
+	Tools       []ToolDefinition `json:"tools,omitempty"`       // Available tools/functions
+	Model       string           `json:"model,omitempty"`       // Model name (gen_ai.response.model or gen_ai.request.model)
+	Vendor      string           `json:"vendor,omitempty"`      // LLM vendor/provider (gen_ai.system)
+	Temperature *float64         `json:"temperature,omitempty"` // Temperature parameter
+	TokenUsage  *LLMTokenUsage   `json:"tokenUsage,omitempty"`  // Token usage details
+}
+
+// ToolData contains tool execution span information
+type ToolData struct {
+	Name string `json:"name,omitempty"` // Tool/function name
+}
+
+// EmbeddingData contains embedding generation span information
+// EmbeddingData contains embedding generation span information
+type EmbeddingData struct {
+	Model      string         `json:"model,omitempty"`      // Embedding model name
+	Vendor     string         `json:"vendor,omitempty"`     // Embedding vendor/provider (gen_ai.system)
+	TokenUsage *LLMTokenUsage `json:"tokenUsage,omitempty"` // Token usage details
+}
+
+// RetrieverData contains vector database retrieval span information
+type RetrieverData struct {
+	VectorDB string `json:"vectorDB,omitempty"` // Vector database system (e.g., Chroma, Pinecone)
+	TopK     int    `json:"topK,omitempty"`     // Number of top results requested
+}
+
+// RetrievedDocument represents a document returned by a retriever or reranker
+type RetrievedDocument struct {
+	ID       string   `json:"id,omitempty"`       // Document ID
+	Content  string   `json:"content,omitempty"`  // Document text
+	Score    *float64 `json:"score,omitempty"`    // Relevance score
+	Metadata string   `json:"metadata,omitempty"` // JSON metadata of the document
+}
+
+// AgentData contains agent execution span information
+type AgentData struct {
+	Name         string           `json:"name,omitempty"`         // Agent name (from gen_ai.agent.name)
+	Tools        []ToolDefinition `json:"tools,omitempty"`        // Available tools for the agent (from gen_ai.agent.tools)
+	Model        string           `json:"model,omitempty"`        // Model used by the agent (from gen_ai.request.model)
+	Framework    string           `json:"framework,omitempty"`    // Agent framework (from gen_ai.system, e.g., "strands-agents")
+	SystemPrompt string           `json:"systemPrompt,omitempty"` // System prompt for the agent
+	MaxIter      int              `json:"maxIter,omitempty"`      // Maximum iterations for the agent (from crewai.agent.max_iter)
+	TokenUsage   *LLMTokenUsage   `json:"tokenUsage,omitempty"`   // Token usage details (aggregated from agent execution)
+}
+
+// CrewAITaskData contains CrewAI task execution span information
+type CrewAITaskData struct {
+	Name        string           `json:"name,omitempty"`        // Task name (from crewai.task.name)
+	Description string           `json:"description,omitempty"` // Task description (from crewai.task.description)
+	Tools       []ToolDefinition `json:"tools,omitempty"`       // Available tools for the task (from crewai.task.tools)
+}
+
+// LangGraphData contains LangGraph graph and node execution span information
+type LangGraphData struct {
+	Graph    string   `json:"graph,omitempty"`    // Graph name (the span name of the graph run, LangGraph by default)
+	Node     string   `json:"node,omitempty"`     // Node executed by the span (from langgraph_node metadata)
+	Step     int      `json:"step,omitempty"`     // Step of the graph run in which the node executed (from langgraph_step metadata)
+	Triggers []string `json:"triggers,omitempty"` // Channels that triggered the node (from langgraph_triggers metadata)
+}
+
+// SpanStatus represents the execution status of a span
+type SpanStatus struct {
+	Error        bool   `json:"error"`                  // Whether the span has an error
+	ErrorType    string `json:"errorType,omitempty"`    // Error type from error.type attribute or the exception event (only if error is true)
+	ErrorMessage string `json:"errorMessage,omitempty"` // Error message from the exception event or the span status (only if error is true)
+}
+
+// LLMTokenUsage represents token usage for a single LLM span
+type LLMTokenUsage struct {
+	InputTokens          int `json:"inputTokens"`
+	OutputTokens         int `json:"outputTokens"`
+	CacheReadInputTokens int `json:"cacheReadInputTokens,omitempty"`
+	TotalTokens          int `json:"totalTokens"`
+}
+
+// PromptMessage represents a single message in a conversation
+type PromptMessage struct {
+	Role      string     `json:"role"`                // system, user, assistant, tool
+	Content   string     `json:"content,omitempty"`   // The message content (text)
+	ToolCalls []ToolCall `json:"toolCalls,omitempty"` // Tool calls made by assistant (for assistant role with tool calls)
+}
+
+// ToolCall represents a tool/function call made by the assistant
+type ToolCall struct {
+	ID        string `json:"id"`        // Tool call ID
+	Name      string `json:"name"`      // Function/tool name
+	Arguments string `json:"arguments"` // JSON arguments for the tool
+}
+
+// ToolDefinition represents a tool/function available to the LLM
+type ToolDefinition struct {
+	Name        string `json:"name"`                  // Function name
+	Description string `json:"description,omitempty"` // Function description
+	Parameters  string `json:"parameters,omitempty"`  // JSON schema of parameters
+}
+
+// TraceResponse represents the response for trace queries
+type TraceResponse struct {
+	Spans      []Span            `json:"spans"`
+	TotalCount int               `json:"totalCount"`
+	TokenUsage *TokenUsage       `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
+	ModelUsage []ModelTokenUsage `json:"modelUsage,omitempty"` // Token usage of GenAI spans per vendor and model
+	Status     *TraceStatus      `json:"status,omitempty"`     // Trace status including error information
+}
+
+// TraceDetailResponse represents detailed information for a single trace
+type TraceDetailResponse struct {
+	TraceID    string   `json:"traceId"`
+	Spans      []Span   `json:"spans"`
+	TotalSpans int      `json:"totalSpans"`
+	Duration   int64    `json:"duration"` // Total trace duration in microseconds
+	Services   []string `json:"services"` // List of services involved
+}
+
+// TraceOverview represents a single trace overview with root span info
+type TraceOverview struct {
+	TraceID         string            `json:"traceId"`
+	RootSpanID      string            `json:"rootSpanId"`
+	RootSpanName    string            `json:"rootSpanName"`
+	RootSpanKind    string            `json:"rootSpanKind"` // Semantic kind of the root span (llm, tool, etc.)
+	StartTime       string            `json:"startTime"`
+	EndTime         string            `json:"endTime"`
+	DurationInNanos int64             `json:"durationInNanos"` // Total trace duration in nanoseconds
+	SpanCount       int               `json:"spanCount"`
+	ComponentUid    string            `json:"componentUid"`         // Component that produced the root span
+	TokenUsage      *TokenUsage       `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
+	ModelUsage      []ModelTokenUsage `json:"modelUsage,omitempty"` // Token usage of GenAI spans per vendor and model
+	Status          *TraceStatus      `json:"status,omitempty"`     // Trace status including error information
+	Input           interface{}       `json:"input,omitempty"`      // Input from root span (nil if not found)
+	Output          interface{}       `json:"output,omitempty"`     // Output from root span (nil if not found)
+	Redaction       *Redaction        `json:"redaction,omitempty"`  // Set when sensitive values of the root span were redacted
+}
+
+// TraceStatus represents the status of a trace
+type TraceStatus struct {
+	ErrorCount int `json:"errorCount"` // Number of spans with errors (0 means no errors)
+}
+
+// SpanType represents the semantic type/kind of a span
+type SpanType string
+
+const (
+	SpanTypeLLM        SpanType = "llm"        // LLM/Chat completion operations
+	SpanTypeEmbedding  SpanType = "embedding"  // Embedding generation operations
+	SpanTypeTool       SpanType = "tool"       // Tool/Function calls
+	SpanTypeRetriever  SpanType = "retriever"  // Vector DB retrieval operations
+	SpanTypeRerank     SpanType = "rerank"     // Reranking operations
+	SpanTypeAgent      SpanType = "agent"      // Agent orchestration
+	SpanTypeChain      SpanType = "chain"      // Generic tasks/workflows
+	SpanTypeCrewAITask SpanType = "crewaitask" // CrewAI task operations
+	SpanTypeUnknown    SpanType = "unknown"    // Unknown/unclassified spans
+)
+
+// TokenUsage represents aggregated token usage from GenAI spans
+type TokenUsage struct {
+	InputTokens  int `json:"inputTokens"`
+	OutputTokens int `json:"outputTokens"`
+	TotalTokens  int `json:"totalTokens"`
+}
+
+// ModelTokenUsage represents the token usage of the GenAI spans of a vendor and model
+type ModelTokenUsage struct {
+	Vendor       string `json:"vendor,omitempty"` // LLM vendor/provider (gen_ai.system)
+	Model        string `json:"model"`            // Model name (gen_ai.response.model or gen_ai.request.model)
+	InputTokens  int    `json:"inputTokens"`
+	OutputTokens int    `json:"outputTokens"`
+	TotalTokens  int    `json:"totalTokens"`
+}
+
+// TraceOverviewResponse represents the response for trace overview queries
+type TraceOverviewResponse struct {
+	Traces     []TraceOverview `json:"traces"`
+	TotalCount int             `json:"totalCount"`
+	NextCursor string          `json:"nextCursor,omitempty"` // Cursor for the next page, empty on the last page
+	Truncated  bool            `json:"truncated,omitempty"`  // Span filters matched too many traces, or traces had too many spans to summarize
+}
+
+// SessionOverview summarizes the turns (traces) of a session
+type SessionOverview struct {
+	SessionID  string     `json:"sessionId"`
+	StartTime  string     `json:"startTime"`  // Start time of the first turn
+	EndTime    string     `json:"endTime"`    // End time of the last turn to finish
+	TurnCount  int        `json:"turnCount"`  // Number of traces in the session
+	ErrorCount int        `json:"errorCount"` // Number of turns with at least one error span
+	TokenUsage TokenUsage `json:"tokenUsage"` // Token totals of the GenAI spans of all turns
+}
+
+// SessionListResponse represents the response for session list queries
+type SessionListResponse struct {
+	Sessions   []SessionOverview `json:"sessions"`
+	TotalCount int               `json:"totalCount"`
+	Truncated  bool              `json:"truncated,omitempty"` // Too many spans or traces to load, so some sessions may be missing or incomplete
+}
+
+// SessionResponse represents a session with its turns ordered by start time
+type SessionResponse struct {
+	SessionOverview
+	Turns     []TraceOverview `json:"turns"`
+	Truncated bool            `json:"truncated,omitempty"` // Too many spans or traces to load, so some turns may be missing or incomplete
+}
+
+// MetricsResponse represents time-bucketed metrics of an agent
+type MetricsResponse struct {
+	Interval string          `json:"interval"` // Width of each bucket, e.g. 5m or 1h
+	Summary  MetricsBucket   `json:"summary"`  // Metrics over the whole time range
+	Buckets  []MetricsBucket `json:"buckets"`  // Metrics per time bucket, in ascending time order
+}
+
+// MetricsBucket holds the metrics of a time bucket
+type MetricsBucket struct {
+	Timestamp    string              `json:"timestamp,omitempty"` // Start of the bucket (RFC3339), omitted for the summary
+	RequestCount int                 `json:"requestCount"`        // Number of traces (root spans) started in the bucket
+	ErrorCount   int                 `json:"errorCount"`          // Number of traces with at least one error span
+	ErrorRate    float64             `json:"errorRate"`           // ErrorCount / RequestCount, between 0 and 1
+	Latency      *LatencyPercentiles `json:"latency,omitempty"`   // Root span latency percentiles, omitted when there are no requests
+	TokenUsage   TokenUsage          `json:"tokenUsage"`          // Token totals of GenAI spans
+}
+
+// LatencyPercentiles holds latency percentiles in nanoseconds
+type LatencyPercentiles struct {
+	P50InNanos int64 `json:"p50InNanos"`
+	P95InNanos int64 `json:"p95InNanos"`
+	P99InNanos int64 `json:"p99InNanos"`
+}
+
+// TokenUsageResponse represents the daily token usage of components per vendor and model
+type TokenUsageResponse struct {
+	Usage []DailyTokenUsage `json:"usage"`
+}
+
+// DailyTokenUsage holds the token usage of a component, vendor and model on a day (UTC)
+type DailyTokenUsage struct {
+	ComponentUid string `json:"componentUid"`
+	Date         string `json:"date"` // Day of the usage, in YYYY-MM-DD format
+	ModelTokenUsage
+}